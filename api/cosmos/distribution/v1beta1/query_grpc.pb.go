@@ -19,16 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName                      = "/cosmos.distribution.v1beta1.Query/Params"
-	Query_ValidatorDistributionInfo_FullMethodName   = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
-	Query_ValidatorOutstandingRewards_FullMethodName = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
-	Query_ValidatorCommission_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
-	Query_ValidatorSlashes_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
-	Query_DelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
-	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
-	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
-	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_Params_FullMethodName                           = "/cosmos.distribution.v1beta1.Query/Params"
+	Query_ValidatorDistributionInfo_FullMethodName        = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
+	Query_ValidatorOutstandingRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
+	Query_ValidatorCommission_FullMethodName              = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
+	Query_ValidatorSlashes_FullMethodName                 = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
+	Query_DelegationRewards_FullMethodName                = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
+	Query_DelegationTotalRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
+	Query_DelegatorValidators_FullMethodName              = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorWithdrawAddress_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_CommunityPool_FullMethodName                    = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_ValidatorRewardDistributionStats_FullMethodName = "/cosmos.distribution.v1beta1.Query/ValidatorRewardDistributionStats"
+	Query_ValidatorDustStats_FullMethodName               = "/cosmos.distribution.v1beta1.Query/ValidatorDustStats"
+	Query_DelegationAccruedRewards_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegationAccruedRewards"
+	Query_CommunityPoolSpendHistory_FullMethodName        = "/cosmos.distribution.v1beta1.Query/CommunityPoolSpendHistory"
+	Query_DelegationRewardsAtHeight_FullMethodName        = "/cosmos.distribution.v1beta1.Query/DelegationRewardsAtHeight"
+	Query_ValidatorCommissionWithholding_FullMethodName   = "/cosmos.distribution.v1beta1.Query/ValidatorCommissionWithholding"
+	Query_CommunityPoolSpendable_FullMethodName           = "/cosmos.distribution.v1beta1.Query/CommunityPoolSpendable"
+	Query_ValidatorForfeitedRewards_FullMethodName        = "/cosmos.distribution.v1beta1.Query/ValidatorForfeitedRewards"
+	Query_CommunityPoolRemainderAccrued_FullMethodName    = "/cosmos.distribution.v1beta1.Query/CommunityPoolRemainderAccrued"
+	Query_WithdrawOperator_FullMethodName                 = "/cosmos.distribution.v1beta1.Query/WithdrawOperator"
+	Query_RewardAdjustments_FullMethodName                = "/cosmos.distribution.v1beta1.Query/RewardAdjustments"
+	Query_BatchDelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/BatchDelegationRewards"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +72,49 @@ type QueryClient interface {
 	//
 	// WARNING: This query will fail if an external community pool is used.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// ValidatorRewardDistributionStats queries the cumulative self-bond rewards,
+	// external delegator rewards and commission withdrawn from a validator.
+	ValidatorRewardDistributionStats(ctx context.Context, in *QueryValidatorRewardDistributionStatsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardDistributionStatsResponse, error)
+	// ValidatorDustStats queries the cumulative truncation remainders sent to the
+	// community pool on withdrawals from a validator.
+	ValidatorDustStats(ctx context.Context, in *QueryValidatorDustStatsRequest, opts ...grpc.CallOption) (*QueryValidatorDustStatsResponse, error)
+	// DelegationAccruedRewards queries the rewards of a delegation settled by
+	// reward checkpoints and not withdrawn yet.
+	DelegationAccruedRewards(ctx context.Context, in *QueryDelegationAccruedRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationAccruedRewardsResponse, error)
+	// CommunityPoolSpendHistory queries the community pool spends executed
+	// within the retention period of the spend history, ordered by height.
+	CommunityPoolSpendHistory(ctx context.Context, in *QueryCommunityPoolSpendHistoryRequest, opts ...grpc.CallOption) (*QueryCommunityPoolSpendHistoryResponse, error)
+	// DelegationRewardsAtHeight queries the rewards accrued by a delegation as
+	// of a historical height, without withdrawing them. The query must be
+	// executed against the state of that height, e.g. with the
+	// x-cosmos-block-height header.
+	DelegationRewardsAtHeight(ctx context.Context, in *QueryDelegationRewardsAtHeightRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsAtHeightResponse, error)
+	// ValidatorCommissionWithholding queries the commission withholding of a
+	// validator and the commission withheld so far.
+	ValidatorCommissionWithholding(ctx context.Context, in *QueryValidatorCommissionWithholdingRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionWithholdingResponse, error)
+	// CommunityPoolSpendable queries the integer coins of the community pool
+	// which can be spent, along with its decimal total and the fractional
+	// remainder which cannot be spent.
+	//
+	// WARNING: This query will fail if an external community pool is used.
+	CommunityPoolSpendable(ctx context.Context, in *QueryCommunityPoolSpendableRequest, opts ...grpc.CallOption) (*QueryCommunityPoolSpendableResponse, error)
+	// ValidatorForfeitedRewards queries the cumulative rewards of a validator
+	// forfeited to the community pool while it had no tokens, along with its
+	// most recent forfeitures.
+	ValidatorForfeitedRewards(ctx context.Context, in *QueryValidatorForfeitedRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorForfeitedRewardsResponse, error)
+	// CommunityPoolRemainderAccrued queries the cumulative truncation remainders
+	// of the withdrawn rewards and commission added to the community pool.
+	CommunityPoolRemainderAccrued(ctx context.Context, in *QueryCommunityPoolRemainderAccruedRequest, opts ...grpc.CallOption) (*QueryCommunityPoolRemainderAccruedResponse, error)
+	// WithdrawOperator queries the withdraw operator registered by a delegator.
+	WithdrawOperator(ctx context.Context, in *QueryWithdrawOperatorRequest, opts ...grpc.CallOption) (*QueryWithdrawOperatorResponse, error)
+	// RewardAdjustments queries the reward adjustments applied by the module
+	// authority, ordered by id.
+	RewardAdjustments(ctx context.Context, in *QueryRewardAdjustmentsRequest, opts ...grpc.CallOption) (*QueryRewardAdjustmentsResponse, error)
+	// BatchDelegationRewards queries the rewards accrued by several delegations,
+	// all calculated against the state of the same height. A delegation which
+	// does not exist is reported by an error code in its result instead of
+	// failing the query.
+	BatchDelegationRewards(ctx context.Context, in *QueryBatchDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryBatchDelegationRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -170,6 +225,126 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) ValidatorRewardDistributionStats(ctx context.Context, in *QueryValidatorRewardDistributionStatsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardDistributionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorRewardDistributionStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorRewardDistributionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorDustStats(ctx context.Context, in *QueryValidatorDustStatsRequest, opts ...grpc.CallOption) (*QueryValidatorDustStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorDustStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorDustStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationAccruedRewards(ctx context.Context, in *QueryDelegationAccruedRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationAccruedRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegationAccruedRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegationAccruedRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityPoolSpendHistory(ctx context.Context, in *QueryCommunityPoolSpendHistoryRequest, opts ...grpc.CallOption) (*QueryCommunityPoolSpendHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCommunityPoolSpendHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_CommunityPoolSpendHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationRewardsAtHeight(ctx context.Context, in *QueryDelegationRewardsAtHeightRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsAtHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegationRewardsAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_DelegationRewardsAtHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorCommissionWithholding(ctx context.Context, in *QueryValidatorCommissionWithholdingRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionWithholdingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorCommissionWithholdingResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorCommissionWithholding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityPoolSpendable(ctx context.Context, in *QueryCommunityPoolSpendableRequest, opts ...grpc.CallOption) (*QueryCommunityPoolSpendableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCommunityPoolSpendableResponse)
+	err := c.cc.Invoke(ctx, Query_CommunityPoolSpendable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorForfeitedRewards(ctx context.Context, in *QueryValidatorForfeitedRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorForfeitedRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorForfeitedRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorForfeitedRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityPoolRemainderAccrued(ctx context.Context, in *QueryCommunityPoolRemainderAccruedRequest, opts ...grpc.CallOption) (*QueryCommunityPoolRemainderAccruedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCommunityPoolRemainderAccruedResponse)
+	err := c.cc.Invoke(ctx, Query_CommunityPoolRemainderAccrued_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawOperator(ctx context.Context, in *QueryWithdrawOperatorRequest, opts ...grpc.CallOption) (*QueryWithdrawOperatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWithdrawOperatorResponse)
+	err := c.cc.Invoke(ctx, Query_WithdrawOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardAdjustments(ctx context.Context, in *QueryRewardAdjustmentsRequest, opts ...grpc.CallOption) (*QueryRewardAdjustmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRewardAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, Query_RewardAdjustments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchDelegationRewards(ctx context.Context, in *QueryBatchDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryBatchDelegationRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBatchDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_BatchDelegationRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -199,6 +374,49 @@ type QueryServer interface {
 	//
 	// WARNING: This query will fail if an external community pool is used.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// ValidatorRewardDistributionStats queries the cumulative self-bond rewards,
+	// external delegator rewards and commission withdrawn from a validator.
+	ValidatorRewardDistributionStats(context.Context, *QueryValidatorRewardDistributionStatsRequest) (*QueryValidatorRewardDistributionStatsResponse, error)
+	// ValidatorDustStats queries the cumulative truncation remainders sent to the
+	// community pool on withdrawals from a validator.
+	ValidatorDustStats(context.Context, *QueryValidatorDustStatsRequest) (*QueryValidatorDustStatsResponse, error)
+	// DelegationAccruedRewards queries the rewards of a delegation settled by
+	// reward checkpoints and not withdrawn yet.
+	DelegationAccruedRewards(context.Context, *QueryDelegationAccruedRewardsRequest) (*QueryDelegationAccruedRewardsResponse, error)
+	// CommunityPoolSpendHistory queries the community pool spends executed
+	// within the retention period of the spend history, ordered by height.
+	CommunityPoolSpendHistory(context.Context, *QueryCommunityPoolSpendHistoryRequest) (*QueryCommunityPoolSpendHistoryResponse, error)
+	// DelegationRewardsAtHeight queries the rewards accrued by a delegation as
+	// of a historical height, without withdrawing them. The query must be
+	// executed against the state of that height, e.g. with the
+	// x-cosmos-block-height header.
+	DelegationRewardsAtHeight(context.Context, *QueryDelegationRewardsAtHeightRequest) (*QueryDelegationRewardsAtHeightResponse, error)
+	// ValidatorCommissionWithholding queries the commission withholding of a
+	// validator and the commission withheld so far.
+	ValidatorCommissionWithholding(context.Context, *QueryValidatorCommissionWithholdingRequest) (*QueryValidatorCommissionWithholdingResponse, error)
+	// CommunityPoolSpendable queries the integer coins of the community pool
+	// which can be spent, along with its decimal total and the fractional
+	// remainder which cannot be spent.
+	//
+	// WARNING: This query will fail if an external community pool is used.
+	CommunityPoolSpendable(context.Context, *QueryCommunityPoolSpendableRequest) (*QueryCommunityPoolSpendableResponse, error)
+	// ValidatorForfeitedRewards queries the cumulative rewards of a validator
+	// forfeited to the community pool while it had no tokens, along with its
+	// most recent forfeitures.
+	ValidatorForfeitedRewards(context.Context, *QueryValidatorForfeitedRewardsRequest) (*QueryValidatorForfeitedRewardsResponse, error)
+	// CommunityPoolRemainderAccrued queries the cumulative truncation remainders
+	// of the withdrawn rewards and commission added to the community pool.
+	CommunityPoolRemainderAccrued(context.Context, *QueryCommunityPoolRemainderAccruedRequest) (*QueryCommunityPoolRemainderAccruedResponse, error)
+	// WithdrawOperator queries the withdraw operator registered by a delegator.
+	WithdrawOperator(context.Context, *QueryWithdrawOperatorRequest) (*QueryWithdrawOperatorResponse, error)
+	// RewardAdjustments queries the reward adjustments applied by the module
+	// authority, ordered by id.
+	RewardAdjustments(context.Context, *QueryRewardAdjustmentsRequest) (*QueryRewardAdjustmentsResponse, error)
+	// BatchDelegationRewards queries the rewards accrued by several delegations,
+	// all calculated against the state of the same height. A delegation which
+	// does not exist is reported by an error code in its result instead of
+	// failing the query.
+	BatchDelegationRewards(context.Context, *QueryBatchDelegationRewardsRequest) (*QueryBatchDelegationRewardsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +457,42 @@ func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *Query
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) ValidatorRewardDistributionStats(context.Context, *QueryValidatorRewardDistributionStatsRequest) (*QueryValidatorRewardDistributionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewardDistributionStats not implemented")
+}
+func (UnimplementedQueryServer) ValidatorDustStats(context.Context, *QueryValidatorDustStatsRequest) (*QueryValidatorDustStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDustStats not implemented")
+}
+func (UnimplementedQueryServer) DelegationAccruedRewards(context.Context, *QueryDelegationAccruedRewardsRequest) (*QueryDelegationAccruedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationAccruedRewards not implemented")
+}
+func (UnimplementedQueryServer) CommunityPoolSpendHistory(context.Context, *QueryCommunityPoolSpendHistoryRequest) (*QueryCommunityPoolSpendHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpendHistory not implemented")
+}
+func (UnimplementedQueryServer) DelegationRewardsAtHeight(context.Context, *QueryDelegationRewardsAtHeightRequest) (*QueryDelegationRewardsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewardsAtHeight not implemented")
+}
+func (UnimplementedQueryServer) ValidatorCommissionWithholding(context.Context, *QueryValidatorCommissionWithholdingRequest) (*QueryValidatorCommissionWithholdingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommissionWithholding not implemented")
+}
+func (UnimplementedQueryServer) CommunityPoolSpendable(context.Context, *QueryCommunityPoolSpendableRequest) (*QueryCommunityPoolSpendableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpendable not implemented")
+}
+func (UnimplementedQueryServer) ValidatorForfeitedRewards(context.Context, *QueryValidatorForfeitedRewardsRequest) (*QueryValidatorForfeitedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorForfeitedRewards not implemented")
+}
+func (UnimplementedQueryServer) CommunityPoolRemainderAccrued(context.Context, *QueryCommunityPoolRemainderAccruedRequest) (*QueryCommunityPoolRemainderAccruedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolRemainderAccrued not implemented")
+}
+func (UnimplementedQueryServer) WithdrawOperator(context.Context, *QueryWithdrawOperatorRequest) (*QueryWithdrawOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawOperator not implemented")
+}
+func (UnimplementedQueryServer) RewardAdjustments(context.Context, *QueryRewardAdjustmentsRequest) (*QueryRewardAdjustmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardAdjustments not implemented")
+}
+func (UnimplementedQueryServer) BatchDelegationRewards(context.Context, *QueryBatchDelegationRewardsRequest) (*QueryBatchDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelegationRewards not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -440,6 +694,222 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorRewardDistributionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardDistributionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewardDistributionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorRewardDistributionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewardDistributionStats(ctx, req.(*QueryValidatorRewardDistributionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorDustStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDustStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDustStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorDustStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDustStats(ctx, req.(*QueryValidatorDustStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationAccruedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationAccruedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationAccruedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegationAccruedRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationAccruedRewards(ctx, req.(*QueryDelegationAccruedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPoolSpendHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolSpendHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolSpendHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommunityPoolSpendHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolSpendHistory(ctx, req.(*QueryCommunityPoolSpendHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationRewardsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewardsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegationRewardsAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewardsAtHeight(ctx, req.(*QueryDelegationRewardsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorCommissionWithholding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCommissionWithholdingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCommissionWithholding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorCommissionWithholding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCommissionWithholding(ctx, req.(*QueryValidatorCommissionWithholdingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPoolSpendable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolSpendableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolSpendable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommunityPoolSpendable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolSpendable(ctx, req.(*QueryCommunityPoolSpendableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorForfeitedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorForfeitedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorForfeitedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorForfeitedRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorForfeitedRewards(ctx, req.(*QueryValidatorForfeitedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPoolRemainderAccrued_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolRemainderAccruedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolRemainderAccrued(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommunityPoolRemainderAccrued_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolRemainderAccrued(ctx, req.(*QueryCommunityPoolRemainderAccruedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WithdrawOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawOperator(ctx, req.(*QueryWithdrawOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RewardAdjustments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardAdjustments(ctx, req.(*QueryRewardAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BatchDelegationRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchDelegationRewards(ctx, req.(*QueryBatchDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -487,6 +957,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "ValidatorRewardDistributionStats",
+			Handler:    _Query_ValidatorRewardDistributionStats_Handler,
+		},
+		{
+			MethodName: "ValidatorDustStats",
+			Handler:    _Query_ValidatorDustStats_Handler,
+		},
+		{
+			MethodName: "DelegationAccruedRewards",
+			Handler:    _Query_DelegationAccruedRewards_Handler,
+		},
+		{
+			MethodName: "CommunityPoolSpendHistory",
+			Handler:    _Query_CommunityPoolSpendHistory_Handler,
+		},
+		{
+			MethodName: "DelegationRewardsAtHeight",
+			Handler:    _Query_DelegationRewardsAtHeight_Handler,
+		},
+		{
+			MethodName: "ValidatorCommissionWithholding",
+			Handler:    _Query_ValidatorCommissionWithholding_Handler,
+		},
+		{
+			MethodName: "CommunityPoolSpendable",
+			Handler:    _Query_CommunityPoolSpendable_Handler,
+		},
+		{
+			MethodName: "ValidatorForfeitedRewards",
+			Handler:    _Query_ValidatorForfeitedRewards_Handler,
+		},
+		{
+			MethodName: "CommunityPoolRemainderAccrued",
+			Handler:    _Query_CommunityPoolRemainderAccrued_Handler,
+		},
+		{
+			MethodName: "WithdrawOperator",
+			Handler:    _Query_WithdrawOperator_Handler,
+		},
+		{
+			MethodName: "RewardAdjustments",
+			Handler:    _Query_RewardAdjustments_Handler,
+		},
+		{
+			MethodName: "BatchDelegationRewards",
+			Handler:    _Query_BatchDelegationRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
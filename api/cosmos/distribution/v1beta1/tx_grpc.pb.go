@@ -19,13 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_SetWithdrawAddress_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
-	Msg_WithdrawDelegatorReward_FullMethodName     = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
-	Msg_WithdrawValidatorCommission_FullMethodName = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
-	Msg_FundCommunityPool_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
-	Msg_UpdateParams_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
-	Msg_CommunityPoolSpend_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
-	Msg_DepositValidatorRewardsPool_FullMethodName = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_SetWithdrawAddress_FullMethodName                    = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
+	Msg_WithdrawDelegatorReward_FullMethodName               = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
+	Msg_WithdrawValidatorCommission_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
+	Msg_FundCommunityPool_FullMethodName                     = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
+	Msg_UpdateParams_FullMethodName                          = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
+	Msg_CommunityPoolSpend_FullMethodName                    = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
+	Msg_DepositValidatorRewardsPool_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_ResetValidatorRewardDistributionStats_FullMethodName = "/cosmos.distribution.v1beta1.Msg/ResetValidatorRewardDistributionStats"
+	Msg_WithdrawAllDelegatorRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards"
+	Msg_SetCommissionWithholding_FullMethodName              = "/cosmos.distribution.v1beta1.Msg/SetCommissionWithholding"
+	Msg_ResolveCommissionWithholding_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/ResolveCommissionWithholding"
+	Msg_RegisterWithdrawOperator_FullMethodName              = "/cosmos.distribution.v1beta1.Msg/RegisterWithdrawOperator"
+	Msg_ApplyRewardAdjustment_FullMethodName                 = "/cosmos.distribution.v1beta1.Msg/ApplyRewardAdjustment"
+	Msg_SweepCommunityPoolDenoms_FullMethodName              = "/cosmos.distribution.v1beta1.Msg/SweepCommunityPoolDenoms"
 )
 
 // MsgClient is the client API for Msg service.
@@ -61,6 +68,35 @@ type MsgClient interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// ResetValidatorRewardDistributionStats defines a governance operation for
+	// resetting the cumulative reward distribution stats of a validator. The
+	// authority is defined in the keeper.
+	ResetValidatorRewardDistributionStats(ctx context.Context, in *MsgResetValidatorRewardDistributionStats, opts ...grpc.CallOption) (*MsgResetValidatorRewardDistributionStatsResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of a
+	// delegator from all the validators it delegates to.
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// SetCommissionWithholding defines a governance operation for withholding
+	// the commission withdrawals of a validator, or lifting the withholding. The
+	// authority is defined in the keeper.
+	SetCommissionWithholding(ctx context.Context, in *MsgSetCommissionWithholding, opts ...grpc.CallOption) (*MsgSetCommissionWithholdingResponse, error)
+	// ResolveCommissionWithholding defines a governance operation for paying
+	// out the withheld commission of a validator to either the validator
+	// operator or the community pool. The authority is defined in the keeper.
+	ResolveCommissionWithholding(ctx context.Context, in *MsgResolveCommissionWithholding, opts ...grpc.CallOption) (*MsgResolveCommissionWithholdingResponse, error)
+	// RegisterWithdrawOperator defines a method for a delegator to register the
+	// operator allowed to withdraw its delegation rewards on its behalf, or to
+	// revoke the registered operator.
+	RegisterWithdrawOperator(ctx context.Context, in *MsgRegisterWithdrawOperator, opts ...grpc.CallOption) (*MsgRegisterWithdrawOperatorResponse, error)
+	// ApplyRewardAdjustment defines a governance operation for adjusting the
+	// rewards of validators misallocated over a height range, financed from or
+	// credited to the community pool. The authority is defined in the keeper.
+	ApplyRewardAdjustment(ctx context.Context, in *MsgApplyRewardAdjustment, opts ...grpc.CallOption) (*MsgApplyRewardAdjustmentResponse, error)
+	// SweepCommunityPoolDenoms defines a governance operation for removing
+	// denoms from the community pool, by burning them or sending them to a
+	// disposal address. The authority is defined in the keeper.
+	//
+	// WARNING: This method will fail if an external community pool is used.
+	SweepCommunityPoolDenoms(ctx context.Context, in *MsgSweepCommunityPoolDenoms, opts ...grpc.CallOption) (*MsgSweepCommunityPoolDenomsResponse, error)
 }
 
 type msgClient struct {
@@ -141,6 +177,76 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) ResetValidatorRewardDistributionStats(ctx context.Context, in *MsgResetValidatorRewardDistributionStats, opts ...grpc.CallOption) (*MsgResetValidatorRewardDistributionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResetValidatorRewardDistributionStatsResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetValidatorRewardDistributionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawAllDelegatorRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCommissionWithholding(ctx context.Context, in *MsgSetCommissionWithholding, opts ...grpc.CallOption) (*MsgSetCommissionWithholdingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetCommissionWithholdingResponse)
+	err := c.cc.Invoke(ctx, Msg_SetCommissionWithholding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveCommissionWithholding(ctx context.Context, in *MsgResolveCommissionWithholding, opts ...grpc.CallOption) (*MsgResolveCommissionWithholdingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResolveCommissionWithholdingResponse)
+	err := c.cc.Invoke(ctx, Msg_ResolveCommissionWithholding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterWithdrawOperator(ctx context.Context, in *MsgRegisterWithdrawOperator, opts ...grpc.CallOption) (*MsgRegisterWithdrawOperatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterWithdrawOperatorResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterWithdrawOperator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApplyRewardAdjustment(ctx context.Context, in *MsgApplyRewardAdjustment, opts ...grpc.CallOption) (*MsgApplyRewardAdjustmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgApplyRewardAdjustmentResponse)
+	err := c.cc.Invoke(ctx, Msg_ApplyRewardAdjustment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepCommunityPoolDenoms(ctx context.Context, in *MsgSweepCommunityPoolDenoms, opts ...grpc.CallOption) (*MsgSweepCommunityPoolDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSweepCommunityPoolDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SweepCommunityPoolDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -174,6 +280,35 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// ResetValidatorRewardDistributionStats defines a governance operation for
+	// resetting the cumulative reward distribution stats of a validator. The
+	// authority is defined in the keeper.
+	ResetValidatorRewardDistributionStats(context.Context, *MsgResetValidatorRewardDistributionStats) (*MsgResetValidatorRewardDistributionStatsResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of a
+	// delegator from all the validators it delegates to.
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// SetCommissionWithholding defines a governance operation for withholding
+	// the commission withdrawals of a validator, or lifting the withholding. The
+	// authority is defined in the keeper.
+	SetCommissionWithholding(context.Context, *MsgSetCommissionWithholding) (*MsgSetCommissionWithholdingResponse, error)
+	// ResolveCommissionWithholding defines a governance operation for paying
+	// out the withheld commission of a validator to either the validator
+	// operator or the community pool. The authority is defined in the keeper.
+	ResolveCommissionWithholding(context.Context, *MsgResolveCommissionWithholding) (*MsgResolveCommissionWithholdingResponse, error)
+	// RegisterWithdrawOperator defines a method for a delegator to register the
+	// operator allowed to withdraw its delegation rewards on its behalf, or to
+	// revoke the registered operator.
+	RegisterWithdrawOperator(context.Context, *MsgRegisterWithdrawOperator) (*MsgRegisterWithdrawOperatorResponse, error)
+	// ApplyRewardAdjustment defines a governance operation for adjusting the
+	// rewards of validators misallocated over a height range, financed from or
+	// credited to the community pool. The authority is defined in the keeper.
+	ApplyRewardAdjustment(context.Context, *MsgApplyRewardAdjustment) (*MsgApplyRewardAdjustmentResponse, error)
+	// SweepCommunityPoolDenoms defines a governance operation for removing
+	// denoms from the community pool, by burning them or sending them to a
+	// disposal address. The authority is defined in the keeper.
+	//
+	// WARNING: This method will fail if an external community pool is used.
+	SweepCommunityPoolDenoms(context.Context, *MsgSweepCommunityPoolDenoms) (*MsgSweepCommunityPoolDenomsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -205,6 +340,27 @@ func (UnimplementedMsgServer) CommunityPoolSpend(context.Context, *MsgCommunityP
 func (UnimplementedMsgServer) DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (UnimplementedMsgServer) ResetValidatorRewardDistributionStats(context.Context, *MsgResetValidatorRewardDistributionStats) (*MsgResetValidatorRewardDistributionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetValidatorRewardDistributionStats not implemented")
+}
+func (UnimplementedMsgServer) WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
+func (UnimplementedMsgServer) SetCommissionWithholding(context.Context, *MsgSetCommissionWithholding) (*MsgSetCommissionWithholdingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommissionWithholding not implemented")
+}
+func (UnimplementedMsgServer) ResolveCommissionWithholding(context.Context, *MsgResolveCommissionWithholding) (*MsgResolveCommissionWithholdingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCommissionWithholding not implemented")
+}
+func (UnimplementedMsgServer) RegisterWithdrawOperator(context.Context, *MsgRegisterWithdrawOperator) (*MsgRegisterWithdrawOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWithdrawOperator not implemented")
+}
+func (UnimplementedMsgServer) ApplyRewardAdjustment(context.Context, *MsgApplyRewardAdjustment) (*MsgApplyRewardAdjustmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRewardAdjustment not implemented")
+}
+func (UnimplementedMsgServer) SweepCommunityPoolDenoms(context.Context, *MsgSweepCommunityPoolDenoms) (*MsgSweepCommunityPoolDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepCommunityPoolDenoms not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -352,6 +508,132 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResetValidatorRewardDistributionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetValidatorRewardDistributionStats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetValidatorRewardDistributionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetValidatorRewardDistributionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetValidatorRewardDistributionStats(ctx, req.(*MsgResetValidatorRewardDistributionStats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawAllDelegatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCommissionWithholding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCommissionWithholding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCommissionWithholding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetCommissionWithholding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCommissionWithholding(ctx, req.(*MsgSetCommissionWithholding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveCommissionWithholding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveCommissionWithholding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveCommissionWithholding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResolveCommissionWithholding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveCommissionWithholding(ctx, req.(*MsgResolveCommissionWithholding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterWithdrawOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterWithdrawOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterWithdrawOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterWithdrawOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterWithdrawOperator(ctx, req.(*MsgRegisterWithdrawOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApplyRewardAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApplyRewardAdjustment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApplyRewardAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApplyRewardAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApplyRewardAdjustment(ctx, req.(*MsgApplyRewardAdjustment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepCommunityPoolDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepCommunityPoolDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepCommunityPoolDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SweepCommunityPoolDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepCommunityPoolDenoms(ctx, req.(*MsgSweepCommunityPoolDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -387,6 +669,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "ResetValidatorRewardDistributionStats",
+			Handler:    _Msg_ResetValidatorRewardDistributionStats_Handler,
+		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
+		{
+			MethodName: "SetCommissionWithholding",
+			Handler:    _Msg_SetCommissionWithholding_Handler,
+		},
+		{
+			MethodName: "ResolveCommissionWithholding",
+			Handler:    _Msg_ResolveCommissionWithholding_Handler,
+		},
+		{
+			MethodName: "RegisterWithdrawOperator",
+			Handler:    _Msg_RegisterWithdrawOperator_Handler,
+		},
+		{
+			MethodName: "ApplyRewardAdjustment",
+			Handler:    _Msg_ApplyRewardAdjustment_Handler,
+		},
+		{
+			MethodName: "SweepCommunityPoolDenoms",
+			Handler:    _Msg_SweepCommunityPoolDenoms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1,6 +1,8 @@
 package node
 
 import (
+	"github.com/spf13/cobra"
+
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	nodev1beta1 "cosmossdk.io/api/cosmos/base/node/v1beta1"
 )
@@ -18,7 +20,12 @@ var ServiceAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
 			Use:       "status",
 			Short:     "Query the current node status",
 		},
+		{
+			RpcMethod: "Metrics",
+			Skip:      true, // served by the custom metrics command
+		},
 	},
+	EnhanceCustomCommand: true,
 }
 
 // NewNodeCommands is a fake `appmodule.Module` to be considered as a module
@@ -36,6 +43,12 @@ func (m nodeModule) Name() string {
 	return "node"
 }
 
+// GetQueryCmd returns the node query command with the custom commands of the
+// node service.
+func (m nodeModule) GetQueryCmd() *cobra.Command {
+	return QueryCmd()
+}
+
 func (m nodeModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: ServiceAutoCLIDescriptor,
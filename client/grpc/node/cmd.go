@@ -0,0 +1,84 @@
+package node
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	flagFormat     = "format"
+	flagOutputFile = "output-file"
+
+	metricsFormatJSON       = "json"
+	metricsFormatPrometheus = "prometheus"
+)
+
+// QueryCmd returns the node query command, to which AutoCLI adds the commands
+// of the node service without a custom command.
+func QueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "node",
+		Short:                      "Querying commands for the node service",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(MetricsCmd())
+
+	return cmd
+}
+
+// MetricsCmd returns the command querying the telemetry metrics of the node,
+// without exposing the metrics endpoint of its API server.
+func MetricsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "Query the telemetry metrics of the node",
+		Long: fmt.Sprintf(`Query the telemetry metrics of the node, in the %s format of the in-memory sink or in the
+Prometheus text format. The metrics are written to stdout, or to the file given by --%s.`, metricsFormatJSON, flagOutputFile),
+		Example: fmt.Sprintf("$ <appd> query node metrics --%s %s --%s metrics.txt", flagFormat, metricsFormatPrometheus, flagOutputFile),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, _ := cmd.Flags().GetString(flagFormat)
+			req := &MetricsRequest{}
+			switch format {
+			case metricsFormatJSON:
+				req.Format = telemetry.FormatText //nolint:staticcheck // TODO: switch to OpenTelemetry
+			case metricsFormatPrometheus:
+				req.Format = telemetry.FormatPrometheus //nolint:staticcheck // TODO: switch to OpenTelemetry
+			default:
+				return fmt.Errorf("unsupported metrics format %q, expected %s or %s", format, metricsFormatJSON, metricsFormatPrometheus)
+			}
+
+			res, err := NewServiceClient(clientCtx).Metrics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if outputFile, _ := cmd.Flags().GetString(flagOutputFile); outputFile != "" {
+				return os.WriteFile(outputFile, res.Metrics, 0o600)
+			}
+
+			_, err = cmd.OutOrStdout().Write(res.Metrics)
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagFormat, metricsFormatJSON, fmt.Sprintf("Format of the metrics, %s or %s", metricsFormatJSON, metricsFormatPrometheus))
+	cmd.Flags().String(flagOutputFile, "", "File to write the metrics to instead of stdout")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,15 +2,28 @@ package node
 
 import (
 	context "context"
+	"net/http"
+	"time"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// MaxMetricsSize is the maximum size of the metrics returned by the Metrics query.
+	MaxMetricsSize = 3 << 20
+	// MetricsGatherTimeout is the maximum duration of the gathering of the metrics
+	// by the Metrics query.
+	MetricsGatherTimeout = 5 * time.Second
+)
+
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
 func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) {
 	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
@@ -27,12 +40,15 @@ var _ ServiceServer = queryServer{}
 type queryServer struct {
 	clientCtx client.Context
 	cfg       config.Config
+	// metrics returns the telemetry metrics of the node, nil if telemetry is disabled
+	metrics func() *telemetry.Metrics //nolint:staticcheck // TODO: switch to OpenTelemetry
 }
 
 func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
 	return queryServer{
 		clientCtx: clientCtx,
 		cfg:       cfg,
+		metrics:   telemetry.GlobalMetrics, //nolint:staticcheck // TODO: switch to OpenTelemetry
 	}
 }
 
@@ -63,3 +79,52 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+// Metrics gathers the telemetry metrics of the node in the requested format. The
+// metrics are not served if the metrics endpoint of the API server requires
+// credentials, which the query cannot carry. The gathering is abandoned after
+// MetricsGatherTimeout, so that a slow sink does not hold the query connection
+// of the node.
+func (s queryServer) Metrics(ctx context.Context, req *MetricsRequest) (*MetricsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	m := s.metrics()
+	if m == nil {
+		return nil, status.Error(codes.Unavailable, "telemetry is disabled")
+	}
+
+	if !m.Authorized(http.Header{}) {
+		return nil, status.Error(codes.PermissionDenied, "metrics require credentials, query the metrics endpoint of the API server")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, MetricsGatherTimeout)
+	defer cancel()
+
+	type gatherResult struct {
+		res telemetry.GatherResponse //nolint:staticcheck // TODO: switch to OpenTelemetry
+		err error
+	}
+	done := make(chan gatherResult, 1)
+	go func() {
+		res, err := m.Gather(req.Format)
+		done <- gatherResult{res: res, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, status.Errorf(codes.DeadlineExceeded, "failed to gather metrics: %s", ctx.Err())
+
+	case result := <-done:
+		if result.err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to gather metrics: %s", result.err)
+		}
+
+		if len(result.res.Metrics) > MaxMetricsSize {
+			return nil, status.Errorf(codes.ResourceExhausted, "metrics size %d exceeds the maximum of %d bytes", len(result.res.Metrics), MaxMetricsSize)
+		}
+
+		return &MetricsResponse{Metrics: result.res.Metrics, ContentType: result.res.ContentType}, nil
+	}
+}
@@ -1,12 +1,18 @@
 package node
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -26,3 +32,39 @@ func TestServiceServer_Config(t *testing.T) {
 	require.Equal(t, defaultCfg.PruningInterval, resp.PruningInterval)
 	require.Equal(t, defaultCfg.HaltHeight, resp.HaltHeight)
 }
+
+// newMetricsClient returns a client of a node service serving the given metrics.
+func newMetricsClient(metrics *telemetry.Metrics) ServiceClient { //nolint:staticcheck // TODO: switch to OpenTelemetry
+	helper := baseapp.NewQueryServerTestHelper(sdk.Context{}.WithContext(context.Background()), codectypes.NewInterfaceRegistry())
+	RegisterServiceServer(helper, queryServer{metrics: func() *telemetry.Metrics { return metrics }}) //nolint:staticcheck // TODO: switch to OpenTelemetry
+	return NewServiceClient(helper)
+}
+
+func TestServiceServer_Metrics(t *testing.T) {
+	ctx := context.Background()
+
+	// telemetry is disabled
+	_, err := newMetricsClient(nil).Metrics(ctx, &MetricsRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	metrics, err := telemetry.New(telemetry.Config{Enabled: true, MetricsSink: telemetry.MetricSinkInMem, ServiceName: "test"})
+	require.NoError(t, err)
+	t.Cleanup(metrics.Close)
+	telemetry.IncrCounter(1, "test_counter")
+
+	res, err := newMetricsClient(metrics).Metrics(ctx, &MetricsRequest{Format: telemetry.FormatText})
+	require.NoError(t, err)
+	require.Equal(t, "application/json", res.ContentType)
+	require.Contains(t, string(res.Metrics), "test.test_counter")
+
+	// the metrics are gathered in the formats of the configured sinks only
+	_, err = newMetricsClient(metrics).Metrics(ctx, &MetricsRequest{Format: telemetry.FormatOpenMetrics})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the metrics protected by credentials are not served
+	protected, err := telemetry.New(telemetry.Config{Enabled: true, MetricsSink: telemetry.MetricSinkInMem, ServiceName: "test", AuthToken: "secret"})
+	require.NoError(t, err)
+	t.Cleanup(protected.Close)
+	_, err = newMetricsClient(protected).Metrics(ctx, &MetricsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -1,8 +1,10 @@
 package codec
 
 import (
+	"github.com/cometbft/cometbft/crypto/bls12381"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	// bls12_381 "github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -10,19 +12,25 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
-// RegisterInterfaces registers the crypto key interfaces.
+// RegisterInterfaces registers the crypto key interfaces. The BLS12-381 keys
+// are only registered when built with the bls12381 build tag, as their methods
+// are not implemented otherwise.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	var pk *cryptotypes.PubKey
 	registry.RegisterInterface("cosmos.crypto.PubKey", pk)
 	registry.RegisterImplementations(pk, &ed25519.PubKey{})
 	registry.RegisterImplementations(pk, &secp256k1.PubKey{})
-	// registry.RegisterImplementations(pk, &bls12_381.PubKey{})
+	if bls12381.Enabled {
+		registry.RegisterImplementations(pk, &bls12_381.PubKey{})
+	}
 	registry.RegisterImplementations(pk, &multisig.LegacyAminoPubKey{})
 
 	var priv *cryptotypes.PrivKey
 	registry.RegisterInterface("cosmos.crypto.PrivKey", priv)
 	registry.RegisterImplementations(priv, &secp256k1.PrivKey{})
 	registry.RegisterImplementations(priv, &ed25519.PrivKey{})
-	// registry.RegisterImplementations(priv, &bls12_381.PrivKey{})
+	if bls12381.Enabled {
+		registry.RegisterImplementations(priv, &bls12_381.PrivKey{})
+	}
 	secp256r1.RegisterInterfaces(registry)
 }
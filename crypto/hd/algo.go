@@ -1,8 +1,15 @@
 package hd
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -19,12 +26,22 @@ const (
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
 	// Bls12_381Type represents the Bls12_381Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// It is supported for end-user keys when built with the bls12381 build
+	// tag, but not by ledgers.
 	Bls12_381Type = PubKeyType("bls12_381")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Bls12_381 uses the BLS12-381 curve, with keys derived following EIP-2333.
+	// It requires the bls12381 build tag.
+	Bls12_381 = bls12381Algo{}
+)
+
+// ErrBls12381Disabled is returned when deriving a BLS12-381 key from a binary
+// built without the bls12381 build tag.
+var ErrBls12381Disabled = errors.New("bls12_381 keys are not enabled, build with the bls12381 build tag")
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -69,3 +86,74 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type bls12381Algo struct{}
+
+func (s bls12381Algo) Name() PubKeyType {
+	return Bls12_381Type
+}
+
+// Derive derives and returns the BLS12-381 private key for the given seed and
+// path, following EIP-2333. Every index of the path is derived as a hardened
+// child, as EIP-2333 has no public derivation, so that m/12381/3600/0/0/0 and
+// m/12381'/3600'/0'/0'/0' derive the same key. An empty path returns the
+// master key.
+func (s bls12381Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		if !bls12381.Enabled {
+			return nil, ErrBls12381Disabled
+		}
+
+		path, err := parseEIP2333Path(hdPath)
+		if err != nil {
+			return nil, err
+		}
+
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		privKey, err := bls12_381.DerivePrivKeyEIP2333(seed, path)
+		if err != nil {
+			return nil, err
+		}
+
+		return privKey.Key, nil
+	}
+}
+
+// Generate generates a BLS12-381 private key from the given bytes.
+func (s bls12381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		bzArr := make([]byte, bls12381.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &bls12_381.PrivKey{Key: bzArr}
+	}
+}
+
+// parseEIP2333Path parses the indexes of an EIP-2333 derivation path such as
+// m/12381/3600/0/0/0. The hardened suffix of the indexes is accepted and
+// ignored.
+func parseEIP2333Path(path string) ([]uint32, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(strings.TrimRight(path, "/"), "/")
+	if strings.TrimSpace(parts[0]) != "m" {
+		return nil, fmt.Errorf("EIP-2333 path %s must start with m/", path)
+	}
+
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		idx, err := strconv.ParseUint(strings.TrimSuffix(part, "'"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EIP-2333 path %s: %w", path, err)
+		}
+		indexes = append(indexes, uint32(idx))
+	}
+
+	return indexes, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/99designs/keyring"
 	"github.com/cockroachdb/errors"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cosmos/go-bip39"
 	"golang.org/x/crypto/bcrypt"
 
@@ -213,6 +214,11 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
+	// BLS12-381 keys are supported when built with the bls12381 build tag,
+	// but not by ledgers
+	if bls12381.Enabled {
+		options.SupportedAlgos = append(options.SupportedAlgos, hd.Bls12_381)
+	}
 
 	for _, optionFn := range opts {
 		optionFn(&options)
@@ -424,7 +430,7 @@ func (ks keystore) SignByAddress(address sdk.Address, msg []byte, signMode signi
 
 func (ks keystore) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error) {
 	if !ks.options.SupportedAlgosLedger.Contains(algo) {
-		return nil, errorsmod.Wrap(ErrUnsupportedSigningAlgo, fmt.Sprintf("signature algo %s is not defined in the keyring options", algo.Name()))
+		return nil, errorsmod.Wrap(ErrUnsupportedSigningAlgo, fmt.Sprintf("signature algo %s is not supported by ledger devices, supported algos: %s", algo.Name(), ks.options.SupportedAlgosLedger))
 	}
 
 	hdPath := hd.NewFundraiserParams(account, coinType, index)
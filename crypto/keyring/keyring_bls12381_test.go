@@ -0,0 +1,195 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package keyring
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	bls12381Path       = "m/12381/3600/0/0/0"
+	bls12381Passphrase = "12345678"
+)
+
+// newBls12381FileKeyring opens a file backend keyring in dir, unlocked with
+// bls12381Passphrase.
+func newBls12381FileKeyring(t *testing.T, dir string) Keyring {
+	t.Helper()
+
+	kr, err := New(t.Name(), BackendFile, dir, strings.NewReader(bls12381Passphrase+"\n"+bls12381Passphrase+"\n"), getCodec())
+	require.NoError(t, err)
+	return kr
+}
+
+func TestBls12381SupportedAlgos(t *testing.T) {
+	kr := newBls12381FileKeyring(t, t.TempDir())
+
+	algos, ledgerAlgos := kr.SupportedAlgorithms()
+	require.True(t, algos.Contains(hd.Bls12_381))
+	require.False(t, ledgerAlgos.Contains(hd.Bls12_381))
+
+	algo, err := NewSigningAlgoFromString(string(hd.Bls12_381Type), algos)
+	require.NoError(t, err)
+	require.Equal(t, hd.Bls12_381, algo)
+
+	// ledger devices cannot hold BLS12-381 keys
+	_, err = kr.SaveLedgerKey("ledger", hd.Bls12_381, "cosmos", 118, 0, 0)
+	require.ErrorIs(t, err, ErrUnsupportedSigningAlgo)
+	require.ErrorContains(t, err, "signature algo bls12_381 is not supported by ledger devices")
+}
+
+func TestBls12381AddSignVerify(t *testing.T) {
+	dir := t.TempDir()
+	kr := newBls12381FileKeyring(t, dir)
+
+	k, mnemonic, err := kr.NewMnemonic("bls", English, bls12381Path, DefaultBIP39Passphrase, hd.Bls12_381)
+	require.NoError(t, err)
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &bls12_381.PubKey{}, pub)
+
+	// the derivation is deterministic, and depends on the path
+	recovered, err := NewInMemory(getCodec()).NewAccount("recovered", mnemonic, DefaultBIP39Passphrase, bls12381Path, hd.Bls12_381)
+	require.NoError(t, err)
+	recoveredPub, err := recovered.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pub.Equals(recoveredPub))
+
+	other, err := NewInMemory(getCodec()).NewAccount("other", mnemonic, DefaultBIP39Passphrase, "m/12381/3600/1/0/0", hd.Bls12_381)
+	require.NoError(t, err)
+	otherPub, err := other.GetPubKey()
+	require.NoError(t, err)
+	require.False(t, pub.Equals(otherPub))
+
+	_, err = NewInMemory(getCodec()).NewAccount("invalid", mnemonic, DefaultBIP39Passphrase, "12381/3600", hd.Bls12_381)
+	require.ErrorContains(t, err, "must start with m/")
+
+	msg := []byte("BLS12-381 keyring message")
+	sig, signPub, err := kr.Sign("bls", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(signPub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the key is read back from the files by a new keyring
+	kr = newBls12381FileKeyring(t, dir)
+	k, err = kr.Key("bls")
+	require.NoError(t, err)
+	storedPub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pub.Equals(storedPub))
+
+	sig, _, err = kr.Sign("bls", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+}
+
+func TestBls12381ExportImport(t *testing.T) {
+	kr := newBls12381FileKeyring(t, t.TempDir())
+
+	k, _, err := kr.NewMnemonic("bls", English, bls12381Path, DefaultBIP39Passphrase, hd.Bls12_381)
+	require.NoError(t, err)
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+
+	armor, err := kr.ExportPrivKeyArmor("bls", "apassphrase")
+	require.NoError(t, err)
+	require.Contains(t, armor, "type: bls12_381")
+
+	require.NoError(t, kr.Delete("bls"))
+	require.NoError(t, kr.ImportPrivKey("imported", armor, "apassphrase"))
+
+	imported, err := kr.Key("imported")
+	require.NoError(t, err)
+	importedPub, err := imported.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pub.Equals(importedPub))
+
+	msg := []byte("BLS12-381 imported key message")
+	sig, _, err := kr.Sign("imported", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the private key can be imported from its hex encoding too
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	require.NoError(t, kr.ImportPrivKeyHex("hex", "0x"+hex.EncodeToString(privKey.Bytes()), string(hd.Bls12_381Type)))
+	hexRecord, err := kr.Key("hex")
+	require.NoError(t, err)
+	hexPub, err := hexRecord.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(hexPub))
+}
+
+func TestBls12381AminoJSON(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	cryptocodec.RegisterCrypto(cdc)
+
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+
+	bz, err := cdc.MarshalJSON(&privKey)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"type":"cometbft/PrivKeyBls12_381"`)
+
+	var decoded types.PrivKey
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	require.True(t, privKey.Equals(decoded))
+
+	bz, err = cdc.MarshalJSON(privKey.PubKey())
+	require.NoError(t, err)
+
+	var decodedPub types.PubKey
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decodedPub))
+	require.True(t, privKey.PubKey().Equals(decodedPub))
+}
+
+func TestBls12381OfflineMultisig(t *testing.T) {
+	cdc := getCodec()
+	kr := NewInMemory(cdc)
+
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+
+	// offline keys are parsed from the JSON of their pubkey
+	bz, err := cdc.MarshalInterfaceJSON(privKey.PubKey())
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "/cosmos.crypto.bls12_381.PubKey")
+
+	var pub types.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &pub))
+	require.True(t, privKey.PubKey().Equals(pub))
+
+	offline, err := kr.SaveOfflineKey("offline", pub)
+	require.NoError(t, err)
+	offlinePub, err := offline.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pub.Equals(offlinePub))
+
+	// multisig pubkeys can mix BLS12-381 and secp256k1 keys
+	multi := multisig.NewLegacyAminoPubKey(2, []types.PubKey{pub, secp256k1.GenPrivKey().PubKey()})
+	bz, err = cdc.MarshalInterfaceJSON(multi)
+	require.NoError(t, err)
+
+	var multiPub types.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &multiPub))
+	require.True(t, multi.Equals(multiPub))
+
+	record, err := kr.SaveMultisig("multi", multiPub)
+	require.NoError(t, err)
+	storedMulti, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, multi.Equals(storedMulti))
+}
@@ -0,0 +1,30 @@
+//go:build !bls12381
+
+package bls12_381
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures aggregates compressed signatures into a single compressed
+// signature.
+func AggregateSignatures(_ [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// AggregatePublicKeys aggregates public keys into a single public key.
+func AggregatePublicKeys(_ []*PubKey) (*PubKey, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignature verifies an aggregated signature of the same message
+// by all the given public keys.
+func VerifyAggregateSignature(_ []*PubKey, _, _ []byte) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignatureDistinct verifies an aggregated signature of distinct
+// messages, where msgs[i] was signed by pks[i].
+func VerifyAggregateSignatureDistinct(_ []*PubKey, _ [][]byte, _ []byte) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
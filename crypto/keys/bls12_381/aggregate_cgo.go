@@ -0,0 +1,174 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures aggregates compressed signatures into a single compressed
+// signature. Every signature is group checked and the infinity point is rejected.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	agg := new(blst.P2Aggregate)
+	for i, bz := range sigs {
+		sig, err := decodeSignature(bz)
+		if err != nil {
+			return nil, fmt.Errorf("signature %d: %w", i, err)
+		}
+		agg.Add(sig, false)
+	}
+
+	return agg.ToAffine().Compress(), nil
+}
+
+// AggregatePublicKeys aggregates public keys into a single public key. Every
+// public key is group checked and the infinity point is rejected, both for the
+// given keys and for the aggregated key. Duplicated keys are not rejected, a key
+// given twice is added twice.
+//
+// The aggregated key is only safe to use for keys whose possession has been
+// proven, as it is otherwise open to rogue key attacks.
+func AggregatePublicKeys(pks []*PubKey) (*PubKey, error) {
+	if len(pks) == 0 {
+		return nil, errors.New("no public keys to aggregate")
+	}
+
+	agg := new(blst.P1Aggregate)
+	for i, pubKey := range pks {
+		pk, err := decodePubKey(pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("public key %d: %w", i, err)
+		}
+		agg.Add(pk, false)
+	}
+
+	aggPk := agg.ToAffine()
+	if !aggPk.KeyValidate() {
+		return nil, errors.New("aggregated public key is the infinity point")
+	}
+
+	return &PubKey{Key: aggPk.Serialize()}, nil
+}
+
+// VerifyAggregateSignature verifies an aggregated signature of the same message
+// by all the given public keys. It returns false if any of the public keys or
+// the signature is invalid. A public key given twice must have signed twice.
+//
+// As for AggregatePublicKeys, the possession of the keys must have been proven.
+func VerifyAggregateSignature(pks []*PubKey, msg, aggSig []byte) bool {
+	if len(pks) == 0 {
+		return false
+	}
+
+	pubKeys, ok := decodePubKeys(pks)
+	if !ok {
+		return false
+	}
+
+	sig, err := decodeSignature(aggSig)
+	if err != nil {
+		return false
+	}
+
+	return sig.FastAggregateVerify(false, pubKeys, msg, dstMinPk)
+}
+
+// VerifyAggregateSignatureDistinct verifies an aggregated signature of distinct
+// messages, where msgs[i] was signed by pks[i]. It returns false if any of the
+// public keys or the signature is invalid, or if the messages are not distinct.
+func VerifyAggregateSignatureDistinct(pks []*PubKey, msgs [][]byte, aggSig []byte) bool {
+	if len(pks) == 0 || len(pks) != len(msgs) {
+		return false
+	}
+
+	// the messages must be distinct, otherwise the signature is open to rogue key attacks
+	seen := make(map[string]struct{}, len(msgs))
+	for _, msg := range msgs {
+		if _, ok := seen[string(msg)]; ok {
+			return false
+		}
+		seen[string(msg)] = struct{}{}
+	}
+
+	pubKeys, ok := decodePubKeys(pks)
+	if !ok {
+		return false
+	}
+
+	sig, err := decodeSignature(aggSig)
+	if err != nil {
+		return false
+	}
+
+	blstMsgs := make([]blst.Message, len(msgs))
+	for i, msg := range msgs {
+		blstMsgs[i] = msg
+	}
+
+	return sig.AggregateVerify(false, pubKeys, false, blstMsgs, dstMinPk)
+}
+
+// decodeSignature uncompresses and group checks a signature, rejecting the
+// infinity point.
+func decodeSignature(bz []byte) (*blst.P2Affine, error) {
+	if len(bz) != bls12381.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length %d", len(bz))
+	}
+
+	sig := new(blst.P2Affine).Uncompress(bz)
+	if sig == nil {
+		return nil, errors.New("failed to deserialize signature")
+	}
+
+	if !sig.SigValidate(true) {
+		return nil, errors.New("signature is not in the group or is the infinity point")
+	}
+
+	return sig, nil
+}
+
+// decodePubKey deserializes and group checks a public key, rejecting the
+// infinity point.
+func decodePubKey(pubKey *PubKey) (*blst.P1Affine, error) {
+	if pubKey == nil {
+		return nil, errors.New("nil public key")
+	}
+
+	pk := new(blst.P1Affine).Deserialize(pubKey.Key)
+	if pk == nil {
+		return nil, errors.New("failed to deserialize public key")
+	}
+
+	if !pk.KeyValidate() {
+		return nil, errors.New("public key is not in the group or is the infinity point")
+	}
+
+	return pk, nil
+}
+
+// decodePubKeys decodes public keys with decodePubKey and returns false if any
+// of them is invalid.
+func decodePubKeys(pks []*PubKey) ([]*blst.P1Affine, bool) {
+	pubKeys := make([]*blst.P1Affine, len(pks))
+	for i, pubKey := range pks {
+		pk, err := decodePubKey(pubKey)
+		if err != nil {
+			return nil, false
+		}
+		pubKeys[i] = pk
+	}
+
+	return pubKeys, true
+}
@@ -0,0 +1,244 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func genAggregateKeys(t *testing.T, n int) ([]PrivKey, []*PubKey) {
+	t.Helper()
+	privs := make([]PrivKey, n)
+	pubs := make([]*PubKey, n)
+	for i := range privs {
+		priv, err := GenPrivKey()
+		require.NoError(t, err)
+		privs[i] = priv
+		pubs[i] = priv.PubKey().(*PubKey)
+	}
+	return privs, pubs
+}
+
+func TestAggregateSignatureSameMessage(t *testing.T) {
+	msg := []byte("attestation")
+	privs, pubs := genAggregateKeys(t, 4)
+
+	sigs := make([][]byte, len(privs))
+	for i, priv := range privs {
+		var err error
+		sigs[i], err = priv.Sign(msg)
+		require.NoError(t, err)
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, VerifyAggregateSignature(pubs, msg, aggSig))
+	require.False(t, VerifyAggregateSignature(pubs, []byte("other message"), aggSig))
+	require.False(t, VerifyAggregateSignature(pubs[1:], msg, aggSig))
+
+	// the aggregated public key verifies the aggregated signature
+	aggPub, err := AggregatePublicKeys(pubs)
+	require.NoError(t, err)
+	require.True(t, aggPub.VerifySignature(msg, aggSig))
+
+	// swapping any one signer fails the verification
+	_, others := genAggregateKeys(t, len(pubs))
+	for i := range pubs {
+		swapped := append([]*PubKey(nil), pubs...)
+		swapped[i] = others[i]
+		require.False(t, VerifyAggregateSignature(swapped, msg, aggSig), "signer %d", i)
+	}
+}
+
+func TestAggregateSignatureDistinctMessages(t *testing.T) {
+	privs, pubs := genAggregateKeys(t, 4)
+
+	msgs := make([][]byte, len(privs))
+	sigs := make([][]byte, len(privs))
+	for i, priv := range privs {
+		msgs[i] = []byte(fmt.Sprintf("message %d", i))
+		var err error
+		sigs[i], err = priv.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, VerifyAggregateSignatureDistinct(pubs, msgs, aggSig))
+	require.False(t, VerifyAggregateSignatureDistinct(pubs[1:], msgs[1:], aggSig))
+	require.False(t, VerifyAggregateSignatureDistinct(pubs, msgs[1:], aggSig))
+
+	// the messages must be in the order of the signers
+	reordered := append([][]byte{msgs[1], msgs[0]}, msgs[2:]...)
+	require.False(t, VerifyAggregateSignatureDistinct(pubs, reordered, aggSig))
+
+	// duplicated messages are rejected
+	duplicated := append([][]byte{msgs[0]}, msgs[:len(msgs)-1]...)
+	require.False(t, VerifyAggregateSignatureDistinct(pubs, duplicated, aggSig))
+
+	// swapping any one signer fails the verification
+	_, others := genAggregateKeys(t, len(pubs))
+	for i := range pubs {
+		swapped := append([]*PubKey(nil), pubs...)
+		swapped[i] = others[i]
+		require.False(t, VerifyAggregateSignatureDistinct(swapped, msgs, aggSig), "signer %d", i)
+	}
+}
+
+func TestAggregateSingleSignature(t *testing.T) {
+	msg := []byte("single")
+	privs, pubs := genAggregateKeys(t, 1)
+	sig, err := privs[0].Sign(msg)
+	require.NoError(t, err)
+
+	aggSig, err := AggregateSignatures([][]byte{sig})
+	require.NoError(t, err)
+	require.Equal(t, sig, aggSig)
+
+	aggPub, err := AggregatePublicKeys(pubs)
+	require.NoError(t, err)
+	require.True(t, aggPub.Equals(pubs[0]))
+
+	require.True(t, VerifyAggregateSignature(pubs, msg, aggSig))
+	require.True(t, VerifyAggregateSignatureDistinct(pubs, [][]byte{msg}, aggSig))
+}
+
+func TestAggregateInvalidInput(t *testing.T) {
+	msg := []byte("invalid")
+	privs, pubs := genAggregateKeys(t, 2)
+	sig, err := privs[0].Sign(msg)
+	require.NoError(t, err)
+
+	// empty inputs
+	_, err = AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = AggregatePublicKeys(nil)
+	require.Error(t, err)
+	require.False(t, VerifyAggregateSignature(nil, msg, sig))
+	require.False(t, VerifyAggregateSignatureDistinct(nil, nil, sig))
+
+	// invalid compressed signatures fail to deserialize
+	infinity := make([]byte, len(sig))
+	infinity[0] = 0xc0 // compressed infinity point
+	for name, bad := range map[string][]byte{
+		"short":    sig[1:],
+		"zeroes":   make([]byte, len(sig)),
+		"infinity": infinity,
+	} {
+		_, err = AggregateSignatures([][]byte{sig, bad})
+		require.Error(t, err, name)
+		require.False(t, VerifyAggregateSignature(pubs[:1], msg, bad), name)
+	}
+
+	// invalid public keys are rejected
+	infinityPub := &PubKey{Key: make([]byte, len(pubs[0].Key))}
+	infinityPub.Key[0] = 0x40 // uncompressed infinity point
+	for name, bad := range map[string]*PubKey{
+		"nil":      nil,
+		"short":    {Key: pubs[1].Key[1:]},
+		"zeroes":   {Key: make([]byte, len(pubs[1].Key))},
+		"infinity": infinityPub,
+	} {
+		_, err = AggregatePublicKeys([]*PubKey{pubs[0], bad})
+		require.Error(t, err, name)
+		require.False(t, VerifyAggregateSignature([]*PubKey{pubs[0], bad}, msg, sig), name)
+	}
+}
+
+// TestAggregateConcurrentUse checks that the aggregation and the aggregate
+// verification can run on shared keys from many goroutines at once, run it
+// with -race.
+func TestAggregateConcurrentUse(t *testing.T) {
+	const (
+		goroutines = 32
+		iterations = 10
+	)
+
+	msg := []byte("attestation")
+	privs, pubs := genAggregateKeys(t, 4)
+
+	msgs := make([][]byte, len(privs))
+	sigs := make([][]byte, len(privs))
+	distinctSigs := make([][]byte, len(privs))
+	for i, priv := range privs {
+		var err error
+		sigs[i], err = priv.Sign(msg)
+		require.NoError(t, err)
+		msgs[i] = []byte(fmt.Sprintf("message %d", i))
+		distinctSigs[i], err = priv.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	distinctAggSig, err := AggregateSignatures(distinctSigs)
+	require.NoError(t, err)
+	aggPub, err := AggregatePublicKeys(pubs)
+	require.NoError(t, err)
+
+	pubKeysBz := make([][]byte, len(pubs))
+	for i, pub := range pubs {
+		pubKeysBz[i] = bytes.Clone(pub.Key)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < iterations; j++ {
+				// the aggregation is deterministic
+				sig, err := AggregateSignatures(sigs)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !bytes.Equal(aggSig, sig) {
+					errs <- fmt.Errorf("goroutine %d: unexpected aggregated signature", i)
+					return
+				}
+
+				pub, err := AggregatePublicKeys(pubs)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !pub.Equals(aggPub) {
+					errs <- fmt.Errorf("goroutine %d: unexpected aggregated public key", i)
+					return
+				}
+
+				if !VerifyAggregateSignature(pubs, msg, aggSig) {
+					errs <- fmt.Errorf("goroutine %d: valid aggregated signature rejected", i)
+					return
+				}
+				if !VerifyAggregateSignatureDistinct(pubs, msgs, distinctAggSig) {
+					errs <- fmt.Errorf("goroutine %d: valid distinct aggregated signature rejected", i)
+					return
+				}
+				if VerifyAggregateSignature(pubs[1:], msg, aggSig) {
+					errs <- fmt.Errorf("goroutine %d: invalid aggregated signature accepted", i)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// no function modified the keys
+	for i, pub := range pubs {
+		require.Equal(t, pubKeysBz[i], pub.Key)
+	}
+}
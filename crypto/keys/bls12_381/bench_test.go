@@ -0,0 +1,94 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/benchmarking"
+)
+
+func BenchmarkSigning(b *testing.B) {
+	b.ReportAllocs()
+	priv, err := GenPrivKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarking.BenchmarkSigning(b, &priv)
+}
+
+func BenchmarkVerification(b *testing.B) {
+	b.ReportAllocs()
+	priv, err := GenPrivKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarking.BenchmarkVerification(b, &priv)
+}
+
+// BenchmarkVerificationParallel verifies signatures of a single pubkey from
+// all the available goroutines, run it with -cpu 1,2,4,8 to see how the
+// verification throughput scales with GOMAXPROCS.
+func BenchmarkVerificationParallel(b *testing.B) {
+	b.ReportAllocs()
+	priv, err := GenPrivKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	pub := priv.PubKey()
+
+	msg := []byte("hello world")
+	sig, err := priv.Sign(msg)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if !pub.VerifySignature(msg, sig) {
+				b.Error("signature verification failed")
+			}
+		}
+	})
+}
+
+// BenchmarkVerificationAllocs compares the allocations of the pooled
+// verification with deserializing new blst objects for every signature.
+func BenchmarkVerificationAllocs(b *testing.B) {
+	priv, err := GenPrivKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	pub := priv.PubKey()
+
+	msg := []byte("hello world")
+	sig, err := priv.Sign(msg)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if !pub.VerifySignature(msg, sig) {
+				b.Fatal("signature verification failed")
+			}
+		}
+	})
+
+	b.Run("unpooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			pk, err := bls12381.NewPublicKeyFromBytes(pub.Bytes())
+			if err != nil {
+				b.Fatal(err)
+			}
+			if !pk.VerifySignature(msg, sig) {
+				b.Fatal("signature verification failed")
+			}
+		}
+	})
+}
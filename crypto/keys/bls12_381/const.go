@@ -0,0 +1,14 @@
+package bls12_381
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
+
+// AddressSize is the size of the address of a public key, the truncated
+// SHA-256 hash of the serialized key.
+const AddressSize = tmhash.TruncatedSize
+
+// ErrZeroizedKey is returned when signing with a zeroized private key.
+var ErrZeroizedKey = errors.New("bls12_381: private key is zeroized")
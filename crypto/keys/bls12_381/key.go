@@ -37,6 +37,12 @@ func GenPrivKey() (PrivKey, error) {
 	panic("not implemented, build flags are required to use bls12_381 keys")
 }
 
+// DerivePrivKeyEIP2333 derives the private key at the given path from a seed
+// of at least 32 bytes, following EIP-2333.
+func DerivePrivKeyEIP2333(seed []byte, path []uint32) (PrivKey, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
 // Bytes returns the byte representation of the Key.
 func (privKey PrivKey) Bytes() []byte {
 	panic("not implemented, build flags are required to use bls12_381 keys")
@@ -64,6 +70,19 @@ func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
 	panic("not implemented, build flags are required to use bls12_381 keys")
 }
 
+// Zeroize overwrites the key bytes with zeros in place, clearing the secret
+// from the memory shared with every slice returned by Bytes. The key cannot
+// sign afterwards.
+func (privKey *PrivKey) Zeroize() {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// IsZeroized returns true if all the key bytes are zero, as after Zeroize. A key
+// which is not PrivKeySize bytes long, such as an empty key, is not zeroized.
+func (privKey PrivKey) IsZeroized() bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
 // MarshalAmino overrides Amino binary marshaling.
 func (privKey PrivKey) MarshalAmino() ([]byte, error) {
 	return privKey.Key, nil
@@ -4,12 +4,15 @@ package bls12_381
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	blst "github.com/supranational/blst/bindings/go"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -22,6 +25,10 @@ import (
 // PrivKey is a wrapper around the Ethereum BLS12-381 private key type. This
 // wrapper conforms to crypto.Pubkey to allow for the use of the Ethereum
 // BLS12-381 private key type.
+//
+// The methods of PrivKey other than Zeroize never modify the Key slice and are
+// safe for concurrent use, as every operation deserializes its own blst secret
+// key, zeroized once the operation is done.
 
 var (
 	_ cryptotypes.PrivKey  = &PrivKey{}
@@ -47,6 +54,28 @@ func GenPrivKey() (PrivKey, error) {
 	}, err
 }
 
+// DerivePrivKeyEIP2333 derives the private key at the given path from a seed
+// of at least 32 bytes, following EIP-2333: the master key is derived from the
+// seed, then a child key is derived for every index of the path. The
+// intermediate keys are zeroized.
+func DerivePrivKeyEIP2333(seed []byte, path []uint32) (PrivKey, error) {
+	if len(seed) < 32 {
+		return PrivKey{}, errors.New("seed must be at least 32 bytes")
+	}
+
+	secretKey := blst.DeriveMasterEip2333(seed)
+	for _, index := range path {
+		child := secretKey.DeriveChildEip2333(index)
+		secretKey.Zeroize()
+		secretKey = child
+	}
+	defer secretKey.Zeroize()
+
+	return PrivKey{
+		Key: secretKey.Serialize(),
+	}, nil
+}
+
 // Bytes returns the byte representation of the Key.
 func (privKey PrivKey) Bytes() []byte {
 	return privKey.Key
@@ -59,15 +88,17 @@ func (privKey PrivKey) PubKey() cryptotypes.PubKey {
 	if err != nil {
 		return nil
 	}
+	defer secretKey.Zeroize()
 
 	return &PubKey{
 		Key: secretKey.PubKey().Bytes(),
 	}
 }
 
-// Equals returns true if two keys are equal and false otherwise.
+// Equals returns true if two keys are equal and false otherwise. The key bytes
+// are compared in constant time.
 func (privKey PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
-	return privKey.Type() == other.Type() && bytes.Equal(privKey.Bytes(), other.Bytes())
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
 }
 
 // Type returns the type.
@@ -77,15 +108,43 @@ func (PrivKey) Type() string {
 
 // Sign signs the given byte array. If msg is larger than
 // MaxMsgLen, SHA256 sum will be signed instead of the raw bytes.
+// Signing with a zeroized key fails with ErrZeroizedKey.
 func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	if privKey.IsZeroized() {
+		return nil, ErrZeroizedKey
+	}
+
 	secretKey, err := bls12381.NewPrivateKeyFromBytes(privKey.Key)
 	if err != nil {
 		return nil, err
 	}
+	defer secretKey.Zeroize()
 
 	return secretKey.Sign(msg)
 }
 
+// Zeroize overwrites the key bytes with zeros in place, clearing the secret
+// from the memory shared with every slice returned by Bytes. The key cannot
+// sign afterwards.
+func (privKey *PrivKey) Zeroize() {
+	clear(privKey.Key)
+}
+
+// IsZeroized returns true if all the key bytes are zero, as after Zeroize. A key
+// which is not PrivKeySize bytes long, such as an empty key, is not zeroized.
+func (privKey PrivKey) IsZeroized() bool {
+	if len(privKey.Key) != bls12381.PrivKeySize {
+		return false
+	}
+
+	var acc byte
+	for _, b := range privKey.Key {
+		acc |= b
+	}
+
+	return acc == 0
+}
+
 // MarshalAmino overrides Amino binary marshaling.
 func (privKey PrivKey) MarshalAmino() ([]byte, error) {
 	return privKey.Key, nil
@@ -120,10 +179,33 @@ func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
 // Pubkey is a wrapper around the Ethereum BLS12-381 public key type. This
 // wrapper conforms to crypto.Pubkey to allow for the use of the Ethereum
 // BLS12-381 public key type.
+//
+// The methods of PubKey never modify the Key slice and are safe for concurrent
+// use. Signature verification deserializes the key and the signature into
+// scratch objects taken from a pool, which are never shared between goroutines.
 
 var _ cryptotypes.PubKey = &PubKey{}
 
-// Address returns the address of the key.
+// dstMinPk is the domain separation tag of the minimal-pubkey-size scheme
+// used by CometBFT.
+var dstMinPk = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
+// verifyScratch holds the blst objects a signature verification deserializes
+// the public key and the signature into.
+type verifyScratch struct {
+	pk  blst.P1Affine
+	sig blst.P2Affine
+}
+
+// verifyScratchPool reuses the verification scratch objects, so that hot
+// verification loops do not allocate them for every signature.
+var verifyScratchPool = sync.Pool{
+	New: func() any { return new(verifyScratch) },
+}
+
+// Address returns the address of the key, the first AddressSize bytes of the
+// SHA-256 hash of the serialized key. It matches the address CometBFT derives
+// for the same key.
 //
 // The function will panic if the public key is invalid.
 func (pubKey PubKey) Address() crypto.Address {
@@ -140,12 +222,27 @@ func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
 		return false
 	}
 
-	pubK, err := bls12381.NewPublicKeyFromBytes(pubKey.Key)
-	if err != nil { // invalid pubkey
+	scratch := verifyScratchPool.Get().(*verifyScratch)
+	defer verifyScratchPool.Put(scratch)
+
+	// deserialize and subgroup check the pubkey, rejecting the infinity point
+	pk := scratch.pk.Deserialize(pubKey.Key)
+	if pk == nil || !pk.KeyValidate() { // invalid pubkey
+		return false
+	}
+
+	signature := scratch.sig.Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	// Group check signature. Do not check for infinity since an aggregated signature
+	// could be infinite.
+	if !signature.SigValidate(false) {
 		return false
 	}
 
-	return pubK.VerifySignature(msg, sig)
+	return signature.Verify(false, pk, false, msg, dstMinPk)
 }
 
 // Bytes returns the byte format.
@@ -0,0 +1,208 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivKeySignVerify(t *testing.T) {
+	msg := []byte("A.1.2 ECC Key Pair Generation by Testing Candidates")
+	priv, err := GenPrivKey()
+	require.NoError(t, err)
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+
+	pub := priv.PubKey()
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the min-pk variant serializes uncompressed G1 public keys and compressed G2 signatures
+	require.Len(t, pub.Bytes(), bls12381.PubKeySize)
+	require.Len(t, sig, bls12381.SignatureLength)
+	require.Equal(t, pub.Address(), priv.PubKey().Address())
+	require.False(t, pub.VerifySignature([]byte("other message"), sig))
+
+	// a signature of another key must not verify
+	other, err := GenPrivKey()
+	require.NoError(t, err)
+	require.False(t, other.PubKey().VerifySignature(msg, sig))
+
+	// malformed signatures and pubkeys must not verify
+	require.False(t, pub.VerifySignature(msg, sig[1:]))
+	require.False(t, pub.VerifySignature(msg, make([]byte, len(sig))))
+	require.False(t, (&PubKey{Key: make([]byte, len(pub.Bytes()))}).VerifySignature(msg, sig))
+	require.False(t, (&PubKey{Key: pub.Bytes()[1:]}).VerifySignature(msg, sig))
+}
+
+func TestDerivePrivKeyEIP2333(t *testing.T) {
+	// test case 0 of EIP-2333
+	seed, err := hex.DecodeString("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+	require.NoError(t, err)
+	masterSK, _ := new(big.Int).SetString("6083874454709270928345386274498605044986640685124978867557563392430687146096", 10)
+	childSK, _ := new(big.Int).SetString("20397789859736650942317412262472558107875392172444076792671091975210932703118", 10)
+
+	master, err := DerivePrivKeyEIP2333(seed, nil)
+	require.NoError(t, err)
+	require.Equal(t, masterSK.FillBytes(make([]byte, bls12381.PrivKeySize)), master.Bytes())
+
+	child, err := DerivePrivKeyEIP2333(seed, []uint32{0})
+	require.NoError(t, err)
+	require.Equal(t, childSK.FillBytes(make([]byte, bls12381.PrivKeySize)), child.Bytes())
+
+	_, err = DerivePrivKeyEIP2333(seed[:31], nil)
+	require.Error(t, err)
+}
+
+func TestPubKeyAddress(t *testing.T) {
+	priv, err := GenPrivKey()
+	require.NoError(t, err)
+	pub := priv.PubKey()
+
+	addr := pub.Address()
+	require.Len(t, addr, AddressSize)
+	require.Equal(t, addr, (&PubKey{Key: bytes.Clone(pub.Bytes())}).Address())
+
+	// the address is the one CometBFT derives for the consensus key
+	cmtPub, err := bls12381.NewPublicKeyFromBytes(pub.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, cmtPub.Address(), addr)
+
+	other, err := GenPrivKey()
+	require.NoError(t, err)
+	require.NotEqual(t, addr, other.PubKey().Address())
+}
+
+// TestConcurrentUse checks that a single key can be used from many goroutines
+// at once, run it with -race.
+func TestConcurrentUse(t *testing.T) {
+	const (
+		goroutines = 64
+		iterations = 20
+	)
+
+	priv, err := GenPrivKey()
+	require.NoError(t, err)
+	pub := priv.PubKey().(*PubKey)
+
+	privKeyBz := bytes.Clone(priv.Key)
+	pubKeyBz := bytes.Clone(pub.Key)
+
+	msgs := make([][]byte, goroutines)
+	sigs := make([][]byte, goroutines)
+	for i := range msgs {
+		msgs[i] = []byte(fmt.Sprintf("message %d", i))
+		sigs[i], err = priv.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < iterations; j++ {
+				// signing is deterministic
+				sig, err := priv.Sign(msgs[i])
+				if err != nil {
+					errs <- err
+					return
+				}
+				if !bytes.Equal(sigs[i], sig) {
+					errs <- fmt.Errorf("goroutine %d: unexpected signature", i)
+					return
+				}
+
+				if !pub.VerifySignature(msgs[i], sigs[i]) {
+					errs <- fmt.Errorf("goroutine %d: valid signature rejected", i)
+					return
+				}
+
+				// the signature of the next message must not verify
+				next := (i + 1) % goroutines
+				if pub.VerifySignature(msgs[i], sigs[next]) {
+					errs <- fmt.Errorf("goroutine %d: invalid signature accepted", i)
+					return
+				}
+
+				if !bytes.Equal(pub.Address(), priv.PubKey().Address()) {
+					errs <- fmt.Errorf("goroutine %d: unexpected address", i)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// no method modified the keys
+	require.Equal(t, privKeyBz, priv.Key)
+	require.Equal(t, pubKeyBz, pub.Key)
+}
+
+func TestPrivKeyEquals(t *testing.T) {
+	priv, err := GenPrivKey()
+	require.NoError(t, err)
+	other, err := GenPrivKey()
+	require.NoError(t, err)
+
+	require.True(t, priv.Equals(&PrivKey{Key: bytes.Clone(priv.Key)}))
+	require.False(t, priv.Equals(other))
+	require.False(t, priv.Equals(&PrivKey{Key: priv.Key[1:]}))
+}
+
+func TestPrivKeyZeroize(t *testing.T) {
+	msg := []byte("message")
+	priv, err := GenPrivKey()
+	require.NoError(t, err)
+	require.False(t, priv.IsZeroized())
+
+	bz := priv.Bytes()
+	data := reflect.ValueOf(priv.Key).Pointer()
+	priv.Zeroize()
+
+	// the key bytes are overwritten in place, so every alias is cleared too
+	require.True(t, priv.IsZeroized())
+	require.Equal(t, data, reflect.ValueOf(priv.Key).Pointer())
+	require.Len(t, priv.Key, bls12381.PrivKeySize)
+	backing := reflect.ValueOf(bz)
+	for i := 0; i < backing.Len(); i++ {
+		require.Zero(t, backing.Index(i).Uint(), "byte %d", i)
+	}
+	require.Equal(t, make([]byte, bls12381.PrivKeySize), priv.Bytes())
+
+	// signing fails rather than signing with a zero scalar
+	for i := 0; i < 2; i++ {
+		sig, err := priv.Sign(msg)
+		require.ErrorIs(t, err, ErrZeroizedKey)
+		require.Nil(t, sig)
+	}
+}
+
+func TestPrivKeyIsZeroizedInvalidLength(t *testing.T) {
+	for _, key := range [][]byte{nil, {}, make([]byte, bls12381.PrivKeySize-1), make([]byte, bls12381.PrivKeySize+1)} {
+		priv := PrivKey{Key: key}
+		require.False(t, priv.IsZeroized(), "key of %d bytes", len(key))
+
+		// signing fails on the invalid key itself
+		sig, err := priv.Sign([]byte("message"))
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrZeroizedKey)
+		require.Nil(t, sig)
+	}
+}
@@ -0,0 +1,206 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/go-metrics"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+func TestMetricsContentNegotiation(t *testing.T) {
+	newServer := func(t *testing.T, prometheusRetention int64) *api.Server {
+		t.Helper()
+
+		//nolint:staticcheck // TODO: switch to OpenTelemetry
+		m, err := telemetry.New(telemetry.Config{
+			Enabled:                 true,
+			ServiceName:             "test",
+			PrometheusRetentionTime: prometheusRetention,
+		})
+		require.NoError(t, err)
+		t.Cleanup(m.Close)
+
+		s := &api.Server{Router: mux.NewRouter()}
+		s.SetTelemetry(m)
+		metrics.IncrCounter([]string{"api_counter"}, 1)
+
+		return s
+	}
+
+	scrape := func(t *testing.T, s *api.Server, target, accept string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		rec := httptest.NewRecorder()
+		s.Router.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		return rec
+	}
+
+	t.Run("prometheus enabled", func(t *testing.T) {
+		s := newServer(t, 60)
+
+		specs := map[string]struct {
+			target     string
+			accept     string
+			expType    string
+			assertBody func(t *testing.T, body []byte)
+		}{
+			"no accept header": {
+				target:  "/metrics",
+				expType: "application/json",
+			},
+			"text": {
+				target:  "/metrics",
+				accept:  "text/plain;version=0.0.4",
+				expType: "application/json",
+			},
+			"openmetrics": {
+				target:  "/metrics",
+				accept:  "application/openmetrics-text;version=1.0.0,text/plain;version=0.0.4;q=0.5,*/*;q=0.1",
+				expType: string(expfmt.NewFormat(expfmt.TypeOpenMetrics)),
+				assertBody: func(t *testing.T, body []byte) {
+					t.Helper()
+					require.Contains(t, string(body), "test_api_counter 1.0\n")
+					require.True(t, bytes.HasSuffix(body, []byte("# EOF\n")))
+				},
+			},
+			"protobuf": {
+				target:  "/metrics",
+				accept:  "application/vnd.google.protobuf;proto=io.prometheus.client.MetricFamily;encoding=delimited;q=0.7,text/plain;version=0.0.4;q=0.3",
+				expType: string(expfmt.NewFormat(expfmt.TypeProtoDelim)),
+				assertBody: func(t *testing.T, body []byte) {
+					t.Helper()
+					dec := expfmt.NewDecoder(bytes.NewReader(body), expfmt.NewFormat(expfmt.TypeProtoDelim))
+					for {
+						var mf dto.MetricFamily
+						require.NoError(t, dec.Decode(&mf))
+						if mf.GetName() == "test_api_counter" {
+							require.Equal(t, 1.0, mf.GetMetric()[0].GetCounter().GetValue())
+							return
+						}
+					}
+				},
+			},
+			"format query parameter overrides the accept header": {
+				target:  "/metrics?format=prometheus",
+				accept:  "application/openmetrics-text;version=1.0.0",
+				expType: telemetry.ContentTypeText,
+				assertBody: func(t *testing.T, body []byte) {
+					t.Helper()
+					require.Contains(t, string(body), "test_api_counter 1")
+					require.NotContains(t, string(body), "# EOF")
+				},
+			},
+		}
+		for name, spec := range specs {
+			t.Run(name, func(t *testing.T) {
+				rec := scrape(t, s, spec.target, spec.accept)
+				require.Equal(t, spec.expType, rec.Header().Get("Content-Type"))
+				if spec.assertBody != nil {
+					spec.assertBody(t, rec.Body.Bytes())
+				}
+			})
+		}
+	})
+
+	t.Run("prometheus disabled", func(t *testing.T) {
+		s := newServer(t, 0)
+
+		rec := scrape(t, s, "/metrics", "application/openmetrics-text;version=1.0.0")
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	})
+}
+
+func TestMetricsAuthorization(t *testing.T) {
+	newServer := func(t *testing.T, authToken, basicAuth string) *api.Server {
+		t.Helper()
+
+		//nolint:staticcheck // TODO: switch to OpenTelemetry
+		m, err := telemetry.New(telemetry.Config{
+			Enabled:                 true,
+			ServiceName:             "test",
+			PrometheusRetentionTime: 60,
+			AuthToken:               authToken,
+			BasicAuth:               basicAuth,
+		})
+		require.NoError(t, err)
+		t.Cleanup(m.Close)
+
+		s := &api.Server{Router: mux.NewRouter()}
+		s.SetTelemetry(m)
+		metrics.IncrCounter([]string{"api_counter"}, 1)
+
+		return s
+	}
+
+	scrape := func(t *testing.T, s *api.Server, authorization string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req := httptest.NewRequest(http.MethodGet, "/metrics?format=prometheus", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		s.Router.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	basic := func(credentials string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+	}
+
+	specs := map[string]struct {
+		authToken     string
+		basicAuth     string
+		authorization string
+		authorized    bool
+	}{
+		"no credentials configured":                  {authorized: true},
+		"no credentials configured, header ignored":  {authorization: "Bearer secret", authorized: true},
+		"missing header":                             {authToken: "secret"},
+		"wrong token":                                {authToken: "secret", authorization: "Bearer secre"},
+		"right token":                                {authToken: "secret", authorization: "Bearer secret", authorized: true},
+		"right token, lowercase scheme":              {authToken: "secret", authorization: "bearer secret", authorized: true},
+		"token with the wrong scheme":                {authToken: "secret", authorization: "Token secret"},
+		"basic auth not configured":                  {authToken: "secret", authorization: basic("user:secret")},
+		"wrong basic auth":                           {basicAuth: "user:pass", authorization: basic("user:secret")},
+		"malformed basic auth":                       {basicAuth: "user:pass", authorization: "Basic user:pass"},
+		"right basic auth":                           {basicAuth: "user:pass", authorization: basic("user:pass"), authorized: true},
+		"right token, basic auth configured as well": {authToken: "secret", basicAuth: "user:pass", authorization: "Bearer secret", authorized: true},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			rec := scrape(t, newServer(t, spec.authToken, spec.basicAuth), spec.authorization)
+			if spec.authorized {
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.Contains(t, rec.Body.String(), "test_api_counter 1")
+				return
+			}
+
+			// no metrics are gathered for unauthorized requests
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+			require.Empty(t, rec.Body.Bytes())
+			require.Empty(t, rec.Header().Get("Content-Type"))
+		})
+	}
+
+	//nolint:staticcheck // TODO: switch to OpenTelemetry
+	_, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test", BasicAuth: "user"})
+	require.ErrorContains(t, err, "basic auth credentials must be of the form user:pass")
+}
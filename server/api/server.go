@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -205,7 +206,16 @@ func (s *Server) registerMetrics(m *telemetry.Metrics) {
 	s.metrics = m
 
 	metricsHandler := func(w http.ResponseWriter, r *http.Request) {
+		if !s.metrics.Authorized(r.Header) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		// the format query parameter overrides the content negotiation
 		format := strings.TrimSpace(r.FormValue("format"))
+		if format == "" {
+			format = s.metrics.NegotiateFormat(r.Header)
+		}
 
 		gr, err := s.metrics.Gather(format)
 		if err != nil {
@@ -214,6 +224,9 @@ func (s *Server) registerMetrics(m *telemetry.Metrics) {
 		}
 
 		w.Header().Set("Content-Type", gr.ContentType)
+		if !gr.SnapshotTime.IsZero() {
+			w.Header().Set("Age", strconv.FormatInt(int64(time.Since(gr.SnapshotTime).Seconds()), 10))
+		}
 		_, _ = w.Write(gr.Metrics)
 	}
 
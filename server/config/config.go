@@ -256,8 +256,10 @@ func DefaultConfig() *Config {
 		},
 		//nolint:staticcheck // TODO: switch to OpenTelemetry
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:                false,
+			GlobalLabels:           [][]string{},
+			MetricsSinks:           []string{},
+			SensitiveLabelPatterns: []string{},
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -235,6 +235,48 @@ func TestAppConfig(t *testing.T) {
 	require.EqualValues(t, appCfg, defAppConfig)
 }
 
+func TestAppConfig_HistogramBuckets(t *testing.T) {
+	appConfigFile := filepath.Join(t.TempDir(), "app.toml")
+
+	defAppConfig := DefaultConfig()
+	defAppConfig.Telemetry.HistogramBuckets = map[string][]float64{
+		"cosmos_tx_latency":    {0.5, 1, 2.5},
+		"cosmos_block_latency": {100, 1e6},
+	}
+	SetConfigTemplate(DefaultConfigTemplate)
+	WriteConfigFile(appConfigFile, defAppConfig)
+
+	v := viper.New()
+	v.SetConfigFile(appConfigFile)
+	require.NoError(t, v.ReadInConfig())
+	appCfg := new(Config)
+	require.NoError(t, v.Unmarshal(appCfg))
+	require.Equal(t, defAppConfig.Telemetry.HistogramBuckets, appCfg.Telemetry.HistogramBuckets)
+}
+
+func TestAppConfig_LabelPolicies(t *testing.T) {
+	appConfigFile := filepath.Join(t.TempDir(), "app.toml")
+
+	defAppConfig := DefaultConfig()
+	defAppConfig.Telemetry.LabelPolicies = map[string]string{
+		"memo":  "drop",
+		"denom": "truncate:16",
+	}
+	defAppConfig.Telemetry.DefaultLabelPolicy = "hash"
+	defAppConfig.Telemetry.ScrubGlobalLabels = true
+	defAppConfig.Telemetry.StrictLabels = true
+	defAppConfig.Telemetry.SensitiveLabelPatterns = []string{`^\d{12,}$`, "^0x"}
+	SetConfigTemplate(DefaultConfigTemplate)
+	WriteConfigFile(appConfigFile, defAppConfig)
+
+	v := viper.New()
+	v.SetConfigFile(appConfigFile)
+	require.NoError(t, v.ReadInConfig())
+	appCfg := new(Config)
+	require.NoError(t, v.Unmarshal(appCfg))
+	require.Equal(t, defAppConfig.Telemetry, appCfg.Telemetry)
+}
+
 func TestGetConfig_HistoricalGRPCAddressBlockRange(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -128,14 +128,73 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
 # MetricsSink defines the type of metrics sink to use.
 metrics-sink = "{{ .Telemetry.MetricsSink }}"
 
+# MetricsSinks defines the metrics sinks to emit to simultaneously, any of
+# "mem", "statsd", "dogstatsd", "otel" and "prometheus". It takes precedence
+# over metrics-sink when not empty. The "prometheus" sink requires a positive
+# prometheus-retention-time.
+#
+# Example:
+# ["statsd", "prometheus"]
+metrics-sinks = [{{ range .Telemetry.MetricsSinks }}{{ printf "%q, " . }}{{end}}]
+
+# HistogramBuckets maps Prometheus metric name prefixes to the upper bounds of
+# the buckets of the histograms that the samples of the matching metrics are
+# observed in, instead of summaries. It requires the prometheus sink.
+#
+# Example:
+# { "cosmos_tx_latency" = [5, 10, 25, 50, 100] }
+histogram-buckets = { {{- $first := true }}{{ range $k, $v := .Telemetry.HistogramBuckets }}{{ if not $first }},{{ end }}{{ $first = false }} "{{ $k }}" = [{{ range $i, $b := $v }}{{ if $i }}, {{ end }}{{ $b }}{{ end }}]{{ end }} }
+
+# LabelPolicies maps label names to the policy applied to their values before
+# they reach the sinks, one of "allow", "hash", "drop" and "truncate:<n>",
+# keeping the first n characters.
+#
+# Example:
+# { "memo" = "drop", "denom" = "truncate:16", "address" = "hash" }
+label-policies = { {{- $first := true }}{{ range $k, $v := .Telemetry.LabelPolicies }}{{ if not $first }},{{ end }}{{ $first = false }} "{{ $k }}" = "{{ $v }}"{{ end }} }
+
+# DefaultLabelPolicy is the policy of the labels without one in label-policies.
+# It defaults to "allow".
+default-label-policy = "{{ .Telemetry.DefaultLabelPolicy }}"
+
+# ScrubGlobalLabels applies the label policies to the global labels as well.
+scrub-global-labels = {{ .Telemetry.ScrubGlobalLabels }}
+
+# StrictLabels logs the labels whose values reach the sinks and match one of
+# sensitive-label-patterns, at most once a minute per label name, so that the
+# call sites emitting them can be found.
+strict-labels = {{ .Telemetry.StrictLabels }}
+
+# SensitiveLabelPatterns are the regular expressions matched by the label
+# values in strict mode. They default to patterns matching bech32 addresses and
+# hexadecimal strings of 40 characters or more.
+sensitive-label-patterns = [{{ range .Telemetry.SensitiveLabelPatterns }}{{ printf "%q, " . }}{{end}}]
+
 # StatsdAddr defines the address of a statsd server to send metrics to.
-# Only utilized if MetricsSink is set to "statsd" or "dogstatsd".
+# Only utilized if a "statsd" or "dogstatsd" sink is used.
 statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 
 # DatadogHostname defines the hostname to use when emitting metrics to
-# Datadog. Only utilized if MetricsSink is set to "dogstatsd".
+# Datadog. Only utilized if a "dogstatsd" sink is used.
 datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
 
+# SnapshotInterval, when positive, serves the metrics gathered by the API
+# server from a snapshot refreshed every snapshot-interval seconds, so that
+# scrapes do not contend with metric emission.
+snapshot-interval = {{ .Telemetry.SnapshotInterval }}
+
+# SnapshotMaxStaleness, when positive, defines the age in seconds beyond which
+# a scrape refreshes the snapshot synchronously, at most once per interval.
+snapshot-max-staleness = {{ .Telemetry.SnapshotMaxStaleness }}
+
+# AuthToken, when set, is the bearer token the requests to the API server
+# metrics endpoint must present in their "Authorization: Bearer <token>" header.
+auth-token = "{{ .Telemetry.AuthToken }}"
+
+# BasicAuth, when set, are the "user:pass" credentials the requests to the API
+# server metrics endpoint may present with the basic authentication scheme.
+basic-auth = "{{ .Telemetry.BasicAuth }}"
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
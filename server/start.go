@@ -232,7 +232,7 @@ func start(svrCtx *Context, clientCtx client.Context, appCreator types.AppCreato
 	}
 	defer appCleanupFn()
 
-	metrics, err := startTelemetry(svrCfg)
+	metrics, err := startTelemetry(svrCtx, svrCfg)
 	if err != nil {
 		return fmt.Errorf("failed to start telemetry: %w", err)
 	}
@@ -566,7 +566,8 @@ func startAPIServer(
 }
 
 //nolint:staticcheck // TODO: switch to OpenTelemetry
-func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
+func startTelemetry(svrCtx *Context, cfg serverconfig.Config) (*telemetry.Metrics, error) {
+	cfg.Telemetry.Logger = svrCtx.Logger.With("module", "telemetry")
 	//nolint:staticcheck // TODO: switch to OpenTelemetry
 	return telemetry.New(cfg.Telemetry)
 }
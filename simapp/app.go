@@ -259,6 +259,7 @@ func NewSimApp(
 		epochstypes.StoreKey,
 		protocolpooltypes.StoreKey,
 	)
+	tkeys := storetypes.NewTransientStoreKeys(distrtypes.TStoreKey)
 
 	// register streaming services
 	if err := bApp.RegisterStreamingServices(appOpts, keys); err != nil {
@@ -356,6 +357,7 @@ func NewSimApp(
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		distrkeeper.WithExternalCommunityPool(app.ProtocolPoolKeeper),
+		distrkeeper.WithTransientStoreService(runtime.NewTransientStoreService(tkeys[distrtypes.TStoreKey])),
 	)
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
@@ -526,6 +528,7 @@ func NewSimApp(
 		banktypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		distrtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		protocolpooltypes.ModuleName,
@@ -612,6 +615,7 @@ func NewSimApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountTransientStores(tkeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -675,7 +679,12 @@ func (app *SimApp) setPostHandler() {
 		panic(err)
 	}
 
-	app.SetPostHandler(postHandler)
+	// emit the withdraw events of the implicit settlements aggregated by
+	// x/distribution at the end of each transaction
+	distrDecorator := distrkeeper.NewImplicitWithdrawEventsDecorator(app.DistrKeeper)
+	app.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+		return distrDecorator.PostHandle(ctx, tx, simulate, success, postHandler)
+	})
 }
 
 // Name returns the name of the App
@@ -112,6 +112,7 @@ var (
 					banktypes.ModuleName,
 					govtypes.ModuleName,
 					stakingtypes.ModuleName,
+					distrtypes.ModuleName,
 					feegrant.ModuleName,
 					protocolpooltypes.ModuleName,
 				},
@@ -265,6 +265,7 @@ require (
 
 // Below are the long-lived replace of the SimApp
 replace (
+	cosmossdk.io/api => ../api
 	cosmossdk.io/log/v2 => ../log
 	cosmossdk.io/store => ../store
 	// use cosmos fork of keyring
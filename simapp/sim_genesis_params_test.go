@@ -0,0 +1,163 @@
+//go:build sims
+
+package simapp
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sims "github.com/cosmos/cosmos-sdk/testutil/simsx"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// genesisParamsCorpusDir holds the genesis param sets which broke the
+// simulation, replayed as regression tests.
+const genesisParamsCorpusDir = "testdata/genesis_params"
+
+// genesisParamsGenerators draw the params of the modules within their valid
+// ranges.
+var genesisParamsGenerators = []sims.GenesisParamsGenerator{
+	{
+		Module: distrtypes.ModuleName,
+		Draw: func(t *rapid.T) proto.Message {
+			params := distrtypes.DefaultParams()
+			params.CommunityTax = sdkmath.LegacyNewDecWithPrec(rapid.Int64Range(0, 1e18).Draw(t, "community_tax"), 18)
+			params.WithdrawAddrEnabled = rapid.Bool().Draw(t, "withdraw_addr_enabled")
+			params.AggregateImplicitWithdrawEvents = rapid.Bool().Draw(t, "aggregate_implicit_withdraw_events")
+			params.RewardDistributionStatsEnabled = rapid.Bool().Draw(t, "reward_distribution_stats_enabled")
+			return &params
+		},
+	},
+	{
+		Module: slashingtypes.ModuleName,
+		Draw: func(t *rapid.T) proto.Message {
+			params := slashingtypes.DefaultParams()
+			params.SignedBlocksWindow = rapid.Int64Range(1, 1000).Draw(t, "signed_blocks_window")
+			params.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(t, "min_signed_per_window"), 2)
+			params.DowntimeJailDuration = time.Duration(rapid.Int64Range(1, 3600).Draw(t, "downtime_jail_duration")) * time.Second
+			params.SlashFractionDoubleSign = sdkmath.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(t, "slash_fraction_double_sign"), 2)
+			params.SlashFractionDowntime = sdkmath.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(t, "slash_fraction_downtime"), 2)
+			return &params
+		},
+	},
+}
+
+func TestGenesisParamsFuzz(t *testing.T) {
+	failure := sims.FuzzGenesisParams(t, NewSimApp, setupStateFactory, sims.GenesisParamsFuzzConfig{
+		Generators:      genesisParamsGenerators,
+		Seed:            1,
+		ValidateGenesis: validateGenesis(newScratchApp(t)),
+		CorpusDir:       genesisParamsCorpusDir,
+	}, checkReferenceCountInvariant)
+	require.Nil(t, failure, "genesis params break the simulation")
+}
+
+func TestGenesisParamsCorpus(t *testing.T) {
+	sims.ReplayGenesisParamsCorpus(t, NewSimApp, setupStateFactory, sims.GenesisParamsFuzzConfig{
+		Generators:      genesisParamsGenerators,
+		Seed:            1,
+		ValidateGenesis: validateGenesis(newScratchApp(t)),
+		CorpusDir:       genesisParamsCorpusDir,
+	}, checkReferenceCountInvariant)
+}
+
+// TestGenesisParamsFuzzWeakenedValidation is a seeded example of the fuzzing
+// catching a community tax above one, let through by a deliberately weakened
+// validation, and shrinking the failing param set.
+func TestGenesisParamsFuzzWeakenedValidation(t *testing.T) {
+	// the failure is expected, rapid must not persist it as a regression
+	noFailFile := flag.Lookup("rapid.nofailfile")
+	prev := noFailFile.Value.String()
+	require.NoError(t, noFailFile.Value.Set("true"))
+	t.Cleanup(func() { _ = noFailFile.Value.Set(prev) })
+
+	app := newScratchApp(t)
+	cfg := sims.GenesisParamsFuzzConfig{
+		Generators: []sims.GenesisParamsGenerator{{
+			Module: distrtypes.ModuleName,
+			Draw: func(t *rapid.T) proto.Message {
+				params := distrtypes.DefaultParams()
+				params.CommunityTax = sdkmath.LegacyNewDecWithPrec(rapid.Int64Range(0, 200).Draw(t, "community_tax_percent"), 2)
+				return &params
+			},
+		}},
+		Modules:         []string{distrtypes.ModuleName},
+		Seed:            1,
+		ValidateGenesis: weakenedCommunityTaxValidation(app),
+		CorpusDir:       t.TempDir(),
+	}
+
+	failure := sims.FuzzGenesisParams(t, NewSimApp, setupStateFactory, cfg, checkReferenceCountInvariant)
+	require.NotNil(t, failure, "weakened community tax validation not caught")
+
+	var params distrtypes.Params
+	require.NoError(t, app.AppCodec().UnmarshalJSON(failure.Params[distrtypes.ModuleName], &params))
+	require.True(t, params.CommunityTax.GT(sdkmath.LegacyOneDec()), "unexpected failing community tax %s", params.CommunityTax)
+
+	corpus, err := sims.LoadGenesisParamsCorpus(cfg.CorpusDir)
+	require.NoError(t, err)
+	require.Len(t, corpus, 1)
+}
+
+// validateGenesis validates the genesis of all the modules of the app.
+func validateGenesis(app *SimApp) func(genesis map[string]json.RawMessage) error {
+	basics := module.NewBasicManagerFromManager(app.ModuleManager, nil)
+	return func(genesis map[string]json.RawMessage) error {
+		return basics.ValidateGenesis(app.AppCodec(), app.TxConfig(), genesis)
+	}
+}
+
+// weakenedCommunityTaxValidation validates the genesis of all the modules of
+// the app, but accepts a distribution community tax up to two instead of one.
+func weakenedCommunityTaxValidation(app *SimApp) func(genesis map[string]json.RawMessage) error {
+	cdc, validate := app.AppCodec(), validateGenesis(app)
+	return func(genesis map[string]json.RawMessage) error {
+		var gs distrtypes.GenesisState
+		if err := cdc.UnmarshalJSON(genesis[distrtypes.ModuleName], &gs); err != nil {
+			return err
+		}
+		if gs.Params.CommunityTax.GT(sdkmath.LegacyOneDec()) && gs.Params.CommunityTax.LTE(sdkmath.LegacyNewDec(2)) {
+			gs.Params.CommunityTax = sdkmath.LegacyOneDec()
+		}
+
+		weakened := make(map[string]json.RawMessage, len(genesis))
+		for module, bz := range genesis {
+			weakened[module] = bz
+		}
+		bz, err := cdc.MarshalJSON(&gs)
+		if err != nil {
+			return err
+		}
+		weakened[distrtypes.ModuleName] = bz
+		return validate(weakened)
+	}
+}
+
+func checkReferenceCountInvariant(tb testing.TB, ti sims.TestInstance[*SimApp], _ []simtypes.Account) {
+	tb.Helper()
+	app := ti.App
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	msg, broken := distrkeeper.ReferenceCountInvariant(app.DistrKeeper)(ctx)
+	require.False(tb, broken, msg)
+}
+
+func newScratchApp(t *testing.T) *SimApp {
+	t.Helper()
+	return NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+}
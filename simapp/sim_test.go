@@ -30,6 +30,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
@@ -56,7 +57,13 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 }
 
 func TestFullAppSimulation(t *testing.T) {
-	sims.Run(t, NewSimApp, setupStateFactory)
+	sims.Run(t, NewSimApp, setupStateFactory, func(tb testing.TB, ti sims.TestInstance[*SimApp], _ []simtypes.Account) {
+		tb.Helper()
+		app := ti.App
+		ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+		msg, broken := distrkeeper.ReferenceCountInvariant(app.DistrKeeper)(ctx)
+		require.False(tb, broken, msg)
+	})
 }
 
 func setupStateFactory(app *SimApp) sims.SimStateFactory {
@@ -2,9 +2,12 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"io"
+	"path/filepath"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -26,6 +29,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrcli "github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 )
 
@@ -109,7 +114,7 @@ func initRootCmd(
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, simapp.DefaultNodeHome),
 		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd(txConfig),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, simapp.DefaultNodeHome),
 		snapshot.Cmd(newApp),
@@ -187,6 +192,28 @@ func txCommand() *cobra.Command {
 	return cmd
 }
 
+// debugCmd returns the debug commands, extended with the commands debugging
+// the distribution state of a stopped node.
+func debugCmd(txConfig client.TxConfig) *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(distrcli.GetDebugCmd(txConfig.SigningContext().ValidatorAddressCodec(), loadDistrKeeper))
+	return cmd
+}
+
+// loadDistrKeeper loads the distribution keeper of the stopped node in the
+// home directory.
+func loadDistrKeeper(cmd *cobra.Command) (sdk.Context, distrkeeper.Keeper, func() error, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+	if err != nil {
+		return sdk.Context{}, distrkeeper.Keeper{}, nil, fmt.Errorf("error opening DB, make sure daemon is not running when calling this command: %w", err)
+	}
+
+	app := simapp.NewSimApp(serverCtx.Logger, db, nil, true, serverCtx.Viper)
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	return ctx, app.DistrKeeper, db.Close, nil
+}
+
 // newApp creates the application
 func newApp(
 	logger log.Logger,
@@ -41,3 +41,11 @@ func TestHomeFlagRegistration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, result, homeDir)
 }
+
+func TestNodeMetricsCmdRegistration(t *testing.T) {
+	rootCmd := cmd.NewRootCmd()
+
+	metricsCmd, _, err := rootCmd.Find([]string{"query", "node", "metrics"})
+	require.NoError(t, err)
+	require.Equal(t, "metrics", metricsCmd.Name())
+}
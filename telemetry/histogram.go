@@ -0,0 +1,121 @@
+package telemetry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// forbiddenCharsReplacer replaces the characters that are not allowed in
+// Prometheus metric names, as the go-metrics Prometheus sink does.
+var forbiddenCharsReplacer = strings.NewReplacer(" ", "_", ".", "_", "=", "_", "-", "_", "/", "_")
+
+// histogramSink wraps a Prometheus sink so that the samples of the metrics
+// whose name starts with a configured prefix are observed in Prometheus
+// histograms rather than in summaries, since summaries cannot be aggregated
+// across instances. All the other metrics are forwarded to the wrapped sink.
+type histogramSink struct {
+	metrics.MetricSink
+
+	buckets map[string][]float64
+	// prefixes are sorted longest first, so that the most specific prefix of
+	// a metric name is matched
+	prefixes []string
+
+	mu         sync.Mutex
+	histograms map[string]prometheus.Histogram
+}
+
+var _ prometheus.Collector = (*histogramSink)(nil)
+
+func newHistogramSink(sink metrics.MetricSink, buckets map[string][]float64) (*histogramSink, error) {
+	s := &histogramSink{
+		MetricSink: sink,
+		buckets:    buckets,
+		histograms: make(map[string]prometheus.Histogram),
+	}
+
+	for prefix, bounds := range buckets {
+		if len(bounds) == 0 {
+			return nil, fmt.Errorf("histogram buckets of %q are empty", prefix)
+		}
+
+		for i := 1; i < len(bounds); i++ {
+			if bounds[i] <= bounds[i-1] {
+				return nil, fmt.Errorf("histogram buckets of %q must be in increasing order", prefix)
+			}
+		}
+
+		s.prefixes = append(s.prefixes, prefix)
+	}
+
+	sort.Slice(s.prefixes, func(i, j int) bool {
+		if len(s.prefixes[i]) != len(s.prefixes[j]) {
+			return len(s.prefixes[i]) > len(s.prefixes[j])
+		}
+		return s.prefixes[i] < s.prefixes[j]
+	})
+
+	return s, nil
+}
+
+func (s *histogramSink) AddSample(key []string, val float32) {
+	s.AddSampleWithLabels(key, val, nil)
+}
+
+func (s *histogramSink) AddSampleWithLabels(key []string, val float32, labels []metrics.Label) {
+	name := forbiddenCharsReplacer.Replace(strings.Join(key, "_"))
+
+	var buckets []float64
+	for _, prefix := range s.prefixes {
+		if strings.HasPrefix(name, prefix) {
+			buckets = s.buckets[prefix]
+			break
+		}
+	}
+
+	if buckets == nil {
+		s.MetricSink.AddSampleWithLabels(key, val, labels)
+		return
+	}
+
+	hash := name
+	constLabels := make(prometheus.Labels, len(labels))
+	for _, label := range labels {
+		hash += ";" + label.Name + "=" + label.Value
+		constLabels[label.Name] = label.Value
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.histograms[hash]
+	if !ok {
+		h = prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:        name,
+			Help:        name,
+			ConstLabels: constLabels,
+			Buckets:     buckets,
+		})
+		s.histograms[hash] = h
+	}
+	h.Observe(float64(val))
+}
+
+// Describe implements prometheus.Collector. The histograms are created as the
+// samples are added, so the collector is unchecked.
+func (s *histogramSink) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (s *histogramSink) Collect(ch chan<- prometheus.Metric) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, h := range s.histograms {
+		ch <- h
+	}
+}
@@ -0,0 +1,95 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics_Histogram(t *testing.T) {
+	m, err := New(Config{
+		Enabled:                 true,
+		ServiceName:             "test",
+		PrometheusRetentionTime: 60,
+		GlobalLabels:            [][]string{{"chain_id", "test-chain"}},
+		HistogramBuckets: map[string][]float64{
+			"test_hist":         {1, 5, 10},
+			"test_hist_latency": {100, 1000},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		m.Close()
+		globalLabels = []metrics.Label{}
+	})
+
+	m.AddSample(3, []string{"hist", "size"}, NewLabel("module", "bank"))
+	m.AddSample(7, []string{"hist", "size"}, NewLabel("module", "bank"))
+	m.MeasureSince([]string{"hist", "latency"}, time.Now().Add(-time.Second))
+	m.AddSample(3, []string{"summary"})
+	m.IncrCounter(2, []string{"labeled", "counter"}, NewLabel("module", "bank"))
+	m.SetGauge(4, []string{"labeled", "gauge"})
+
+	gr, err := m.Gather(FormatPrometheus)
+	require.NoError(t, err)
+	body := string(gr.Metrics)
+
+	// the samples of the matching metrics are observed in histograms with the
+	// buckets of the longest matching prefix
+	require.Contains(t, body, "# TYPE test_hist_size histogram")
+	for _, line := range []string{
+		`test_hist_size_bucket{chain_id="test-chain",module="bank",le="1"} 0`,
+		`test_hist_size_bucket{chain_id="test-chain",module="bank",le="5"} 1`,
+		`test_hist_size_bucket{chain_id="test-chain",module="bank",le="10"} 2`,
+		`test_hist_size_bucket{chain_id="test-chain",module="bank",le="+Inf"} 2`,
+		`test_hist_size_sum{chain_id="test-chain",module="bank"} 10`,
+		`test_hist_size_count{chain_id="test-chain",module="bank"} 2`,
+		`test_hist_latency_bucket{chain_id="test-chain",le="100"} 0`,
+		`test_hist_latency_bucket{chain_id="test-chain",le="1000"} 0`,
+		`test_hist_latency_bucket{chain_id="test-chain",le="+Inf"} 1`,
+	} {
+		require.Contains(t, body, line)
+	}
+	require.Contains(t, body, "# TYPE test_hist_latency histogram")
+
+	// the other samples are still observed in summaries
+	require.Contains(t, body, "# TYPE test_summary summary")
+	require.Contains(t, body, `test_labeled_counter{chain_id="test-chain",module="bank"} 2`)
+	require.Contains(t, body, `test_labeled_gauge{chain_id="test-chain"} 4`)
+}
+
+func TestMetrics_HistogramConfig(t *testing.T) {
+	_, err := New(Config{
+		Enabled:          true,
+		HistogramBuckets: map[string][]float64{"test": {1}},
+	})
+	require.ErrorContains(t, err, "histogram buckets require the prometheus metrics sink")
+
+	_, err = New(Config{
+		Enabled:                 true,
+		PrometheusRetentionTime: 60,
+		HistogramBuckets:        map[string][]float64{"test": {5, 1}},
+	})
+	require.ErrorContains(t, err, "must be in increasing order")
+
+	// a failed configuration leaves nothing registered behind
+	m, err := New(Config{
+		Enabled:                 true,
+		PrometheusRetentionTime: 60,
+		HistogramBuckets:        map[string][]float64{"test": {1, 5}},
+	})
+	require.NoError(t, err)
+	m.Close()
+}
+
+func TestMetrics_NilHelpers(t *testing.T) {
+	var m *Metrics
+	require.NotPanics(t, func() {
+		m.MeasureSince([]string{"nil"}, time.Now())
+		m.IncrCounter(1, []string{"nil"})
+		m.SetGauge(1, []string{"nil"})
+		m.AddSample(1, []string{"nil"})
+	})
+}
@@ -3,10 +3,16 @@ package telemetry
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-metrics"
@@ -15,6 +21,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/expfmt"
 	"go.opentelemetry.io/otel"
+
+	"cosmossdk.io/log/v2"
 )
 
 // globalTelemetryEnabled is a private variable that stores the telemetry enabled state.
@@ -31,6 +39,16 @@ func EnableTelemetry() {
 	globalTelemetryEnabled = true
 }
 
+// globalMetrics stores the metrics created by the last call to New, it is nil
+// if telemetry is disabled or the metrics are closed.
+var globalMetrics atomic.Pointer[Metrics]
+
+// Deprecated: GlobalMetrics returns the metrics created by New, or nil if
+// telemetry is disabled or the metrics are closed.
+func GlobalMetrics() *Metrics {
+	return globalMetrics.Load()
+}
+
 // globalLabels defines the set of global labels that will be applied to all
 // metrics emitted using the telemetry package function wrappers.
 var globalLabels = []metrics.Label{}
@@ -43,6 +61,12 @@ const (
 	FormatPrometheus = "prometheus"
 	// Deprecated: FormatText indicates text format for metrics gathering.
 	FormatText = "text"
+	// Deprecated: FormatOpenMetrics indicates OpenMetrics text format for
+	// metrics gathering.
+	FormatOpenMetrics = "openmetrics"
+	// Deprecated: FormatProtobuf indicates delimited Prometheus protobuf format
+	// for metrics gathering.
+	FormatProtobuf = "protobuf"
 	// Deprecated: ContentTypeText is the content type for text formatted metrics.
 	ContentTypeText = `text/plain; version=` + expfmt.TextVersion + `; charset=utf-8`
 
@@ -54,6 +78,8 @@ const (
 	MetricSinkDogsStatsd = "dogstatsd"
 	// Deprecated: MetricSinkOtel indicates OpenTelemetry metrics sink.
 	MetricSinkOtel = "otel"
+	// Deprecated: MetricSinkPrometheus indicates Prometheus metrics sink.
+	MetricSinkPrometheus = "prometheus"
 )
 
 // DisplayableSink defines an interface for a sink to provide human-readable metrics.
@@ -99,13 +125,77 @@ type Config struct {
 	// Can be one of "mem", "statsd", "dogstatsd", or "otel".
 	MetricsSink string `mapstructure:"metrics-sink" default:"mem"`
 
+	// MetricsSinks defines the metrics backends to fan out to, any of "mem",
+	// "statsd", "dogstatsd", "otel" and "prometheus". It takes precedence over
+	// MetricsSink when not empty. The "prometheus" sink requires a positive
+	// PrometheusRetentionTime.
+	MetricsSinks []string `mapstructure:"metrics-sinks"`
+
 	// StatsdAddr defines the address of a statsd server to send metrics to.
-	// Only utilized if MetricsSink is set to "statsd" or "dogstatsd".
+	// Only utilized if a "statsd" or "dogstatsd" sink is used.
 	StatsdAddr string `mapstructure:"statsd-addr"`
 
 	// DatadogHostname defines the hostname to use when emitting metrics to
-	// Datadog. Only utilized if MetricsSink is set to "dogstatsd".
+	// Datadog. Only utilized if a "dogstatsd" sink is used.
 	DatadogHostname string `mapstructure:"datadog-hostname"`
+
+	// SnapshotInterval, when positive, makes Gather serve the metrics from a
+	// snapshot refreshed in the background every SnapshotInterval seconds,
+	// instead of gathering them from the sinks on every call.
+	SnapshotInterval int64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotMaxStaleness, when positive, defines the age in seconds beyond
+	// which Gather refreshes the snapshot synchronously, at most once per
+	// SnapshotInterval. Only utilized if SnapshotInterval is positive.
+	SnapshotMaxStaleness int64 `mapstructure:"snapshot-max-staleness"`
+
+	// HistogramBuckets maps Prometheus metric name prefixes to the upper bounds
+	// of the buckets of the histograms that the samples of the matching metrics
+	// are observed in, instead of summaries. It requires the Prometheus sink.
+	//
+	// Example:
+	// {"cosmos_tx_latency": [5, 10, 25, 50, 100]}
+	HistogramBuckets map[string][]float64 `mapstructure:"histogram-buckets"`
+
+	// LabelPolicies maps label names to the policy applied to their values
+	// before they reach the sinks, one of "allow", "hash", "drop" and
+	// "truncate:<n>", keeping the first n characters.
+	//
+	// Example:
+	// {"memo": "drop", "denom": "truncate:16", "address": "hash"}
+	LabelPolicies map[string]string `mapstructure:"label-policies"`
+
+	// DefaultLabelPolicy is the policy of the labels without one in
+	// LabelPolicies. It defaults to "allow".
+	DefaultLabelPolicy string `mapstructure:"default-label-policy"`
+
+	// ScrubGlobalLabels applies the label policies to the global labels as
+	// well. Otherwise, the global labels are emitted as configured.
+	ScrubGlobalLabels bool `mapstructure:"scrub-global-labels"`
+
+	// StrictLabels logs the labels whose values reach the sinks and match one
+	// of SensitiveLabelPatterns, at most once a minute per label name, so that
+	// the call sites emitting them can be found.
+	StrictLabels bool `mapstructure:"strict-labels"`
+
+	// SensitiveLabelPatterns are the regular expressions matched by the label
+	// values in strict mode. They default to DefaultSensitiveLabelPatterns,
+	// matching bech32 addresses and long hexadecimal strings.
+	SensitiveLabelPatterns []string `mapstructure:"sensitive-label-patterns"`
+
+	// AuthToken, when set, is the bearer token the requests gathering the
+	// metrics through the API server must be authorized with, in their
+	// "Authorization: Bearer <token>" header.
+	AuthToken string `mapstructure:"auth-token"`
+
+	// BasicAuth, when set, are the "user:pass" credentials the requests
+	// gathering the metrics through the API server may be authorized with
+	// using the basic authentication scheme, in addition to AuthToken.
+	BasicAuth string `mapstructure:"basic-auth"`
+
+	// Logger is the logger of the strict mode, it defaults to a logger writing
+	// to the standard error.
+	Logger log.Logger `mapstructure:"-"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -116,8 +206,17 @@ type Config struct {
 //
 // Deprecated: users should switch to OpenTelemetry.
 type Metrics struct {
-	sink              metrics.MetricSink
+	global            *metrics.Metrics
+	memSink           *metrics.InmemSink
+	promSink          *metricsprom.PrometheusSink
+	histograms        *histogramSink
 	prometheusEnabled bool
+	snapshots         *snapshotter
+
+	// authToken and basicAuth are the SHA-256 digests of the configured
+	// credentials, nil if not configured
+	authToken []byte
+	basicAuth []byte
 }
 
 // GatherResponse is the response type of registered metrics
@@ -126,6 +225,9 @@ type Metrics struct {
 type GatherResponse struct {
 	Metrics     []byte
 	ContentType string
+	// SnapshotTime is the time the metrics were gathered at, it is zero if the
+	// metrics were gathered from the sinks on the call.
+	SnapshotTime time.Time
 }
 
 // New creates a new instance of Metrics
@@ -134,14 +236,25 @@ type GatherResponse struct {
 func New(cfg Config) (_ *Metrics, rerr error) {
 	globalTelemetryEnabled = cfg.Enabled
 	if !cfg.Enabled {
+		globalMetrics.Store(nil)
 		return nil, nil
 	}
 
+	scrub, err := newScrubber(cfg)
+	if err != nil {
+		return nil, err
+	}
+	labelScrubber.Store(scrub)
+
 	if numGlobalLabels := len(cfg.GlobalLabels); numGlobalLabels > 0 {
 		parsedGlobalLabels := make([]metrics.Label, numGlobalLabels)
 		for i, gl := range cfg.GlobalLabels {
 			parsedGlobalLabels[i] = NewLabel(gl[0], gl[1])
 		}
+		// the global labels are static, so they are scrubbed once
+		if cfg.ScrubGlobalLabels {
+			parsedGlobalLabels = scrub.scrub(nil, parsedGlobalLabels)
+		}
 		globalLabels = parsedGlobalLabels
 	}
 
@@ -149,63 +262,231 @@ func New(cfg Config) (_ *Metrics, rerr error) {
 	metricsConf.EnableHostname = cfg.EnableHostname
 	metricsConf.EnableHostnameLabel = cfg.EnableHostnameLabel
 
-	var (
-		sink metrics.MetricSink
-		err  error
-	)
-	switch cfg.MetricsSink {
-	case MetricSinkStatsd:
-		sink, err = metrics.NewStatsdSink(cfg.StatsdAddr)
-	case MetricSinkDogsStatsd:
-		sink, err = datadog.NewDogStatsdSink(cfg.StatsdAddr, cfg.DatadogHostname)
-	case MetricSinkOtel:
-		sink = newOtelGoMetricsSink(context.Background(), otel.Meter("gometrics"))
-	default:
-		memSink := metrics.NewInmemSink(10*time.Second, time.Minute)
-		sink = memSink
-		inMemSig := metrics.DefaultInmemSignal(memSink)
-		defer func() {
-			if rerr != nil {
-				inMemSig.Stop()
-			}
-		}()
-	}
-
+	sinkNames, err := cfg.metricsSinks()
 	if err != nil {
 		return nil, err
 	}
 
-	m := &Metrics{sink: sink}
-	fanout := metrics.FanoutSink{sink}
+	if len(cfg.HistogramBuckets) > 0 && !slices.Contains(sinkNames, MetricSinkPrometheus) {
+		return nil, errors.New("histogram buckets require the prometheus metrics sink")
+	}
 
-	if cfg.PrometheusRetentionTime > 0 {
-		m.prometheusEnabled = true
-		prometheusOpts := metricsprom.PrometheusOpts{
-			Expiration: time.Duration(cfg.PrometheusRetentionTime) * time.Second,
+	m := &Metrics{}
+	if cfg.AuthToken != "" {
+		digest := sha256.Sum256([]byte(cfg.AuthToken))
+		m.authToken = digest[:]
+	}
+	if cfg.BasicAuth != "" {
+		if user, _, ok := strings.Cut(cfg.BasicAuth, ":"); !ok || user == "" {
+			return nil, errors.New("basic auth credentials must be of the form user:pass")
+		}
+		digest := sha256.Sum256([]byte(cfg.BasicAuth))
+		m.basicAuth = digest[:]
+	}
+
+	defer func() {
+		if rerr != nil {
+			m.Close()
+		}
+	}()
+
+	fanout := make(metrics.FanoutSink, 0, len(sinkNames))
+	for _, name := range sinkNames {
+		var sink metrics.MetricSink
+		switch name {
+		case MetricSinkStatsd:
+			sink, err = metrics.NewStatsdSink(cfg.StatsdAddr)
+		case MetricSinkDogsStatsd:
+			sink, err = datadog.NewDogStatsdSink(cfg.StatsdAddr, cfg.DatadogHostname)
+		case MetricSinkOtel:
+			sink = newOtelGoMetricsSink(context.Background(), otel.Meter("gometrics"))
+		case MetricSinkPrometheus:
+			m.prometheusEnabled = true
+			prometheusOpts := metricsprom.PrometheusOpts{
+				Expiration: time.Duration(cfg.PrometheusRetentionTime) * time.Second,
+			}
+			m.promSink, err = metricsprom.NewPrometheusSinkFrom(prometheusOpts)
+			sink = m.promSink
+			if err == nil && len(cfg.HistogramBuckets) > 0 {
+				m.histograms, err = newHistogramSink(m.promSink, cfg.HistogramBuckets)
+				if err == nil {
+					err = prometheus.Register(m.histograms)
+				}
+				sink = m.histograms
+			}
+		default:
+			m.memSink = metrics.NewInmemSink(10*time.Second, time.Minute)
+			sink = m.memSink
+			inMemSig := metrics.DefaultInmemSignal(m.memSink)
+			defer func() {
+				if rerr != nil {
+					inMemSig.Stop()
+				}
+			}()
 		}
 
-		promSink, err := metricsprom.NewPrometheusSinkFrom(prometheusOpts)
 		if err != nil {
 			return nil, err
 		}
 
-		fanout = append(fanout, promSink)
+		fanout = append(fanout, sink)
 	}
 
-	if _, err := metrics.NewGlobal(metricsConf, fanout); err != nil {
+	if m.global, err = metrics.NewGlobal(metricsConf, fanout); err != nil {
 		return nil, err
 	}
 
+	if cfg.SnapshotInterval > 0 {
+		m.snapshots = newSnapshotter(
+			m.gatherLive,
+			[]string{FormatText, FormatPrometheus, FormatOpenMetrics, FormatProtobuf},
+			time.Duration(cfg.SnapshotInterval)*time.Second,
+			time.Duration(cfg.SnapshotMaxStaleness)*time.Second,
+		)
+		m.snapshots.start()
+	}
+
+	globalMetrics.Store(m)
 	return m, nil
 }
 
+// Close stops refreshing the metrics snapshot, if any, and unregisters the
+// Prometheus sink.
+func (m *Metrics) Close() {
+	globalMetrics.CompareAndSwap(m, nil)
+
+	if m.snapshots != nil {
+		m.snapshots.close()
+	}
+
+	if m.promSink != nil {
+		prometheus.Unregister(m.promSink)
+	}
+
+	if m.histograms != nil {
+		prometheus.Unregister(m.histograms)
+	}
+}
+
+// MeasureSince emits a timing sample of the time elapsed since start, with the
+// global labels and the given labels.
+func (m *Metrics) MeasureSince(keys []string, start time.Time, labels ...metrics.Label) {
+	if m == nil {
+		return
+	}
+
+	m.global.MeasureSinceWithLabels(keys, start.UTC(), withGlobalLabels(keys, labels))
+}
+
+// IncrCounter increments a counter by val, with the global labels and the
+// given labels.
+func (m *Metrics) IncrCounter(val float32, keys []string, labels ...metrics.Label) {
+	if m == nil {
+		return
+	}
+
+	m.global.IncrCounterWithLabels(keys, val, withGlobalLabels(keys, labels))
+}
+
+// SetGauge sets a gauge to val, with the global labels and the given labels.
+func (m *Metrics) SetGauge(val float32, keys []string, labels ...metrics.Label) {
+	if m == nil {
+		return
+	}
+
+	m.global.SetGaugeWithLabels(keys, val, withGlobalLabels(keys, labels))
+}
+
+// AddSample adds a sample of val, with the global labels and the given labels.
+// The samples of the metrics matching a prefix of Config.HistogramBuckets are
+// observed in Prometheus histograms.
+func (m *Metrics) AddSample(val float32, keys []string, labels ...metrics.Label) {
+	if m == nil {
+		return
+	}
+
+	m.global.AddSampleWithLabels(keys, val, withGlobalLabels(keys, labels))
+}
+
+// withGlobalLabels returns the given labels of the metric, scrubbed according
+// to the label policies, followed by the global labels, without modifying the
+// given slice.
+func withGlobalLabels(keys []string, labels []metrics.Label) []metrics.Label {
+	merged := make([]metrics.Label, 0, len(labels)+len(globalLabels))
+	merged = labelScrubber.Load().scrub(keys, append(merged, labels...))
+	return append(merged, globalLabels...)
+}
+
+// metricsSinks returns the names of the sinks to fan out to. Without
+// MetricsSinks, MetricsSink selects a single sink, defaulting to the in-memory
+// one. A positive PrometheusRetentionTime always enables the Prometheus sink.
+func (cfg Config) metricsSinks() ([]string, error) {
+	var sinks []string
+	if len(cfg.MetricsSinks) == 0 {
+		switch cfg.MetricsSink {
+		case MetricSinkStatsd, MetricSinkDogsStatsd, MetricSinkOtel:
+			sinks = []string{cfg.MetricsSink}
+		default:
+			sinks = []string{MetricSinkInMem}
+		}
+	}
+
+	for _, name := range cfg.MetricsSinks {
+		switch name {
+		case MetricSinkInMem, MetricSinkStatsd, MetricSinkDogsStatsd, MetricSinkOtel:
+		case MetricSinkPrometheus:
+			if cfg.PrometheusRetentionTime <= 0 {
+				return nil, errors.New("prometheus metrics sink requires a positive prometheus retention time")
+			}
+		default:
+			return nil, fmt.Errorf("unsupported metrics sink: %q", name)
+		}
+
+		if slices.Contains(sinks, name) {
+			return nil, fmt.Errorf("duplicate metrics sink: %q", name)
+		}
+		sinks = append(sinks, name)
+	}
+
+	if cfg.PrometheusRetentionTime > 0 && !slices.Contains(sinks, MetricSinkPrometheus) {
+		sinks = append(sinks, MetricSinkPrometheus)
+	}
+
+	return sinks, nil
+}
+
 // Gather collects all registered metrics and returns a GatherResponse where the
 // metrics are encoded depending on the type. Metrics are either encoded via
-// Prometheus or JSON if in-memory.
+// Prometheus or JSON if in-memory. If snapshots are enabled, the metrics of the
+// latest snapshot are returned.
 func (m *Metrics) Gather(format string) (GatherResponse, error) {
+	if m.snapshots == nil {
+		return m.gatherLive(format)
+	}
+
+	switch format {
+	case FormatPrometheus, FormatText, FormatOpenMetrics, FormatProtobuf:
+		return m.snapshots.Gather(format)
+
+	case FormatDefault:
+		return m.snapshots.Gather(FormatText)
+
+	default:
+		return GatherResponse{}, fmt.Errorf("unsupported metrics format: %s", format)
+	}
+}
+
+// gatherLive collects the metrics from the sinks.
+func (m *Metrics) gatherLive(format string) (GatherResponse, error) {
 	switch format {
 	case FormatPrometheus:
-		return m.gatherPrometheus()
+		return m.gatherPrometheus(expfmt.TypeTextPlain)
+
+	case FormatOpenMetrics:
+		return m.gatherPrometheus(expfmt.TypeOpenMetrics)
+
+	case FormatProtobuf:
+		return m.gatherPrometheus(expfmt.TypeProtoDelim)
 
 	case FormatText:
 		return m.gatherGeneric()
@@ -218,9 +499,59 @@ func (m *Metrics) Gather(format string) (GatherResponse, error) {
 	}
 }
 
-// gatherPrometheus collects Prometheus metrics and returns a GatherResponse.
-// If Prometheus metrics are not enabled, it returns an error.
-func (m *Metrics) gatherPrometheus() (GatherResponse, error) {
+// NegotiateFormat returns the format to gather the metrics in for a request
+// with the given header, based on its Accept header. The OpenMetrics and
+// protobuf formats are only negotiated if Prometheus metrics are enabled,
+// otherwise and for any other accepted type the default format is returned.
+func (m *Metrics) NegotiateFormat(h http.Header) string {
+	if !m.prometheusEnabled {
+		return FormatDefault
+	}
+
+	switch expfmt.NegotiateIncludingOpenMetrics(h).FormatType() {
+	case expfmt.TypeOpenMetrics:
+		return FormatOpenMetrics
+
+	case expfmt.TypeProtoDelim:
+		return FormatProtobuf
+
+	default:
+		return FormatDefault
+	}
+}
+
+// Authorized returns whether a request with the given header is authorized to
+// gather the metrics, with either the configured bearer token or basic auth
+// credentials in its Authorization header. All the requests are authorized if
+// neither is configured. The credentials are compared in constant time.
+func (m *Metrics) Authorized(h http.Header) bool {
+	if m.authToken == nil && m.basicAuth == nil {
+		return true
+	}
+
+	scheme, credentials, _ := strings.Cut(h.Get("Authorization"), " ")
+	switch {
+	case strings.EqualFold(scheme, "Bearer") && m.authToken != nil:
+		digest := sha256.Sum256([]byte(credentials))
+		return subtle.ConstantTimeCompare(digest[:], m.authToken) == 1
+
+	case strings.EqualFold(scheme, "Basic") && m.basicAuth != nil:
+		decoded, err := base64.StdEncoding.DecodeString(credentials)
+		if err != nil {
+			return false
+		}
+		digest := sha256.Sum256(decoded)
+		return subtle.ConstantTimeCompare(digest[:], m.basicAuth) == 1
+
+	default:
+		return false
+	}
+}
+
+// gatherPrometheus collects Prometheus metrics, encodes them in the given
+// exposition format and returns a GatherResponse. If Prometheus metrics are
+// not enabled, it returns an error.
+func (m *Metrics) gatherPrometheus(formatType expfmt.FormatType) (GatherResponse, error) {
 	if !m.prometheusEnabled {
 		return GatherResponse{}, errors.New("prometheus metrics are not enabled")
 	}
@@ -233,7 +564,8 @@ func (m *Metrics) gatherPrometheus() (GatherResponse, error) {
 	buf := &bytes.Buffer{}
 	defer buf.Reset()
 
-	e := expfmt.NewEncoder(buf, expfmt.NewFormat(expfmt.TypeTextPlain))
+	format := expfmt.NewFormat(formatType)
+	e := expfmt.NewEncoder(buf, format)
 
 	for _, mf := range metricsFamilies {
 		if err := e.Encode(mf); err != nil {
@@ -241,17 +573,23 @@ func (m *Metrics) gatherPrometheus() (GatherResponse, error) {
 		}
 	}
 
-	return GatherResponse{ContentType: ContentTypeText, Metrics: buf.Bytes()}, nil
+	// the OpenMetrics encoder terminates the exposition on close
+	if closer, ok := e.(expfmt.Closer); ok {
+		if err := closer.Close(); err != nil {
+			return GatherResponse{}, fmt.Errorf("failed to encode prometheus metrics: %w", err)
+		}
+	}
+
+	return GatherResponse{ContentType: string(format), Metrics: buf.Bytes()}, nil
 }
 
 // gatherGeneric collects generic metrics and returns a GatherResponse.
 func (m *Metrics) gatherGeneric() (GatherResponse, error) {
-	gm, ok := m.sink.(DisplayableSink)
-	if !ok {
+	if m.memSink == nil {
 		return GatherResponse{}, errors.New("non in-memory metrics sink does not support generic format")
 	}
 
-	summary, err := gm.DisplayMetrics(nil, nil)
+	summary, err := m.memSink.DisplayMetrics(nil, nil)
 	if err != nil {
 		return GatherResponse{}, fmt.Errorf("failed to gather in-memory metrics: %w", err)
 	}
@@ -2,6 +2,7 @@ package telemetry
 
 import (
 	"encoding/json"
+	"net"
 	"strings"
 	"testing"
 	"time"
@@ -16,6 +17,22 @@ func TestMetrics_Disabled(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestGlobalMetrics(t *testing.T) {
+	m, err := New(Config{MetricsSink: MetricSinkInMem, Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+	require.Same(t, m, GlobalMetrics())
+
+	m.Close()
+	require.Nil(t, GlobalMetrics())
+
+	m, err = New(Config{MetricsSink: MetricSinkInMem, Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+	_, err = New(Config{Enabled: false})
+	require.NoError(t, err)
+	require.Nil(t, GlobalMetrics())
+	m.Close()
+}
+
 func TestMetrics_InMem(t *testing.T) {
 	m, err := New(Config{
 		MetricsSink:    MetricSinkInMem,
@@ -51,6 +68,7 @@ func TestMetrics_Prom(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.NotNil(t, m)
+	t.Cleanup(m.Close)
 	require.True(t, m.prometheusEnabled)
 
 	emitMetrics()
@@ -62,6 +80,95 @@ func TestMetrics_Prom(t *testing.T) {
 	require.True(t, strings.Contains(string(gr.Metrics), "test_dummy_counter 30"))
 }
 
+func TestMetrics_Fanout(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	m, err := New(Config{
+		MetricsSinks:            []string{MetricSinkStatsd, MetricSinkPrometheus},
+		StatsdAddr:              conn.LocalAddr().String(),
+		Enabled:                 true,
+		ServiceName:             "test",
+		PrometheusRetentionTime: 60,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	t.Cleanup(m.Close)
+
+	metrics.IncrCounter([]string{"fanout_counter"}, 3)
+
+	// the statsd sink flushes its buffer periodically
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 1024)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	require.Contains(t, string(buf[:n]), "test.fanout_counter:3.000000|c")
+
+	gr, err := m.Gather(FormatPrometheus)
+	require.NoError(t, err)
+	require.Contains(t, string(gr.Metrics), "test_fanout_counter 3")
+
+	// without the in-memory sink there is nothing to display
+	_, err = m.Gather(FormatText)
+	require.ErrorContains(t, err, "does not support generic format")
+}
+
+func TestConfig_MetricsSinks(t *testing.T) {
+	specs := map[string]struct {
+		cfg    Config
+		exp    []string
+		expErr string
+	}{
+		"default": {
+			exp: []string{MetricSinkInMem},
+		},
+		"single sink": {
+			cfg: Config{MetricsSink: MetricSinkDogsStatsd},
+			exp: []string{MetricSinkDogsStatsd},
+		},
+		"unknown single sink falls back to in-memory": {
+			cfg: Config{MetricsSink: "unknown"},
+			exp: []string{MetricSinkInMem},
+		},
+		"prometheus retention adds prometheus": {
+			cfg: Config{MetricsSink: MetricSinkStatsd, PrometheusRetentionTime: 60},
+			exp: []string{MetricSinkStatsd, MetricSinkPrometheus},
+		},
+		"sinks take precedence": {
+			cfg: Config{MetricsSink: MetricSinkOtel, MetricsSinks: []string{MetricSinkInMem, MetricSinkStatsd}},
+			exp: []string{MetricSinkInMem, MetricSinkStatsd},
+		},
+		"prometheus listed with retention": {
+			cfg: Config{MetricsSinks: []string{MetricSinkPrometheus, MetricSinkStatsd}, PrometheusRetentionTime: 60},
+			exp: []string{MetricSinkPrometheus, MetricSinkStatsd},
+		},
+		"prometheus listed without retention": {
+			cfg:    Config{MetricsSinks: []string{MetricSinkPrometheus}},
+			expErr: "requires a positive prometheus retention time",
+		},
+		"unknown sink": {
+			cfg:    Config{MetricsSinks: []string{MetricSinkInMem, "unknown"}},
+			expErr: `unsupported metrics sink: "unknown"`,
+		},
+		"duplicate sink": {
+			cfg:    Config{MetricsSinks: []string{MetricSinkStatsd, MetricSinkStatsd}},
+			expErr: `duplicate metrics sink: "statsd"`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			sinks, err := spec.cfg.metricsSinks()
+			if spec.expErr != "" {
+				require.ErrorContains(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, sinks)
+		})
+	}
+}
+
 func emitMetrics() {
 	ticker := time.NewTicker(time.Second)
 	timeout := time.After(30 * time.Second)
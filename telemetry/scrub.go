@@ -0,0 +1,239 @@
+package telemetry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/log/v2"
+)
+
+// Label policies, applied to the values of the metric labels before they
+// reach the sinks.
+const (
+	// LabelPolicyAllow keeps the label values as-is.
+	LabelPolicyAllow = "allow"
+	// LabelPolicyHash replaces the label values by the first 16 hexadecimal
+	// characters of their SHA-256 hash.
+	LabelPolicyHash = "hash"
+	// LabelPolicyTruncate, followed by ":<n>", keeps the first n characters of
+	// the label values.
+	LabelPolicyTruncate = "truncate"
+	// LabelPolicyDrop removes the labels.
+	LabelPolicyDrop = "drop"
+)
+
+// DefaultSensitiveLabelPatterns match bech32 addresses and hexadecimal strings
+// of 40 characters or more.
+var DefaultSensitiveLabelPatterns = []string{
+	`^[a-z0-9]{1,83}1[02-9ac-hj-np-z]{38,}$`,
+	`^(0x)?[0-9a-fA-F]{40,}$`,
+}
+
+// strictLabelsLogInterval is the minimum interval between two logs of the
+// sensitive values of a label name.
+const strictLabelsLogInterval = time.Minute
+
+// labelScrubber holds the global label scrubber, it holds nil if the label
+// values are emitted as-is. It is swapped by New while the metrics may be
+// emitted from any goroutine.
+var labelScrubber atomic.Pointer[scrubber]
+
+type labelPolicyKind int
+
+const (
+	labelAllow labelPolicyKind = iota
+	labelHash
+	labelTruncate
+	labelDrop
+)
+
+type labelPolicy struct {
+	kind labelPolicyKind
+	// n is the number of characters kept by the truncate policy
+	n int
+}
+
+// parseLabelPolicy parses a label policy, the empty policy allows the values.
+func parseLabelPolicy(policy string) (labelPolicy, error) {
+	switch policy {
+	case "", LabelPolicyAllow:
+		return labelPolicy{kind: labelAllow}, nil
+	case LabelPolicyHash:
+		return labelPolicy{kind: labelHash}, nil
+	case LabelPolicyDrop:
+		return labelPolicy{kind: labelDrop}, nil
+	}
+
+	if n, ok := strings.CutPrefix(policy, LabelPolicyTruncate+":"); ok {
+		length, err := strconv.Atoi(n)
+		if err != nil || length <= 0 {
+			return labelPolicy{}, fmt.Errorf("invalid truncate label policy %q: the length must be a positive integer", policy)
+		}
+		return labelPolicy{kind: labelTruncate, n: length}, nil
+	}
+
+	return labelPolicy{}, fmt.Errorf("unsupported label policy: %q", policy)
+}
+
+// apply returns the value with the policy applied and whether the label is
+// kept.
+func (p labelPolicy) apply(value string) (string, bool) {
+	switch p.kind {
+	case labelHash:
+		sum := sha256.Sum256([]byte(value))
+		return hex.EncodeToString(sum[:8]), true
+	case labelTruncate:
+		return truncate(value, p.n), true
+	case labelDrop:
+		return "", false
+	default:
+		return value, true
+	}
+}
+
+// truncate returns the first n characters of s, without allocating.
+func truncate(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+// scrubber applies the label policies to the label values and, in strict mode,
+// logs the values which reach the sinks and match a sensitive pattern.
+type scrubber struct {
+	policies      map[string]labelPolicy
+	defaultPolicy labelPolicy
+
+	strict   bool
+	patterns []*regexp.Regexp
+	logger   log.Logger
+	interval time.Duration
+	// logged maps the label names to the time their sensitive values were last
+	// logged at
+	mu     sync.Mutex
+	logged map[string]time.Time
+}
+
+// newScrubber returns the label scrubber of the configuration, or nil if the
+// label values are emitted as-is.
+func newScrubber(cfg Config) (*scrubber, error) {
+	if len(cfg.LabelPolicies) == 0 && cfg.DefaultLabelPolicy == "" && !cfg.StrictLabels {
+		return nil, nil
+	}
+
+	s := &scrubber{
+		policies: make(map[string]labelPolicy, len(cfg.LabelPolicies)),
+		strict:   cfg.StrictLabels,
+		logger:   cfg.Logger,
+		interval: strictLabelsLogInterval,
+		logged:   make(map[string]time.Time),
+	}
+
+	var err error
+	if s.defaultPolicy, err = parseLabelPolicy(cfg.DefaultLabelPolicy); err != nil {
+		return nil, err
+	}
+
+	for name, policy := range cfg.LabelPolicies {
+		if s.policies[name], err = parseLabelPolicy(policy); err != nil {
+			return nil, fmt.Errorf("label %q: %w", name, err)
+		}
+	}
+
+	if !s.strict {
+		return s, nil
+	}
+
+	patterns := cfg.SensitiveLabelPatterns
+	if len(patterns) == 0 {
+		patterns = DefaultSensitiveLabelPatterns
+	}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sensitive label pattern %q: %w", pattern, err)
+		}
+		s.patterns = append(s.patterns, re)
+	}
+
+	if s.logger == nil {
+		s.logger = log.NewLogger(os.Stderr)
+	}
+
+	return s, nil
+}
+
+// scrub applies the policies to the labels of the metric in place, removing
+// the dropped ones, and returns the remaining labels. A nil scrubber returns
+// the labels as-is.
+func (s *scrubber) scrub(keys []string, labels []metrics.Label) []metrics.Label {
+	if s == nil {
+		return labels
+	}
+
+	kept := labels[:0]
+	for _, label := range labels {
+		policy, ok := s.policies[label.Name]
+		if !ok {
+			policy = s.defaultPolicy
+		}
+
+		value, keep := policy.apply(label.Value)
+		if !keep {
+			continue
+		}
+
+		// the hashes never leak the values
+		if s.strict && policy.kind != labelHash {
+			s.check(keys, label.Name, value)
+		}
+
+		kept = append(kept, metrics.Label{Name: label.Name, Value: value})
+	}
+
+	return kept
+}
+
+// check logs the value of a label if it matches a sensitive pattern, at most
+// once per interval for each label name. The value itself is not logged.
+func (s *scrubber) check(keys []string, name, value string) {
+	for _, pattern := range s.patterns {
+		if !pattern.MatchString(value) {
+			continue
+		}
+
+		now := time.Now()
+		s.mu.Lock()
+		last, ok := s.logged[name]
+		if ok && now.Sub(last) < s.interval {
+			s.mu.Unlock()
+			return
+		}
+		s.logged[name] = now
+		s.mu.Unlock()
+
+		s.logger.Warn(
+			"sensitive value in metric label",
+			"metric", strings.Join(keys, "."),
+			"label", name,
+			"pattern", pattern.String(),
+			"length", utf8.RuneCountInString(value),
+		)
+		return
+	}
+}
@@ -0,0 +1,204 @@
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+)
+
+const (
+	testAddress = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	testHex     = "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293"
+)
+
+func TestScrubber_Policies(t *testing.T) {
+	s, err := newScrubber(Config{
+		LabelPolicies: map[string]string{
+			"module":  "allow",
+			"address": "hash",
+			"denom":   "truncate:5",
+			"memo":    "drop",
+		},
+		DefaultLabelPolicy: "truncate:3",
+	})
+	require.NoError(t, err)
+
+	labels := s.scrub([]string{"test"}, []metrics.Label{
+		NewLabel("module", "bank"),
+		NewLabel("address", testAddress),
+		NewLabel("denom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"),
+		NewLabel("memo", "private"),
+		NewLabel("unknown", "éèêë"),
+	})
+	require.Equal(t, []metrics.Label{
+		NewLabel("module", "bank"),
+		NewLabel("address", "01bfc685c3f5e160"),
+		NewLabel("denom", "ibc/2"),
+		NewLabel("unknown", "éèê"),
+	}, labels)
+
+	// the hashes are stable
+	require.Equal(t, labels[1], s.scrub(nil, []metrics.Label{NewLabel("address", testAddress)})[0])
+
+	// without any policy, the labels are emitted as-is
+	s, err = newScrubber(Config{})
+	require.NoError(t, err)
+	require.Nil(t, s)
+	labels = []metrics.Label{NewLabel("memo", "private")}
+	require.Equal(t, labels, s.scrub(nil, labels))
+
+	s, err = newScrubber(Config{DefaultLabelPolicy: "drop"})
+	require.NoError(t, err)
+	require.Empty(t, s.scrub(nil, []metrics.Label{NewLabel("module", "bank"), NewLabel("memo", "private")}))
+
+	for _, policy := range []string{"truncate", "truncate:0", "truncate:x", "mask"} {
+		_, err = newScrubber(Config{LabelPolicies: map[string]string{"memo": policy}})
+		require.Error(t, err, policy)
+	}
+	_, err = newScrubber(Config{StrictLabels: true, SensitiveLabelPatterns: []string{"("}})
+	require.ErrorContains(t, err, "invalid sensitive label pattern")
+}
+
+func TestScrubber_Allocations(t *testing.T) {
+	s, err := newScrubber(Config{
+		LabelPolicies:      map[string]string{"module": "allow", "memo": "drop"},
+		DefaultLabelPolicy: "truncate:8",
+	})
+	require.NoError(t, err)
+
+	labels := []metrics.Label{NewLabel("module", "bank"), NewLabel("memo", "private"), NewLabel("denom", "ibc/27394FB092D2ECCD")}
+	buf := make([]metrics.Label, len(labels))
+	allocs := testing.AllocsPerRun(100, func() {
+		copy(buf, labels)
+		s.scrub(nil, buf)
+	})
+	require.Zero(t, allocs)
+}
+
+func TestScrubber_Strict(t *testing.T) {
+	var out bytes.Buffer
+	s, err := newScrubber(Config{
+		LabelPolicies: map[string]string{"address": "hash"},
+		StrictLabels:  true,
+		Logger:        log.NewLogger(&out, log.ColorOption(false)),
+	})
+	require.NoError(t, err)
+
+	// the sensitive values are logged once per label name
+	s.scrub([]string{"tx", "count"}, []metrics.Label{NewLabel("sender", testAddress)})
+	s.scrub([]string{"tx", "count"}, []metrics.Label{NewLabel("sender", testAddress)})
+	s.scrub([]string{"tx", "size"}, []metrics.Label{NewLabel("hash", testHex)})
+	logs := out.String()
+	require.Equal(t, 2, strings.Count(logs, "sensitive value in metric label"), logs)
+	require.Contains(t, logs, "metric=tx.count")
+	require.Contains(t, logs, "label=sender")
+	require.Contains(t, logs, "metric=tx.size")
+	require.NotContains(t, logs, testAddress)
+	require.NotContains(t, logs, testHex)
+
+	// the other values and the hashed ones are not
+	out.Reset()
+	s.scrub([]string{"tx", "count"}, []metrics.Label{
+		NewLabel("module", "bank"),
+		NewLabel("short_hex", "0a1b2c3d"),
+		NewLabel("address", testAddress),
+	})
+	require.Empty(t, out.String())
+
+	// once the interval has elapsed, the values are logged again
+	s.interval = 0
+	s.scrub([]string{"tx", "count"}, []metrics.Label{NewLabel("sender", testAddress)})
+	require.Contains(t, out.String(), "label=sender")
+}
+
+func TestMetrics_LabelPolicies(t *testing.T) {
+	for _, scrubGlobalLabels := range []bool{false, true} {
+		m, err := New(Config{
+			MetricsSink:        MetricSinkInMem,
+			Enabled:            true,
+			ServiceName:        "test",
+			GlobalLabels:       [][]string{{"chain_id", "test-chain"}},
+			LabelPolicies:      map[string]string{"module": "allow", "chain_id": "truncate:4"},
+			DefaultLabelPolicy: "drop",
+			ScrubGlobalLabels:  scrubGlobalLabels,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			labelScrubber.Store(nil)
+			globalLabels = []metrics.Label{}
+		})
+
+		m.IncrCounter(1, []string{"scrubbed", "counter"}, NewLabel("module", "bank"), NewLabel("memo", "private"))
+		IncrCounterWithLabels([]string{"scrubbed", "wrapper"}, 1, []metrics.Label{NewLabel("memo", "private")})
+		IncrCounter(1, "scrubbed", "global")
+		SetGauge(1, "scrubbed", "global")
+		MeasureSince(time.Now(), "scrubbed", "global")
+
+		gr, err := m.Gather(FormatText)
+		require.NoError(t, err)
+
+		type metric struct {
+			Name   string
+			Labels map[string]string
+		}
+		var summary struct {
+			Counters []metric
+			Gauges   []metric
+			Samples  []metric
+		}
+		require.NoError(t, json.Unmarshal(gr.Metrics, &summary))
+
+		chainID := "test-chain"
+		if scrubGlobalLabels {
+			chainID = "test"
+		}
+
+		counters := make(map[string]map[string]string)
+		for _, c := range summary.Counters {
+			counters[c.Name] = c.Labels
+		}
+		require.Equal(t, map[string]string{"module": "bank", "chain_id": chainID}, counters["test.scrubbed.counter"])
+		require.Equal(t, map[string]string{"chain_id": chainID}, counters["test.scrubbed.wrapper"])
+
+		// the wrappers without labels emit the same global labels
+		require.Equal(t, map[string]string{"chain_id": chainID}, counters["test.scrubbed.global"])
+		require.Len(t, summary.Gauges, 1)
+		require.Equal(t, "test.scrubbed.global", summary.Gauges[0].Name)
+		require.Equal(t, map[string]string{"chain_id": chainID}, summary.Gauges[0].Labels)
+		require.Len(t, summary.Samples, 1)
+		require.Equal(t, "test.scrubbed.global", summary.Samples[0].Name)
+		require.Equal(t, map[string]string{"chain_id": chainID}, summary.Samples[0].Labels)
+		m.Close()
+	}
+}
+
+func TestWithGlobalLabels_ConcurrentScrubberSwap(t *testing.T) {
+	t.Cleanup(func() { labelScrubber.Store(nil) })
+
+	s, err := newScrubber(Config{DefaultLabelPolicy: "drop"})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = withGlobalLabels([]string{"test"}, []metrics.Label{NewLabel("memo", "private")})
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		labelScrubber.Store(s)
+		labelScrubber.Store(nil)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,136 @@
+package telemetry
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// metricsSnapshot holds the metrics gathered in every supported format at a
+// point in time. It is never modified once produced.
+type metricsSnapshot struct {
+	responses map[string]GatherResponse
+	errs      map[string]error
+	takenAt   time.Time
+}
+
+// snapshotter periodically gathers the metrics into an immutable snapshot, so
+// that scrapes encode nothing and never contend with metric emission on the
+// locks of the sinks. At most one snapshot is produced at a time.
+type snapshotter struct {
+	gather       func(format string) (GatherResponse, error)
+	formats      []string
+	interval     time.Duration
+	maxStaleness time.Duration
+	now          func() time.Time
+
+	current atomic.Pointer[metricsSnapshot]
+
+	// mu serializes the production of snapshots and guards lastSyncRefresh.
+	mu              sync.Mutex
+	lastSyncRefresh time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newSnapshotter(gather func(string) (GatherResponse, error), formats []string, interval, maxStaleness time.Duration) *snapshotter {
+	s := &snapshotter{
+		gather:       gather,
+		formats:      formats,
+		interval:     interval,
+		maxStaleness: maxStaleness,
+		now:          time.Now,
+		stop:         make(chan struct{}),
+	}
+	s.refresh()
+
+	return s
+}
+
+// start refreshes the snapshot on every interval in the background, until
+// close is called.
+func (s *snapshotter) start() {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.refresh()
+			case <-s.stop:
+				return
+			}
+		}
+	}()
+}
+
+func (s *snapshotter) close() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+// refresh produces a new snapshot and publishes it.
+func (s *snapshotter) refresh() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshLocked()
+}
+
+func (s *snapshotter) refreshLocked() {
+	snap := &metricsSnapshot{
+		responses: make(map[string]GatherResponse, len(s.formats)),
+		errs:      make(map[string]error),
+	}
+	for _, format := range s.formats {
+		gr, err := s.gather(format)
+		if err != nil {
+			snap.errs[format] = err
+			continue
+		}
+		snap.responses[format] = gr
+	}
+	snap.takenAt = s.now()
+
+	s.current.Store(snap)
+}
+
+// snapshot returns the latest snapshot. If it is older than the staleness
+// limit, it is refreshed synchronously first, but at most once per interval so
+// that scrapes cannot force a refresh on every request.
+func (s *snapshotter) snapshot() *metricsSnapshot {
+	snap := s.current.Load()
+	if s.maxStaleness <= 0 || s.now().Sub(snap.takenAt) <= s.maxStaleness {
+		return snap
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// another scrape or the background refresh may have refreshed meanwhile
+	snap = s.current.Load()
+	now := s.now()
+	if now.Sub(snap.takenAt) <= s.maxStaleness || now.Sub(s.lastSyncRefresh) < s.interval {
+		return snap
+	}
+
+	s.refreshLocked()
+	s.lastSyncRefresh = now
+
+	return s.current.Load()
+}
+
+// Gather returns the metrics of the latest snapshot in the given format,
+// which must be one of the formats of the snapshotter.
+func (s *snapshotter) Gather(format string) (GatherResponse, error) {
+	snap := s.snapshot()
+	if err, ok := snap.errs[format]; ok {
+		return GatherResponse{}, err
+	}
+
+	gr := snap.responses[format]
+	gr.SnapshotTime = snap.takenAt
+
+	return gr, nil
+}
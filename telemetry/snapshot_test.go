@@ -0,0 +1,163 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotter_Staleness(t *testing.T) {
+	var calls int
+	gather := func(format string) (GatherResponse, error) {
+		if format == FormatPrometheus {
+			return GatherResponse{}, errors.New("prometheus metrics are not enabled")
+		}
+		calls++
+		return GatherResponse{Metrics: []byte(strconv.Itoa(calls))}, nil
+	}
+
+	start := time.Unix(1_000, 0)
+	now := start
+	s := newSnapshotter(gather, []string{FormatText, FormatPrometheus}, 10*time.Second, 2*time.Second)
+	s.now = func() time.Time { return now }
+	s.refresh()
+	require.Equal(t, 2, calls)
+
+	at := func(offset time.Duration, expMetrics string, expTime time.Time) {
+		t.Helper()
+		now = start.Add(offset)
+		gr, err := s.Gather(FormatText)
+		require.NoError(t, err)
+		require.Equal(t, expMetrics, string(gr.Metrics))
+		require.Equal(t, expTime, gr.SnapshotTime)
+	}
+
+	// fresh enough
+	at(2*time.Second, "2", start)
+	// stale, refreshed synchronously
+	at(3*time.Second, "3", start.Add(3*time.Second))
+	// stale again, but already refreshed synchronously within the interval
+	at(6*time.Second, "3", start.Add(3*time.Second))
+	at(12*time.Second, "3", start.Add(3*time.Second))
+	// the interval has passed
+	at(13*time.Second, "4", start.Add(13*time.Second))
+
+	// errors are served from the snapshot as well
+	_, err := s.Gather(FormatPrometheus)
+	require.ErrorContains(t, err, "prometheus metrics are not enabled")
+	require.Equal(t, 4, calls)
+}
+
+func TestSnapshotter_StalenessBound(t *testing.T) {
+	// when the staleness limit is at least the interval, no snapshot older than
+	// the limit is ever served
+	const (
+		interval     = 5 * time.Second
+		maxStaleness = 7 * time.Second
+	)
+	s := newSnapshotter(func(string) (GatherResponse, error) { return GatherResponse{}, nil }, []string{FormatText}, interval, maxStaleness)
+	now := time.Unix(1_000, 0)
+	s.now = func() time.Time { return now }
+	s.refresh()
+
+	for i := 0; i < 100; i++ {
+		now = now.Add(time.Duration(i%4) * time.Second)
+		gr, err := s.Gather(FormatText)
+		require.NoError(t, err)
+		require.LessOrEqual(t, now.Sub(gr.SnapshotTime), maxStaleness)
+	}
+}
+
+func TestMetrics_Snapshot(t *testing.T) {
+	m, err := New(Config{
+		MetricsSink:      MetricSinkInMem,
+		Enabled:          true,
+		ServiceName:      "test",
+		SnapshotInterval: 60,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	t.Cleanup(m.Close)
+
+	counter := func() float64 {
+		t.Helper()
+		gr, err := m.Gather(FormatDefault)
+		require.NoError(t, err)
+		require.Equal(t, "application/json", gr.ContentType)
+		require.False(t, gr.SnapshotTime.IsZero())
+
+		var summary metrics.MetricsSummary
+		require.NoError(t, json.Unmarshal(gr.Metrics, &summary))
+		for _, c := range summary.Counters {
+			if c.Name == "test.snapshot_counter" {
+				return float64(c.Count)
+			}
+		}
+		return 0
+	}
+
+	// metrics emitted after the snapshot only appear in the next one
+	metrics.IncrCounter([]string{"snapshot_counter"}, 1)
+	require.Zero(t, counter())
+	m.snapshots.refresh()
+	require.Equal(t, 1.0, counter())
+
+	_, err = m.Gather(FormatPrometheus)
+	require.ErrorContains(t, err, "prometheus metrics are not enabled")
+	_, err = m.Gather("unknown")
+	require.ErrorContains(t, err, "unsupported metrics format")
+}
+
+// BenchmarkEmitWithConcurrentScrapes measures the latency of emitting a metric
+// while the metrics are continuously scraped.
+func BenchmarkEmitWithConcurrentScrapes(b *testing.B) {
+	for name, snapshotInterval := range map[string]int64{"live": 0, "snapshot": 1} {
+		b.Run(name, func(b *testing.B) {
+			m, err := New(Config{
+				MetricsSink:      MetricSinkInMem,
+				Enabled:          true,
+				ServiceName:      "bench",
+				SnapshotInterval: snapshotInterval,
+			})
+			require.NoError(b, err)
+			defer m.Close()
+
+			// populate the sink so that gathering has work to do
+			for i := 0; i < 1_000; i++ {
+				metrics.SetGauge([]string{"gauge", strconv.Itoa(i)}, float32(i))
+			}
+
+			done := make(chan struct{})
+			var wg sync.WaitGroup
+			for i := 0; i < 4; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for {
+						select {
+						case <-done:
+							return
+						default:
+							_, _ = m.Gather(FormatText)
+						}
+					}
+				}()
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				metrics.IncrCounter([]string{"counter"}, 1)
+			}
+			b.StopTimer()
+
+			close(done)
+			wg.Wait()
+		})
+	}
+}
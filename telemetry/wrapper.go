@@ -30,7 +30,7 @@ func ModuleMeasureSince(module string, start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(
 		keys,
 		start.UTC(),
-		append([]metrics.Label{NewLabel(MetricLabelNameModule, module)}, globalLabels...),
+		withGlobalLabels(keys, []metrics.Label{NewLabel(MetricLabelNameModule, module)}),
 	)
 }
 
@@ -45,7 +45,7 @@ func ModuleSetGauge(module string, val float32, keys ...string) {
 	metrics.SetGaugeWithLabels(
 		keys,
 		val,
-		append([]metrics.Label{NewLabel(MetricLabelNameModule, module)}, globalLabels...),
+		withGlobalLabels(keys, []metrics.Label{NewLabel(MetricLabelNameModule, module)}),
 	)
 }
 
@@ -56,7 +56,7 @@ func IncrCounter(val float32, keys ...string) {
 		return
 	}
 
-	metrics.IncrCounterWithLabels(keys, val, globalLabels)
+	metrics.IncrCounterWithLabels(keys, val, withGlobalLabels(keys, nil))
 }
 
 // Deprecated: IncrCounterWithLabels provides a wrapper functionality for emitting a counter
@@ -66,7 +66,7 @@ func IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label) {
 		return
 	}
 
-	metrics.IncrCounterWithLabels(keys, val, append(labels, globalLabels...))
+	metrics.IncrCounterWithLabels(keys, val, withGlobalLabels(keys, labels))
 }
 
 // Deprecated: SetGauge provides a wrapper functionality for emitting a gauge metric with
@@ -76,7 +76,7 @@ func SetGauge(val float32, keys ...string) {
 		return
 	}
 
-	metrics.SetGaugeWithLabels(keys, val, globalLabels)
+	metrics.SetGaugeWithLabels(keys, val, withGlobalLabels(keys, nil))
 }
 
 // Deprecated: SetGaugeWithLabels provides a wrapper functionality for emitting a gauge
@@ -86,7 +86,7 @@ func SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label) {
 		return
 	}
 
-	metrics.SetGaugeWithLabels(keys, val, append(labels, globalLabels...))
+	metrics.SetGaugeWithLabels(keys, val, withGlobalLabels(keys, labels))
 }
 
 // Deprecated: MeasureSince provides a wrapper functionality for emitting a time measure
@@ -96,7 +96,7 @@ func MeasureSince(start time.Time, keys ...string) {
 		return
 	}
 
-	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), withGlobalLabels(keys, nil))
 }
 
 // Deprecated: Now return the current time if telemetry is enabled or a zero time if it's not
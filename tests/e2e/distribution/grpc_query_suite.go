@@ -206,6 +206,7 @@ func (s *GRPCQueryTestSuite) TestQueryValidatorCommissionGRPC() {
 				Commission: types.ValidatorAccumulatedCommission{
 					Commission: commission,
 				},
+				Withdrawable: sdk.NewCoins(sdk.NewInt64Coin("stake", 9)),
 			},
 		},
 	}
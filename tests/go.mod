@@ -267,6 +267,7 @@ require (
 
 // Below are the long-lived replace for tests.
 replace (
+	cosmossdk.io/api => ../api
 	cosmossdk.io/log/v2 => ../log
 	// We always want to test against the latest version of the simapp.
 	cosmossdk.io/simapp => ../simapp
@@ -119,6 +119,8 @@ var (
 		GenType(&disttypes.MsgUpdateParams{}, &distapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 		GenType(&disttypes.MsgCommunityPoolSpend{}, &distapi.MsgCommunityPoolSpend{}, GenOpts),
 		GenType(&disttypes.MsgDepositValidatorRewardsPool{}, &distapi.MsgDepositValidatorRewardsPool{}, GenOpts),
+		GenType(&disttypes.MsgSweepCommunityPoolDenoms{}, &distapi.MsgSweepCommunityPoolDenoms{}, GenOpts),
+		GenType(&disttypes.MsgRegisterWithdrawOperator{}, &distapi.MsgRegisterWithdrawOperator{}, GenOpts),
 
 		// feegrant
 		GenType(&feegranttypes.MsgGrantAllowance{}, &feegrantapi.MsgGrantAllowance{},
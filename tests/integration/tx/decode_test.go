@@ -9,6 +9,8 @@ import (
 	"google.golang.org/protobuf/proto"
 	"pgregory.net/rapid"
 
+	distapi "cosmossdk.io/api/cosmos/distribution/v1beta1"
+	govv1api "cosmossdk.io/api/cosmos/gov/v1"
 	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/tx/decode"
@@ -30,9 +32,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/consensus"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	govv1types "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
@@ -153,6 +157,109 @@ func TestDecode(t *testing.T) {
 	legacytx.RegressionTestingAminoCodec = nil
 }
 
+// TestDecodeDistributionMsgs tests that the tx decoder accepts the fields added
+// to the distribution messages, which it rejects as unknown unless they are in
+// the descriptors of the api module.
+func TestDecodeDistributionMsgs(t *testing.T) {
+	encCfg := testutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, distribution.AppModuleBasic{}, gov.AppModuleBasic{})
+	authority := sdk.AccAddress("authority").String()
+
+	testCases := []struct {
+		name  string
+		msg   sdk.Msg
+		check func(t *testing.T, msg proto.Message)
+	}{
+		{
+			name: "MsgUpdateParams with community tax overrides",
+			msg: &disttypes.MsgUpdateParams{
+				Authority: authority,
+				Params: disttypes.Params{
+					CommunityTax:        math.LegacyNewDecWithPrec(2, 2),
+					BaseProposerReward:  math.LegacyZeroDec(),
+					BonusProposerReward: math.LegacyZeroDec(),
+					CommunityTaxOverrides: []disttypes.CommunityTaxOverride{
+						{Denom: "uatom", Rate: math.LegacyNewDecWithPrec(5, 2)},
+					},
+					MinWithdrawalAmount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+				},
+			},
+			check: func(t *testing.T, msg proto.Message) {
+				t.Helper()
+				params := msg.(*distapi.MsgUpdateParams).Params
+				require.Len(t, params.CommunityTaxOverrides, 1)
+				require.Equal(t, "uatom", params.CommunityTaxOverrides[0].Denom)
+				require.Len(t, params.MinWithdrawalAmount, 1)
+			},
+		},
+		{
+			name: "MsgWithdrawDelegatorReward on behalf of the delegator",
+			msg: &disttypes.MsgWithdrawDelegatorReward{
+				DelegatorAddress: sdk.AccAddress("delegator").String(),
+				ValidatorAddress: sdk.ValAddress("validator").String(),
+				OnBehalfOf:       sdk.AccAddress("operator").String(),
+			},
+			check: func(t *testing.T, msg proto.Message) {
+				t.Helper()
+				require.Equal(t, sdk.AccAddress("operator").String(), msg.(*distapi.MsgWithdrawDelegatorReward).OnBehalfOf)
+			},
+		},
+		{
+			name: "MsgRegisterWithdrawOperator",
+			msg:  disttypes.NewMsgRegisterWithdrawOperator(sdk.AccAddress("delegator").String(), sdk.AccAddress("operator").String()),
+			check: func(t *testing.T, msg proto.Message) {
+				t.Helper()
+				require.Equal(t, sdk.AccAddress("operator").String(), msg.(*distapi.MsgRegisterWithdrawOperator).OperatorAddress)
+			},
+		},
+		{
+			name: "MsgSubmitProposal with MsgSweepCommunityPoolDenoms",
+			msg: mustNewSubmitProposal(t, &disttypes.MsgSweepCommunityPoolDenoms{
+				Authority:       authority,
+				Denoms:          []string{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+				DisposalAddress: sdk.AccAddress("disposal").String(),
+			}),
+			check: func(t *testing.T, msg proto.Message) {
+				t.Helper()
+				proposalMsgs := msg.(*govv1api.MsgSubmitProposal).Messages
+				require.Len(t, proposalMsgs, 1)
+				sweep := &distapi.MsgSweepCommunityPoolDenoms{}
+				require.NoError(t, proposalMsgs[0].UnmarshalTo(sweep))
+				require.Len(t, sweep.Denoms, 1)
+				require.Equal(t, sdk.AccAddress("disposal").String(), sweep.DisposalAddress)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			txBytes, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+			require.NoError(t, err)
+
+			signContext, err := txsigning.NewContext(txsigning.Options{
+				AddressCodec:          dummyAddressCodec{},
+				ValidatorAddressCodec: dummyAddressCodec{},
+			})
+			require.NoError(t, err)
+			decoder, err := decode.NewDecoder(decode.Options{SigningContext: signContext})
+			require.NoError(t, err)
+			decodedTx, err := decoder.Decode(txBytes)
+			require.NoError(t, err)
+			require.Len(t, decodedTx.Messages, 1)
+			tc.check(t, decodedTx.Messages[0])
+		})
+	}
+}
+
+func mustNewSubmitProposal(t *testing.T, msgs ...sdk.Msg) *govv1types.MsgSubmitProposal {
+	t.Helper()
+
+	proposal, err := govv1types.NewMsgSubmitProposal(msgs, nil, sdk.AccAddress("proposer").String(), "", "title", "summary", false)
+	require.NoError(t, err)
+	return proposal
+}
+
 type dummyAddressCodec struct{}
 
 func (d dummyAddressCodec) StringToBytes(text string) ([]byte, error) {
@@ -0,0 +1,482 @@
+package simsx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+)
+
+const (
+	// DefaultGenesisParamsBlocks is the number of blocks of the simulation
+	// segment run for every genesis param set.
+	DefaultGenesisParamsBlocks = 30
+	// DefaultGenesisParamsBlockSize is the average number of operations per
+	// block of the simulation segment run for every genesis param set.
+	DefaultGenesisParamsBlockSize = 20
+	// DefaultGenesisParamsAccounts is the maximum number of accounts of the
+	// simulation segment run for every genesis param set.
+	DefaultGenesisParamsAccounts = 100
+)
+
+// ErrGenesisParamsRejected is returned for the genesis param sets rejected by
+// the genesis validation, which are not simulated.
+var ErrGenesisParamsRejected = errors.New("genesis params rejected")
+
+// GenesisParamsGenerator draws the params of a module, each within its
+// individually valid range.
+type GenesisParamsGenerator struct {
+	// Module is the name of the module in the app genesis.
+	Module string
+	// Draw draws the params of the module.
+	Draw func(t *rapid.T) proto.Message
+}
+
+// GenesisParams are the JSON encoded params of the modules, by module name.
+type GenesisParams map[string]json.RawMessage
+
+// GenesisParamsFailure is a genesis param set breaking the simulation, along
+// with the failure.
+type GenesisParamsFailure struct {
+	Params GenesisParams `json:"params"`
+	Error  string        `json:"error"`
+}
+
+// GenesisParamsFuzzConfig configures the fuzzing of the genesis params.
+type GenesisParamsFuzzConfig struct {
+	// Generators are the param generators of the modules.
+	Generators []GenesisParamsGenerator
+	// Modules is the allow-list of the modules whose params are drawn. The
+	// params of all the modules with a generator are drawn when empty.
+	Modules []string
+	// Seed is the simulation seed, the same for every param set.
+	Seed int64
+	// NumBlocks is the number of simulated blocks, DefaultGenesisParamsBlocks
+	// when zero.
+	NumBlocks int
+	// BlockSize is the average number of operations per block,
+	// DefaultGenesisParamsBlockSize when zero.
+	BlockSize int
+	// NumAccounts is the maximum number of simulated accounts,
+	// DefaultGenesisParamsAccounts when zero.
+	NumAccounts int
+	// ValidateGenesis validates the app genesis with the drawn params applied.
+	// The param sets it rejects are discarded. Nothing is validated when nil.
+	ValidateGenesis func(genesis map[string]json.RawMessage) error
+	// CorpusDir is the directory the failing param sets are persisted to, to
+	// be replayed by ReplayGenesisParamsCorpus. Nothing is persisted when empty.
+	CorpusDir string
+}
+
+func (cfg GenesisParamsFuzzConfig) generators() []GenesisParamsGenerator {
+	if len(cfg.Modules) == 0 {
+		return cfg.Generators
+	}
+
+	var generators []GenesisParamsGenerator
+	for _, g := range cfg.Generators {
+		if slices.Contains(cfg.Modules, g.Module) {
+			generators = append(generators, g)
+		}
+	}
+	return generators
+}
+
+// FuzzGenesisParams draws genesis param sets for the allowed modules with
+// rapid and runs a short simulation segment with a fixed seed for each of
+// them. A param set breaks the simulation when it panics or fails one of the
+// post run actions, typically checking the invariants of the app. The first
+// param set breaking the simulation is shrunk to a minimal one, which is
+// persisted to the corpus directory and returned. It returns nil if no param
+// set breaks the simulation.
+//
+// The number of param sets drawn and the time spent shrinking are set by the
+// -rapid.checks and -rapid.shrinktime flags.
+func FuzzGenesisParams[T SimulationApp](
+	tb testing.TB,
+	appFactory func(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp)) T,
+	setupStateFactory func(app T) SimStateFactory,
+	cfg GenesisParamsFuzzConfig,
+	postRunActions ...func(t testing.TB, app TestInstance[T], accs []simtypes.Account),
+) *GenesisParamsFailure {
+	tb.Helper()
+
+	generators := cfg.generators()
+	if len(generators) == 0 {
+		tb.Fatal("no genesis params generator for the allowed modules")
+	}
+
+	var failure *GenesisParamsFailure
+	checker := &failureRecorder{TB: tb}
+	rapid.Check(checker, func(t *rapid.T) {
+		params := make(GenesisParams, len(generators))
+		for _, g := range generators {
+			bz, err := codec.ProtoMarshalJSON(g.Draw(t), nil)
+			if err != nil {
+				t.Fatalf("marshal %s params: %v", g.Module, err)
+			}
+			params[g.Module] = bz
+		}
+
+		err := RunGenesisParams(tb, appFactory, setupStateFactory, cfg, params, postRunActions...)
+		switch {
+		case errors.Is(err, ErrGenesisParamsRejected):
+			t.Skip(err)
+		case err != nil:
+			// the last failure is the minimal one, rapid replaying it once shrunk
+			failure = &GenesisParamsFailure{Params: params, Error: err.Error()}
+			t.Fatal(err)
+		}
+	})
+
+	for _, msg := range checker.msgs {
+		tb.Log(msg)
+	}
+
+	if failure == nil {
+		if checker.Failed() {
+			tb.Fatal("genesis params fuzzing failed without a failing param set")
+		}
+		return nil
+	}
+
+	if cfg.CorpusDir != "" {
+		path, err := failure.persist(cfg.CorpusDir)
+		if err != nil {
+			tb.Fatalf("persist failing genesis params: %v", err)
+		}
+		tb.Logf("failing genesis params persisted to %s", path)
+	}
+
+	return failure
+}
+
+// RunGenesisParams runs a simulation segment with the given genesis params
+// applied to the genesis of the app. It returns ErrGenesisParamsRejected if
+// the genesis validation rejects them, or the failure of the segment.
+func RunGenesisParams[T SimulationApp](
+	tb testing.TB,
+	appFactory func(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp)) T,
+	setupStateFactory func(app T) SimStateFactory,
+	cfg GenesisParamsFuzzConfig,
+	params GenesisParams,
+	postRunActions ...func(t testing.TB, app TestInstance[T], accs []simtypes.Account),
+) (err error) {
+	tb.Helper()
+
+	simCfg := cli.NewConfigFromFlags()
+	simCfg.ChainID = SimAppChainID
+	simCfg.NumBlocks = cfg.NumBlocks
+	if simCfg.NumBlocks == 0 {
+		simCfg.NumBlocks = DefaultGenesisParamsBlocks
+	}
+	simCfg.BlockSize = cfg.BlockSize
+	if simCfg.BlockSize == 0 {
+		simCfg.BlockSize = DefaultGenesisParamsBlockSize
+	}
+
+	maxAccounts := cfg.NumAccounts
+	if maxAccounts == 0 {
+		maxAccounts = DefaultGenesisParamsAccounts
+	}
+	randAccFn := func(r *rand.Rand, n int) []simtypes.Account {
+		return simtypes.RandomAccounts(r, min(n, maxAccounts))
+	}
+
+	segment := &failureRecorder{TB: tb, abort: true}
+	defer func() {
+		segment.cleanup()
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case segmentAborted:
+			case genesisRejected:
+				err = fmt.Errorf("%w: %w", ErrGenesisParamsRejected, r.err)
+				return
+			default:
+				err = fmt.Errorf("panic: %v", r)
+				return
+			}
+		}
+		if segment.Failed() {
+			err = errors.New(strings.Join(segment.msgs, "\n"))
+		}
+	}()
+
+	// the stores can't be closed, so without sync pruning every app would
+	// leak its pruning goroutines, and the app of a segment aborted by a panic
+	// is not closed by the runner
+	closingAppFactory := func(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp)) T {
+		baseAppOptions = append(baseAppOptions, baseapp.SetIAVLSyncPruning(true))
+		app := appFactory(logger, db, traceStore, loadLatest, appOpts, baseAppOptions...)
+		segment.Cleanup(func() { _ = app.Close() })
+		return app
+	}
+	stateFactory := func(app T) SimStateFactory {
+		factory := setupStateFactory(app)
+		factory.AppStateFn = withGenesisParams(factory.AppStateFn, params, cfg.ValidateGenesis)
+		return factory
+	}
+	runWithSeed(segment, simCfg, closingAppFactory, stateFactory, cfg.Seed, nil, randAccFn, nil, postRunActions...)
+	return nil
+}
+
+// ReplayGenesisParamsCorpus runs a simulation segment for every param set
+// persisted to the corpus directory, as a subtest failing if the param set
+// still breaks the simulation. The param sets now rejected by the genesis
+// validation pass.
+func ReplayGenesisParamsCorpus[T SimulationApp](
+	t *testing.T,
+	appFactory func(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp)) T,
+	setupStateFactory func(app T) SimStateFactory,
+	cfg GenesisParamsFuzzConfig,
+	postRunActions ...func(t testing.TB, app TestInstance[T], accs []simtypes.Account),
+) {
+	t.Helper()
+
+	corpus, err := LoadGenesisParamsCorpus(cfg.CorpusDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(corpus)) {
+		t.Run(name, func(t *testing.T) {
+			err := RunGenesisParams(t, appFactory, setupStateFactory, cfg, corpus[name].Params, postRunActions...)
+			if errors.Is(err, ErrGenesisParamsRejected) {
+				t.Log(err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("genesis params still break the simulation, previously with %q: %v", corpus[name].Error, err)
+			}
+		})
+	}
+}
+
+// LoadGenesisParamsCorpus loads the failing param sets persisted to the
+// corpus directory, by file name. A missing directory is an empty corpus.
+func LoadGenesisParamsCorpus(dir string) (map[string]GenesisParamsFailure, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	corpus := make(map[string]GenesisParamsFailure, len(files))
+	for _, file := range files {
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var failure GenesisParamsFailure
+		if err := json.Unmarshal(bz, &failure); err != nil {
+			return nil, fmt.Errorf("invalid genesis params corpus file %s: %w", file, err)
+		}
+		corpus[filepath.Base(file)] = failure
+	}
+
+	return corpus, nil
+}
+
+// persist writes the failure to the corpus directory, in a file named after
+// the modules and a hash of the params, and returns its path.
+func (f GenesisParamsFailure) persist(dir string) (string, error) {
+	bz, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	params, err := json.Marshal(f.Params)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(params)
+	name := fmt.Sprintf("%s-%s.json", strings.Join(slices.Sorted(maps.Keys(f.Params)), "-"), hex.EncodeToString(digest[:4]))
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, name)
+	return path, os.WriteFile(path, bz, 0o600)
+}
+
+// genesisRejected aborts a segment whose genesis is rejected by the validation.
+type genesisRejected struct {
+	err error
+}
+
+// withGenesisParams returns an app state function replacing the params of the
+// modules in the genesis, before validating it.
+func withGenesisParams(
+	appStateFn simtypes.AppStateFn,
+	params GenesisParams,
+	validate func(genesis map[string]json.RawMessage) error,
+) simtypes.AppStateFn {
+	return func(r *rand.Rand, accs []simtypes.Account, config simtypes.Config) (json.RawMessage, []simtypes.Account, string, time.Time) {
+		appState, accounts, chainID, genesisTimestamp := appStateFn(r, accs, config)
+
+		genesis, err := applyGenesisParams(appState, params)
+		if err != nil {
+			panic(err)
+		}
+
+		if validate != nil {
+			if err := validate(genesis); err != nil {
+				panic(genesisRejected{err: err})
+			}
+		}
+
+		appState, err = json.Marshal(genesis)
+		if err != nil {
+			panic(err)
+		}
+
+		return appState, accounts, chainID, genesisTimestamp
+	}
+}
+
+// applyGenesisParams replaces the params field of the genesis of the modules.
+func applyGenesisParams(appState json.RawMessage, params GenesisParams) (map[string]json.RawMessage, error) {
+	var genesis map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &genesis); err != nil {
+		return nil, err
+	}
+
+	for module, moduleParams := range params {
+		bz, ok := genesis[module]
+		if !ok {
+			return nil, fmt.Errorf("module %s has no genesis", module)
+		}
+
+		var moduleGenesis map[string]json.RawMessage
+		if err := json.Unmarshal(bz, &moduleGenesis); err != nil {
+			return nil, fmt.Errorf("%s genesis: %w", module, err)
+		}
+
+		moduleGenesis["params"] = moduleParams
+		bz, err := json.Marshal(moduleGenesis)
+		if err != nil {
+			return nil, err
+		}
+		genesis[module] = bz
+	}
+
+	return genesis, nil
+}
+
+// segmentAborted is the panic aborting a segment on a fatal failure.
+type segmentAborted struct{}
+
+// failureRecorder records the failures reported to a test instead of failing
+// it. When abort is set, the fatal failures abort the caller by panicking with
+// segmentAborted, and the cleanups are run by cleanup instead of at the end of
+// the test.
+type failureRecorder struct {
+	testing.TB
+	abort bool
+
+	mu       sync.Mutex
+	failed   bool
+	msgs     []string
+	cleanups []func()
+}
+
+func (r *failureRecorder) record(msg string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failed = true
+	if msg != "" {
+		r.msgs = append(r.msgs, msg)
+	}
+}
+
+func (r *failureRecorder) Fail() { r.record("") }
+
+func (r *failureRecorder) Failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed
+}
+
+func (r *failureRecorder) FailNow() {
+	r.Fail()
+	if r.abort {
+		panic(segmentAborted{})
+	}
+}
+
+func (r *failureRecorder) Error(args ...any) { r.record(fmt.Sprint(args...)) }
+
+func (r *failureRecorder) Errorf(format string, args ...any) {
+	r.record(fmt.Sprintf(format, args...))
+}
+
+func (r *failureRecorder) Fatal(args ...any) {
+	r.Error(args...)
+	r.FailNow()
+}
+
+func (r *failureRecorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.FailNow()
+}
+
+func (r *failureRecorder) Cleanup(f func()) {
+	if !r.abort {
+		r.TB.Cleanup(f)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cleanups = append(r.cleanups, f)
+}
+
+func (r *failureRecorder) TempDir() string {
+	if !r.abort {
+		return r.TB.TempDir()
+	}
+
+	dir, err := os.MkdirTemp("", "genesis-params")
+	if err != nil {
+		r.Fatal(err)
+	}
+	r.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+// cleanup runs the cleanups in the reverse order of their registration.
+func (r *failureRecorder) cleanup() {
+	r.mu.Lock()
+	cleanups := r.cleanups
+	r.cleanups = nil
+	r.mu.Unlock()
+
+	for _, f := range slices.Backward(cleanups) {
+		f()
+	}
+}
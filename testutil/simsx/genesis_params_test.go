@@ -0,0 +1,94 @@
+package simsx
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestGenesisParamsFuzzConfigGenerators(t *testing.T) {
+	cfg := GenesisParamsFuzzConfig{
+		Generators: []GenesisParamsGenerator{{Module: "bank"}, {Module: "distribution"}, {Module: "staking"}},
+	}
+	assert.Len(t, cfg.generators(), 3)
+
+	cfg.Modules = []string{"staking", "distribution"}
+	got := Collect(cfg.generators(), func(g GenesisParamsGenerator) string { return g.Module })
+	assert.Equal(t, []string{"distribution", "staking"}, got)
+}
+
+func TestApplyGenesisParams(t *testing.T) {
+	appState := json.RawMessage(`{"bank":{"params":{"a":1},"balances":[]},"distribution":{"params":{"b":2}}}`)
+
+	genesis, err := applyGenesisParams(appState, GenesisParams{"bank": json.RawMessage(`{"a":3}`)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"params":{"a":3},"balances":[]}`, string(genesis["bank"]))
+	assert.JSONEq(t, `{"params":{"b":2}}`, string(genesis["distribution"]))
+
+	_, err = applyGenesisParams(appState, GenesisParams{"staking": json.RawMessage(`{}`)})
+	require.ErrorContains(t, err, "module staking has no genesis")
+}
+
+func TestWithGenesisParamsRejected(t *testing.T) {
+	appStateFn := withGenesisParams(
+		func(*rand.Rand, []simtypes.Account, simtypes.Config) (json.RawMessage, []simtypes.Account, string, time.Time) {
+			return json.RawMessage(`{"bank":{"params":{}}}`), nil, SimAppChainID, time.Time{}
+		},
+		GenesisParams{"bank": json.RawMessage(`{"a":1}`)},
+		func(map[string]json.RawMessage) error { return errors.New("invalid") },
+	)
+	defer func() {
+		r, ok := recover().(genesisRejected)
+		require.True(t, ok)
+		assert.EqualError(t, r.err, "invalid")
+	}()
+	appStateFn(nil, nil, simtypes.Config{})
+}
+
+func TestGenesisParamsCorpus(t *testing.T) {
+	dir := t.TempDir()
+	failure := GenesisParamsFailure{
+		Params: GenesisParams{"distribution": json.RawMessage(`{"community_tax":"1.5"}`)},
+		Error:  "panic: negative coin amount",
+	}
+
+	path, err := failure.persist(dir)
+	require.NoError(t, err)
+	assert.Regexp(t, `distribution-[0-9a-f]{8}\.json$`, path)
+
+	corpus, err := LoadGenesisParamsCorpus(dir)
+	require.NoError(t, err)
+	require.Len(t, corpus, 1)
+	for _, got := range corpus {
+		assert.Equal(t, failure.Error, got.Error)
+		assert.JSONEq(t, string(failure.Params["distribution"]), string(got.Params["distribution"]))
+	}
+
+	corpus, err = LoadGenesisParamsCorpus(t.TempDir() + "/missing")
+	require.NoError(t, err)
+	assert.Empty(t, corpus)
+}
+
+func TestFailureRecorder(t *testing.T) {
+	r := &failureRecorder{TB: t, abort: true}
+	var cleaned []int
+	r.Cleanup(func() { cleaned = append(cleaned, 1) })
+	r.Cleanup(func() { cleaned = append(cleaned, 2) })
+	dir := r.TempDir()
+	assert.DirExists(t, dir)
+
+	assert.PanicsWithValue(t, segmentAborted{}, func() { r.Fatalf("broken %d", 1) })
+	assert.True(t, r.Failed())
+	assert.Equal(t, []string{"broken 1"}, r.msgs)
+
+	r.cleanup()
+	assert.Equal(t, []int{2, 1}, cleaned)
+	assert.NoDirExists(t, dir)
+}
@@ -0,0 +1,344 @@
+package simsx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ErrKeeperAccessRequired is returned by CheckRemoteCapability for message factories which
+// are not known to build their messages from the ChainDataSource only.
+var ErrKeeperAccessRequired = errors.New("message factory may require keeper access, mark it with AsRemoteCapable when it does not")
+
+// RemoteCapable is implemented by message factories which declare that they only depend on
+// the ChainDataSource, so that they can build messages against a remote node.
+type RemoteCapable interface {
+	RemoteCapable() bool
+}
+
+var _ RemoteCapable = remoteCapableFactory{}
+
+type remoteCapableFactory struct {
+	SimMsgFactoryX
+}
+
+func (remoteCapableFactory) RemoteCapable() bool {
+	return true
+}
+
+// AsRemoteCapable marks a message factory as remote capable. The factory must only use the
+// ChainDataSource and must not unwrap the context into an sdk.Context, as in remote mode the
+// context is a plain context.Context and the data source is backed by gRPC queries.
+func AsRemoteCapable(f SimMsgFactoryX) SimMsgFactoryX {
+	if f == nil {
+		panic("message factory must not be nil")
+	}
+	return remoteCapableFactory{SimMsgFactoryX: f}
+}
+
+// CheckRemoteCapability returns an error describing why the message factory can not be run
+// against a remote node, or nil when it can.
+func CheckRemoteCapability(f SimMsgFactoryX) error {
+	rc, ok := f.(RemoteCapable)
+	if !ok || !rc.RemoteCapable() {
+		return ErrKeeperAccessRequired
+	}
+	if x, ok := f.(remoteCapableFactory); ok {
+		f = x.SimMsgFactoryX
+	}
+	if _, ok := f.(HasFutureOpsRegistry); ok {
+		return errors.New("message factory schedules future operations which are not supported remotely")
+	}
+	return nil
+}
+
+var _ BalanceSource = &RemoteBalanceSource{}
+
+// RemoteBalanceSource is a BalanceSource backed by the bank gRPC queries of a live node.
+// Query errors are reported as an empty balance or a disabled denom, so that message factories
+// skip instead of failing.
+type RemoteBalanceSource struct {
+	bank banktypes.QueryClient
+}
+
+// NewRemoteBalanceSource constructor
+func NewRemoteBalanceSource(conn gogogrpc.ClientConn) *RemoteBalanceSource {
+	return &RemoteBalanceSource{bank: banktypes.NewQueryClient(conn)}
+}
+
+func (s *RemoteBalanceSource) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	var (
+		coins   sdk.Coins
+		nextKey []byte
+	)
+	for {
+		res, err := s.bank.SpendableBalances(ctx, &banktypes.QuerySpendableBalancesRequest{
+			Address:    addr.String(),
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return sdk.Coins{}
+		}
+		coins = append(coins, res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return coins
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+func (s *RemoteBalanceSource) IsSendEnabledDenom(ctx context.Context, denom string) bool {
+	res, err := s.bank.SendEnabled(ctx, &banktypes.QuerySendEnabledRequest{Denoms: []string{denom}})
+	if err != nil {
+		return false
+	}
+	if len(res.SendEnabled) != 0 {
+		return res.SendEnabled[0].Enabled
+	}
+	params, err := s.bank.Params(ctx, &banktypes.QueryParamsRequest{})
+	if err != nil {
+		return false
+	}
+	return params.Params.DefaultSendEnabled
+}
+
+var _ AccountSourceX = &RemoteAccountSource{}
+
+// RemoteAccountSource is an AccountSourceX backed by the auth gRPC queries of a live node.
+type RemoteAccountSource struct {
+	auth         authtypes.QueryClient
+	addressCodec address.Codec
+}
+
+// NewRemoteAccountSource constructor
+func NewRemoteAccountSource(conn gogogrpc.ClientConn, addressCodec address.Codec) *RemoteAccountSource {
+	return &RemoteAccountSource{auth: authtypes.NewQueryClient(conn), addressCodec: addressCodec}
+}
+
+// GetAccount returns the account with the given address or nil when it does not exist or
+// the query failed.
+func (s *RemoteAccountSource) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	acc, err := s.getAccount(ctx, addr)
+	if err != nil {
+		return nil
+	}
+	return acc
+}
+
+func (s *RemoteAccountSource) getAccount(ctx context.Context, addr sdk.AccAddress) (sdk.AccountI, error) {
+	addrStr, err := s.addressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.auth.AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: addrStr})
+	if err != nil {
+		return nil, err
+	}
+	return res.Info, nil
+}
+
+// GetModuleAddress returns the address of a module account. Module account addresses are
+// derived from the module name, so no query is made.
+func (s *RemoteAccountSource) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+// RemoteTxResult is the result of a transaction broadcast to a remote node.
+type RemoteTxResult struct {
+	TxHash    string
+	Codespace string
+	Code      uint32
+	// Latency is the duration of the broadcast call
+	Latency time.Duration
+	// Err is set when the broadcast call itself failed
+	Err error
+}
+
+// Accepted returns true when the node accepted the transaction. With the sync broadcast mode,
+// this means the transaction passed CheckTx. With the async mode, it only means the node
+// received it.
+func (r RemoteTxResult) Accepted() bool {
+	return r.Err == nil && r.Code == 0
+}
+
+// ErrorCode returns a key for the error of a rejected transaction: the codespace and ABCI code
+// of a rejection by the node or the gRPC status code of a failed broadcast call.
+func (r RemoteTxResult) ErrorCode() string {
+	if r.Err != nil {
+		return fmt.Sprintf("grpc:%s", status.Code(r.Err))
+	}
+	return fmt.Sprintf("%s:%d", r.Codespace, r.Code)
+}
+
+type remoteSigner struct {
+	mx            sync.Mutex
+	loaded        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+// RemoteTXBuilder signs messages with the keys of sim accounts and broadcasts them through the
+// tx service of a remote node. Account numbers and sequences are fetched over gRPC on first
+// use and the sequences are then tracked locally, as the node only exposes the sequences of
+// the last committed block. The sequences of the signers are reloaded after a failed broadcast
+// call or a sequence mismatch.
+//
+// It is safe for concurrent use. Transactions of the same signer are serialized.
+type RemoteTXBuilder struct {
+	txConfig  client.TxConfig
+	accounts  *RemoteAccountSource
+	txService txtypes.ServiceClient
+	chainID   string
+	mode      txtypes.BroadcastMode
+	gas       uint64
+	fees      sdk.Coins
+
+	mx      sync.Mutex
+	signers map[string]*remoteSigner
+}
+
+// NewRemoteTXBuilder constructor
+func NewRemoteTXBuilder(
+	conn gogogrpc.ClientConn,
+	txConfig client.TxConfig,
+	accounts *RemoteAccountSource,
+	chainID string,
+	mode txtypes.BroadcastMode,
+	gas uint64,
+	fees sdk.Coins,
+) *RemoteTXBuilder {
+	if gas == 0 {
+		gas = sims.DefaultGenTxGas
+	}
+	return &RemoteTXBuilder{
+		txConfig:  txConfig,
+		accounts:  accounts,
+		txService: txtypes.NewServiceClient(conn),
+		chainID:   chainID,
+		mode:      mode,
+		gas:       gas,
+		fees:      fees,
+		signers:   make(map[string]*remoteSigner),
+	}
+}
+
+func (b *RemoteTXBuilder) signer(addr sdk.AccAddress) *remoteSigner {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	s, ok := b.signers[string(addr)]
+	if !ok {
+		s = &remoteSigner{}
+		b.signers[string(addr)] = s
+	}
+	return s
+}
+
+// Broadcast signs a transaction with the given message and senders and broadcasts it. An error
+// is only returned when the transaction could not be built.
+func (b *RemoteTXBuilder) Broadcast(ctx context.Context, r *rand.Rand, msg sdk.Msg, senders ...SimAccount) (RemoteTxResult, error) {
+	if len(senders) == 0 {
+		return RemoteTxResult{}, errors.New("no senders")
+	}
+
+	// lock the signers in address order to not deadlock with concurrent broadcasts
+	signers := make([]*remoteSigner, len(senders))
+	order := make([]int, len(senders))
+	for i, sender := range senders {
+		signers[i] = b.signer(sender.Address)
+		order[i] = i
+	}
+	slices.SortFunc(order, func(i, j int) int {
+		return bytes.Compare(senders[i].Address, senders[j].Address)
+	})
+	var locked []*remoteSigner
+	for _, i := range order {
+		if slices.Contains(locked, signers[i]) {
+			continue
+		}
+		signers[i].mx.Lock()
+		locked = append(locked, signers[i])
+	}
+	defer func() {
+		for _, s := range locked {
+			s.mx.Unlock()
+		}
+	}()
+
+	accountNumbers := make([]uint64, len(senders))
+	sequenceNumbers := make([]uint64, len(senders))
+	for i, s := range signers {
+		if !s.loaded {
+			acc, err := b.accounts.getAccount(ctx, senders[i].Address)
+			if err != nil {
+				return RemoteTxResult{}, fmt.Errorf("failed to fetch account %s: %w", senders[i].AddressBech32, err)
+			}
+			s.accountNumber, s.sequence, s.loaded = acc.GetAccountNumber(), acc.GetSequence(), true
+		}
+		accountNumbers[i] = s.accountNumber
+		sequenceNumbers[i] = s.sequence
+	}
+
+	tx, err := sims.GenSignedMockTx(
+		r,
+		b.txConfig,
+		[]sdk.Msg{msg},
+		b.fees,
+		b.gas,
+		b.chainID,
+		accountNumbers,
+		sequenceNumbers,
+		Collect(senders, func(a SimAccount) cryptotypes.PrivKey { return a.PrivKey })...,
+	)
+	if err != nil {
+		return RemoteTxResult{}, err
+	}
+	txBytes, err := b.txConfig.TxEncoder()(tx)
+	if err != nil {
+		return RemoteTxResult{}, err
+	}
+
+	start := time.Now()
+	res, err := b.txService.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{TxBytes: txBytes, Mode: b.mode})
+	result := RemoteTxResult{Latency: time.Since(start), Err: err}
+	if err == nil {
+		result.TxHash = res.TxResponse.TxHash
+		result.Codespace = res.TxResponse.Codespace
+		result.Code = res.TxResponse.Code
+	}
+
+	switch {
+	case result.Accepted():
+		for _, s := range locked {
+			s.sequence++
+		}
+	case err != nil || result.isWrongSequence():
+		for _, s := range locked {
+			s.loaded = false
+		}
+	}
+	return result, nil
+}
+
+func (r RemoteTxResult) isWrongSequence() bool {
+	return r.Codespace == sdkerrors.ErrWrongSequence.Codespace() && r.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
@@ -0,0 +1,296 @@
+package simsx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// RemoteLoadConfig configures a load generation run against a remote node.
+type RemoteLoadConfig struct {
+	// ChainID of the remote chain
+	ChainID string
+	// Accounts are the funded accounts signing the transactions. Their private keys must be set.
+	Accounts []simtypes.Account
+	// NumTxs is the number of transactions to generate
+	NumTxs int
+	// Concurrency is the number of transactions generated and broadcast in parallel. Defaults to 1.
+	Concurrency int
+	// Mode is the broadcast mode. Defaults to sync.
+	Mode txtypes.BroadcastMode
+	// Gas is the gas limit of each transaction. Defaults to sims.DefaultGenTxGas.
+	Gas uint64
+	// Fees are the fees paid by each transaction
+	Fees sdk.Coins
+	// Seed of the random source of the message factories
+	Seed int64
+}
+
+// RemoteLoadRunner generates messages with the sims message factories from the state of a
+// remote node, queried over gRPC, and broadcasts them as signed transactions through the tx
+// service of the node.
+//
+// Only message factories marked with AsRemoteCapable are run, the others are skipped and listed
+// in the report with the reason.
+type RemoteLoadRunner struct {
+	conn      gogogrpc.ClientConn
+	txConfig  client.TxConfig
+	accounts  *RemoteAccountSource
+	balances  *RemoteBalanceSource
+	factories []WeightedFactory
+}
+
+// NewRemoteLoadRunner constructor
+func NewRemoteLoadRunner(conn gogogrpc.ClientConn, txConfig client.TxConfig, factories []WeightedFactory) *RemoteLoadRunner {
+	return &RemoteLoadRunner{
+		conn:      conn,
+		txConfig:  txConfig,
+		accounts:  NewRemoteAccountSource(conn, txConfig.SigningContext().AddressCodec()),
+		balances:  NewRemoteBalanceSource(conn),
+		factories: factories,
+	}
+}
+
+// Run generates and broadcasts the configured number of transactions and returns the report.
+// Factory runs which are skipped do not count towards the number of transactions. An error is
+// returned when the config is invalid, no factory can be run remotely or the context is done.
+func (x *RemoteLoadRunner) Run(ctx context.Context, cfg RemoteLoadConfig) (*RemoteLoadReport, error) {
+	if len(cfg.Accounts) == 0 {
+		return nil, errors.New("no accounts")
+	}
+	if cfg.NumTxs <= 0 {
+		return nil, errors.New("number of transactions must be positive")
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 1
+	}
+	if cfg.Mode == txtypes.BroadcastMode_BROADCAST_MODE_UNSPECIFIED {
+		cfg.Mode = txtypes.BroadcastMode_BROADCAST_MODE_SYNC
+	}
+
+	report := newRemoteLoadReport()
+	var (
+		factories   []WeightedFactory
+		totalWeight uint64
+	)
+	for _, f := range x.factories {
+		if err := CheckRemoteCapability(f.Factory); err != nil {
+			report.SkippedFactories[sdk.MsgTypeURL(f.Factory.MsgType())] = err.Error()
+			continue
+		}
+		factories = append(factories, f)
+		totalWeight += uint64(f.Weight)
+	}
+	if len(factories) == 0 {
+		return report, errors.New("no message factory can be run remotely")
+	}
+
+	builder := NewRemoteTXBuilder(x.conn, x.txConfig, x.accounts, cfg.ChainID, cfg.Mode, cfg.Gas, cfg.Fees)
+	reporter := NewBasicSimulationReporter()
+
+	var (
+		mx      sync.Mutex
+		pending = cfg.NumTxs
+		runErr  error
+		wg      sync.WaitGroup
+	)
+	// next reserves a transaction to generate, it returns false once all are generated
+	next := func() bool {
+		mx.Lock()
+		defer mx.Unlock()
+		if pending == 0 || runErr != nil {
+			return false
+		}
+		pending--
+		return true
+	}
+	// release gives back a transaction reservation when the factory run was skipped
+	release := func(err error) {
+		mx.Lock()
+		defer mx.Unlock()
+		pending++
+		if err != nil && runErr == nil {
+			runErr = err
+		}
+	}
+
+	start := time.Now()
+	for i := 0; i < cfg.Concurrency; i++ {
+		wg.Add(1)
+		go func(r *rand.Rand) {
+			defer wg.Done()
+			for next() {
+				if err := ctx.Err(); err != nil {
+					release(err)
+					return
+				}
+
+				fx := pickWeightedFactory(r, factories, totalWeight)
+				fCtx, done := context.WithCancel(ctx)
+				testData := NewChainDataSource(fCtx, r, x.accounts, x.balances, x.accounts.addressCodec, cfg.Accounts...)
+				fReporter := reporter.WithScope(fx.MsgType(), SkipHookFn(func(args ...any) { done() }))
+				signers, msg := SafeRunFactoryMethod(fCtx, testData, fReporter, fx.Create())
+				done()
+				if fReporter.IsSkipped() || msg == nil {
+					report.addSkip(sdk.MsgTypeURL(fx.MsgType()), fReporter.Comment())
+					release(nil)
+					continue
+				}
+
+				res, err := builder.Broadcast(ctx, r, msg, signers...)
+				if err != nil {
+					report.addSkip(sdk.MsgTypeURL(fx.MsgType()), err.Error())
+					release(nil)
+					continue
+				}
+				report.add(res)
+			}
+		}(rand.New(rand.NewSource(cfg.Seed + int64(i))))
+	}
+	wg.Wait()
+	report.Duration = time.Since(start)
+
+	return report, runErr
+}
+
+func pickWeightedFactory(r *rand.Rand, factories []WeightedFactory, totalWeight uint64) SimMsgFactoryX {
+	n := uint64(r.Int63n(int64(totalWeight)))
+	for _, f := range factories {
+		if n < uint64(f.Weight) {
+			return f.Factory
+		}
+		n -= uint64(f.Weight)
+	}
+	return factories[len(factories)-1].Factory
+}
+
+// RemoteLoadReport summarizes a load generation run against a remote node.
+type RemoteLoadReport struct {
+	// Broadcast is the number of transactions broadcast
+	Broadcast int
+	// Accepted is the number of transactions accepted by the node
+	Accepted int
+	// ErrorCodes is the number of rejected transactions by error code, see RemoteTxResult.ErrorCode
+	ErrorCodes map[string]int
+	// Skipped is the number of skipped factory runs by message type URL and reason
+	Skipped map[string]map[string]int
+	// SkippedFactories are the reasons of the message factories which could not be run
+	// remotely by message type URL
+	SkippedFactories map[string]string
+	// Duration of the run
+	Duration time.Duration
+
+	mx        sync.Mutex
+	latencies []time.Duration
+}
+
+func newRemoteLoadReport() *RemoteLoadReport {
+	return &RemoteLoadReport{
+		ErrorCodes:       make(map[string]int),
+		Skipped:          make(map[string]map[string]int),
+		SkippedFactories: make(map[string]string),
+	}
+}
+
+func (r *RemoteLoadReport) add(res RemoteTxResult) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.Broadcast++
+	r.latencies = append(r.latencies, res.Latency)
+	if res.Accepted() {
+		r.Accepted++
+		return
+	}
+	r.ErrorCodes[res.ErrorCode()]++
+}
+
+func (r *RemoteLoadReport) addSkip(msgTypeURL, reason string) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	reasons, ok := r.Skipped[msgTypeURL]
+	if !ok {
+		reasons = make(map[string]int)
+		r.Skipped[msgTypeURL] = reasons
+	}
+	reasons[reason]++
+}
+
+// AcceptanceRate returns the share of the broadcast transactions accepted by the node.
+func (r *RemoteLoadReport) AcceptanceRate() float64 {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.Broadcast == 0 {
+		return 0
+	}
+	return float64(r.Accepted) / float64(r.Broadcast)
+}
+
+// Throughput returns the number of accepted transactions per second.
+func (r *RemoteLoadReport) Throughput() float64 {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.Duration <= 0 {
+		return 0
+	}
+	return float64(r.Accepted) / r.Duration.Seconds()
+}
+
+// LatencyPercentile returns the broadcast latency percentile p, within (0, 100], using the
+// nearest rank method.
+func (r *RemoteLoadReport) LatencyPercentile(p float64) time.Duration {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if len(r.latencies) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(r.latencies)
+	slices.Sort(sorted)
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	rank = max(1, min(rank, len(sorted)))
+	return sorted[rank-1]
+}
+
+func (r *RemoteLoadReport) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("broadcast: %d, accepted: %d (%.2f%%), duration: %s, throughput: %.2f tx/s\n",
+		r.Broadcast, r.Accepted, 100*r.AcceptanceRate(), r.Duration, r.Throughput()))
+	sb.WriteString(fmt.Sprintf("latency p50: %s, p90: %s, p99: %s, max: %s\n",
+		r.LatencyPercentile(50), r.LatencyPercentile(90), r.LatencyPercentile(99), r.LatencyPercentile(100)))
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if len(r.ErrorCodes) != 0 {
+		sb.WriteString("\nError codes:\n")
+	}
+	for _, code := range slices.Sorted(maps.Keys(r.ErrorCodes)) {
+		sb.WriteString(fmt.Sprintf("%d\t%s\n", r.ErrorCodes[code], code))
+	}
+	if len(r.Skipped) != 0 {
+		sb.WriteString("\nSkip reasons:\n")
+	}
+	for _, url := range slices.Sorted(maps.Keys(r.Skipped)) {
+		reasons := r.Skipped[url]
+		sb.WriteString(fmt.Sprintf("%d\t%s: %q\n", sum(slices.Collect(maps.Values(reasons))), url, slices.Sorted(maps.Keys(reasons))))
+	}
+	if len(r.SkippedFactories) != 0 {
+		sb.WriteString("\nSkipped factories:\n")
+	}
+	for _, url := range slices.Sorted(maps.Keys(r.SkippedFactories)) {
+		sb.WriteString(fmt.Sprintf("%s: %s\n", url, r.SkippedFactories[url]))
+	}
+	return sb.String()
+}
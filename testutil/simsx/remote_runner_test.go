@@ -0,0 +1,36 @@
+package simsx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteLoadReport(t *testing.T) {
+	report := newRemoteLoadReport()
+	assert.Equal(t, time.Duration(0), report.LatencyPercentile(50))
+	assert.Equal(t, 0., report.AcceptanceRate())
+
+	for i := 10; i > 0; i-- {
+		res := RemoteTxResult{Latency: time.Duration(i) * time.Millisecond}
+		if i%5 == 0 {
+			res.Codespace, res.Code = "sdk", 32
+		}
+		report.add(res)
+	}
+	report.addSkip("/my.Msg", "no account")
+	report.addSkip("/my.Msg", "no account")
+	report.Duration = 2 * time.Second
+
+	assert.Equal(t, 10, report.Broadcast)
+	assert.Equal(t, 8, report.Accepted)
+	assert.Equal(t, map[string]int{"sdk:32": 2}, report.ErrorCodes)
+	assert.Equal(t, map[string]map[string]int{"/my.Msg": {"no account": 2}}, report.Skipped)
+	assert.Equal(t, 0.8, report.AcceptanceRate())
+	assert.Equal(t, 4., report.Throughput())
+	assert.Equal(t, time.Millisecond, report.LatencyPercentile(1))
+	assert.Equal(t, 5*time.Millisecond, report.LatencyPercentile(50))
+	assert.Equal(t, 9*time.Millisecond, report.LatencyPercentile(90))
+	assert.Equal(t, 10*time.Millisecond, report.LatencyPercentile(100))
+}
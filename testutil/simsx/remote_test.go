@@ -0,0 +1,141 @@
+package simsx_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/testutil/simsx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type futureOpsFactory struct {
+	simsx.SimMsgFactoryX
+}
+
+func (futureOpsFactory) SetFutureOpsRegistry(simsx.FutureOpsRegistry) {}
+
+func TestCheckRemoteCapability(t *testing.T) {
+	specs := map[string]struct {
+		src    simsx.SimMsgFactoryX
+		expErr bool
+	}{
+		"marked": {
+			src: simsx.AsRemoteCapable(banksim.MsgSendFactory()),
+		},
+		"not marked": {
+			src:    banksim.MsgSendFactory(),
+			expErr: true,
+		},
+		"marked with future ops": {
+			src:    simsx.AsRemoteCapable(futureOpsFactory{banksim.MsgSendFactory()}),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := simsx.CheckRemoteCapability(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestRemoteLoadRunner(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test network in short mode")
+	}
+	cfg, err := network.DefaultConfigWithAppConfig(network.MinimumAppConfig())
+	require.NoError(t, err)
+	cfg.NumValidators = 1
+	cfg.TimeoutCommit = 500 * time.Millisecond
+
+	// fund the load accounts in genesis
+	accs := simtypes.RandomAccounts(rand.New(rand.NewSource(1)), 20)
+	var authGenState authtypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[authtypes.ModuleName], &authGenState)
+	var bankGenState banktypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankGenState)
+	for _, acc := range accs {
+		genAccs, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(acc.Address, acc.PubKey, 0, 0)})
+		require.NoError(t, err)
+		authGenState.Accounts = append(authGenState.Accounts, genAccs...)
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{
+			Address: acc.Address.String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(cfg.BondDenom, 1_000_000_000)),
+		})
+	}
+	cfg.GenesisState[authtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&authGenState)
+	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenState)
+
+	nw, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer nw.Cleanup()
+	require.NoError(t, nw.WaitForNextBlock())
+
+	conn, err := grpc.NewClient(
+		nw.Validators[0].AppConfig.GRPC.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(cfg.InterfaceRegistry).GRPCCodec())),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	runner := simsx.NewRemoteLoadRunner(conn, cfg.TxConfig, []simsx.WeightedFactory{
+		{Weight: 100, Factory: simsx.AsRemoteCapable(banksim.MsgSendFactory())},
+		{Weight: 100, Factory: banksim.MsgMultiSendFactory()},
+	})
+	const numTxs = 300
+	report, err := runner.Run(context.Background(), simsx.RemoteLoadConfig{
+		ChainID:     cfg.ChainID,
+		Accounts:    accs,
+		NumTxs:      numTxs,
+		Concurrency: 8,
+		Gas:         200_000,
+		Fees:        sdk.NewCoins(sdk.NewInt64Coin(cfg.BondDenom, 10)),
+		Seed:        1,
+	})
+	require.NoError(t, err)
+	t.Log(report.String())
+
+	assert.Equal(t, numTxs, report.Broadcast)
+	assert.Positive(t, report.Accepted)
+	var rejected int
+	for _, n := range report.ErrorCodes {
+		rejected += n
+	}
+	assert.Equal(t, report.Broadcast, report.Accepted+rejected)
+	assert.Contains(t, report.SkippedFactories, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	assert.NotContains(t, report.SkippedFactories, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	assert.Positive(t, report.LatencyPercentile(50))
+	assert.GreaterOrEqual(t, report.LatencyPercentile(99), report.LatencyPercentile(50))
+
+	// every accepted transaction is included in a block and increments the sequence of its signer
+	authQuery := authtypes.NewQueryClient(conn)
+	require.Eventually(t, func() bool {
+		var sequences uint64
+		for _, acc := range accs {
+			res, err := authQuery.AccountInfo(context.Background(), &authtypes.QueryAccountInfoRequest{Address: acc.Address.String()})
+			if err != nil {
+				return false
+			}
+			sequences += res.Info.Sequence
+		}
+		return sequences == uint64(report.Accepted)
+	}, 10*time.Second, 200*time.Millisecond)
+}
@@ -177,6 +177,38 @@ func RunWithSeedAndRandAcc[T SimulationApp](
 	fuzzSeed []byte,
 	randAccFn simtypes.RandomAccountFn,
 	postRunActions ...func(t testing.TB, app TestInstance[T], accs []simtypes.Account),
+) {
+	tb.Helper()
+	runWithSeed(tb, cfg, appFactory, setupStateFactory, seed, fuzzSeed, randAccFn, nil, postRunActions...)
+}
+
+// RunWithSeedAndPausePoints calls RunWithSeed with pause points. The simulation
+// is paused whenever a pause point is hit and resumes, deterministically, once its
+// callback returns. Pause points require cfg.Commit to be set.
+func RunWithSeedAndPausePoints[T SimulationApp](
+	tb testing.TB,
+	cfg simtypes.Config,
+	appFactory func(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp)) T,
+	setupStateFactory func(app T) SimStateFactory,
+	seed int64,
+	fuzzSeed []byte,
+	pausePoints []simulation.PausePoint,
+	postRunActions ...func(t testing.TB, app TestInstance[T], accs []simtypes.Account),
+) {
+	tb.Helper()
+	runWithSeed(tb, cfg, appFactory, setupStateFactory, seed, fuzzSeed, simtypes.RandomAccounts, pausePoints, postRunActions...)
+}
+
+func runWithSeed[T SimulationApp](
+	tb testing.TB,
+	cfg simtypes.Config,
+	appFactory func(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, appOpts servertypes.AppOptions, baseAppOptions ...func(*baseapp.BaseApp)) T,
+	setupStateFactory func(app T) SimStateFactory,
+	seed int64,
+	fuzzSeed []byte,
+	randAccFn simtypes.RandomAccountFn,
+	pausePoints []simulation.PausePoint,
+	postRunActions ...func(t testing.TB, app TestInstance[T], accs []simtypes.Account),
 ) {
 	tb.Helper()
 	// setup environment
@@ -205,6 +237,7 @@ func RunWithSeedAndRandAcc[T SimulationApp](
 		tCfg,
 		stateFactory.Codec,
 		testInstance.ExecLogWriter,
+		pausePoints...,
 	)
 	require.NoError(tb, err)
 	err = simtestutil.CheckExportSimulation(app, tCfg, simParams)
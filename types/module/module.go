@@ -294,6 +294,10 @@ type Manager struct {
 	OrderPrepareCheckStaters []string
 	OrderPrecommiters        []string
 	OrderMigrations          []string
+
+	// ParallelInitGenesis enables the concurrent initialization of the modules
+	// declaring their genesis dependencies, see HasGenesisDependencies.
+	ParallelInitGenesis bool
 }
 
 // NewManager creates a new Manager object.
@@ -489,31 +493,31 @@ func (m *Manager) RegisterServices(cfg Configurator) error {
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, genesisData map[string]json.RawMessage) (*abci.ResponseInitChain, error) {
 	var validatorUpdates []abci.ValidatorUpdate
 	ctx.Logger().Info("initializing blockchain state from genesis.json")
-	for _, moduleName := range m.OrderInitGenesis {
-		if genesisData[moduleName] == nil {
-			continue
+
+	var batches [][]string
+	if m.ParallelInitGenesis {
+		var ok bool
+		if batches, ok = m.initGenesisBatches(genesisData); !ok {
+			ctx.Logger().Info("not all modules declare their genesis dependencies, initializing them sequentially")
+			batches = nil
 		}
+	}
 
-		mod := m.Modules[moduleName]
-		// we might get an adapted module, a native core API module or a legacy module
-		if module, ok := mod.(appmodule.HasGenesis); ok {
-			ctx.Logger().Debug("running initialization for module", "module", moduleName)
-			// core API genesis
-			source, err := genesis.SourceFromRawJSON(genesisData[moduleName])
-			if err != nil {
-				return &abci.ResponseInitChain{}, err
+	if batches != nil {
+		var err error
+		if validatorUpdates, err = m.initGenesisParallel(ctx, cdc, genesisData, batches); err != nil {
+			return &abci.ResponseInitChain{}, err
+		}
+	} else {
+		for _, moduleName := range m.OrderInitGenesis {
+			if genesisData[moduleName] == nil {
+				continue
 			}
 
-			err = module.InitGenesis(ctx, source)
+			moduleValUpdates, err := m.initModuleGenesis(ctx, cdc, moduleName, genesisData[moduleName])
 			if err != nil {
 				return &abci.ResponseInitChain{}, err
 			}
-		} else if module, ok := mod.(HasGenesis); ok {
-			ctx.Logger().Debug("running initialization for module", "module", moduleName)
-			module.InitGenesis(ctx, cdc, genesisData[moduleName])
-		} else if module, ok := mod.(HasABCIGenesis); ok {
-			ctx.Logger().Debug("running initialization for module", "module", moduleName)
-			moduleValUpdates := module.InitGenesis(ctx, cdc, genesisData[moduleName])
 
 			// use these validator updates if provided, the module manager assumes
 			// only one module will update the validator set
@@ -536,6 +540,31 @@ func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, genesisData
 	}, nil
 }
 
+// initModuleGenesis initializes the state of a single module from its genesis
+// data and returns the validator updates of the module, if any.
+func (m *Manager) initModuleGenesis(ctx sdk.Context, cdc codec.JSONCodec, moduleName string, data json.RawMessage) ([]abci.ValidatorUpdate, error) {
+	mod := m.Modules[moduleName]
+	// we might get an adapted module, a native core API module or a legacy module
+	if module, ok := mod.(appmodule.HasGenesis); ok {
+		ctx.Logger().Debug("running initialization for module", "module", moduleName)
+		// core API genesis
+		source, err := genesis.SourceFromRawJSON(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, module.InitGenesis(ctx, source)
+	} else if module, ok := mod.(HasGenesis); ok {
+		ctx.Logger().Debug("running initialization for module", "module", moduleName)
+		module.InitGenesis(ctx, cdc, data)
+	} else if module, ok := mod.(HasABCIGenesis); ok {
+		ctx.Logger().Debug("running initialization for module", "module", moduleName)
+		return module.InitGenesis(ctx, cdc, data), nil
+	}
+
+	return nil, nil
+}
+
 // ExportGenesis performs export genesis functionality for modules
 func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) (map[string]json.RawMessage, error) {
 	return m.ExportGenesisForModules(ctx, cdc, []string{})
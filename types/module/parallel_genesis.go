@@ -0,0 +1,137 @@
+package module
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasGenesisDependencies is the interface for modules whose genesis can be
+// initialized concurrently with the genesis of other modules, when
+// Manager.ParallelInitGenesis is set.
+//
+// By implementing it, a module declares that its InitGenesis only depends on
+// the state initialized by the InitGenesis of the modules it returns, and that
+// it neither depends on nor writes to the state of any other module. The
+// InitGenesis of the module must be safe to call concurrently with the
+// InitGenesis of the other modules, e.g. it must not mutate any in-memory state
+// shared with their keepers.
+type HasGenesisDependencies interface {
+	// GenesisDependencies returns the names of the modules whose genesis must
+	// be initialized before the genesis of the module.
+	GenesisDependencies() []string
+}
+
+// initGenesisBatches splits the modules with genesis data, in OrderInitGenesis
+// order, into consecutive batches of modules which do not depend on each other.
+// It returns false if a module does not declare its genesis dependencies, in
+// which case the modules must be initialized sequentially.
+func (m *Manager) initGenesisBatches(genesisData map[string]json.RawMessage) ([][]string, bool) {
+	var (
+		batches [][]string
+		batch   []string
+	)
+	for _, moduleName := range m.OrderInitGenesis {
+		if genesisData[moduleName] == nil {
+			continue
+		}
+
+		module, ok := m.Modules[moduleName].(HasGenesisDependencies)
+		if !ok {
+			return nil, false
+		}
+
+		for _, dep := range module.GenesisDependencies() {
+			if slices.Contains(batch, dep) {
+				batches = append(batches, batch)
+				batch = nil
+				break
+			}
+		}
+		batch = append(batch, moduleName)
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches, true
+}
+
+// initGenesisParallel initializes the genesis of the batches of modules one
+// after the other, and the modules of a batch concurrently, each on its own
+// branch of the state. The branches of a batch are written in the order of the
+// modules in the batch once all the modules of the batch are initialized, so
+// the resulting state and events do not depend on the scheduling of the
+// modules. It returns the validator updates of the modules.
+func (m *Manager) initGenesisParallel(ctx sdk.Context, cdc codec.JSONCodec, genesisData map[string]json.RawMessage, batches [][]string) ([]abci.ValidatorUpdate, error) {
+	type genesisResult struct {
+		validatorUpdates []abci.ValidatorUpdate
+		err              error
+		panicked         any
+	}
+
+	var validatorUpdates []abci.ValidatorUpdate
+	for _, batch := range batches {
+		ctx.Logger().Debug("running initialization for modules", "modules", batch)
+
+		ctxs := make([]sdk.Context, len(batch))
+		writes := make([]func(), len(batch))
+		for i := range batch {
+			// the branches are created sequentially, as the multistore is not
+			// safe for concurrent use
+			ctxs[i], writes[i] = ctx.CacheContext()
+			ctxs[i] = ctxs[i].WithGasMeter(storetypes.NewInfiniteGasMeter()) // avoid race conditions
+		}
+
+		results := make([]genesisResult, len(batch))
+		var wg sync.WaitGroup
+		for i, moduleName := range batch {
+			wg.Add(1)
+			go func(i int, moduleName string) {
+				defer wg.Done()
+				defer func() {
+					// legacy modules panic on failure, the panic is raised again
+					// by the caller goroutine
+					if r := recover(); r != nil {
+						results[i].panicked = r
+					}
+				}()
+
+				results[i].validatorUpdates, results[i].err = m.initModuleGenesis(ctxs[i], cdc, moduleName, genesisData[moduleName])
+			}(i, moduleName)
+		}
+		wg.Wait()
+
+		for i, result := range results {
+			if result.panicked != nil {
+				panic(result.panicked)
+			}
+
+			if result.err != nil {
+				return nil, result.err
+			}
+
+			writes[i]()
+
+			// use these validator updates if provided, the module manager assumes
+			// only one module will update the validator set
+			if len(result.validatorUpdates) > 0 {
+				if len(validatorUpdates) > 0 {
+					return nil, errors.New("validator InitGenesis updates already set by a previous module")
+				}
+				validatorUpdates = result.validatorUpdates
+			}
+		}
+	}
+
+	return validatorUpdates, nil
+}
@@ -0,0 +1,219 @@
+package module_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var doneKey = []byte("done")
+
+// genesisTestModule is a module whose genesis is a number of entries, each
+// written under a key hashed from its index. The module checks that the
+// genesis of its dependencies is initialized before its own.
+type genesisTestModule struct {
+	name      string
+	key       *storetypes.KVStoreKey
+	deps      []*genesisTestModule
+	validator bool
+	declared  bool
+}
+
+var (
+	_ module.HasABCIGenesis         = (*genesisTestModule)(nil)
+	_ module.HasGenesisDependencies = declaredGenesisTestModule{}
+)
+
+// declaredGenesisTestModule is a genesisTestModule declaring its genesis
+// dependencies.
+type declaredGenesisTestModule struct {
+	*genesisTestModule
+}
+
+func (m *genesisTestModule) IsOnePerModuleType() {}
+func (m *genesisTestModule) IsAppModule()        {}
+
+func (m *genesisTestModule) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	return json.RawMessage(`0`)
+}
+
+func (m *genesisTestModule) ValidateGenesis(codec.JSONCodec, client.TxEncodingConfig, json.RawMessage) error {
+	return nil
+}
+
+func (m *genesisTestModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var entries int
+	if err := json.Unmarshal(data, &entries); err != nil {
+		panic(err)
+	}
+
+	for _, dep := range m.deps {
+		if !ctx.KVStore(dep.key).Has(doneKey) {
+			panic(fmt.Sprintf("genesis of %s initialized before %s", m.name, dep.name))
+		}
+	}
+
+	kvStore := ctx.KVStore(m.key)
+	hash := sha256.Sum256([]byte(m.name))
+	for i := 0; i < entries; i++ {
+		hash = sha256.Sum256(binary.BigEndian.AppendUint64(hash[:], uint64(i)))
+		kvStore.Set(hash[:8], hash[8:])
+	}
+	kvStore.Set(doneKey, []byte{1})
+	ctx.EventManager().EmitEvent(sdk.NewEvent("genesis", sdk.NewAttribute("module", m.name)))
+
+	if m.validator {
+		return []abci.ValidatorUpdate{{Power: int64(entries)}}
+	}
+	return nil
+}
+
+func (m *genesisTestModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
+	return nil
+}
+
+func (m declaredGenesisTestModule) GenesisDependencies() []string {
+	deps := make([]string, len(m.deps))
+	for i, dep := range m.deps {
+		deps[i] = dep.name
+	}
+	return deps
+}
+
+// genesisTestModules returns a bank like module the other modules depend on,
+// independent modules, and a staking like module returning the validator
+// updates and depending on the bank like module.
+func genesisTestModules(independent int) []*genesisTestModule {
+	bank := &genesisTestModule{name: "bank", declared: true}
+	modules := []*genesisTestModule{bank}
+	for i := 0; i < independent; i++ {
+		modules = append(modules, &genesisTestModule{name: fmt.Sprintf("module%d", i), declared: true})
+	}
+	modules = append(modules, &genesisTestModule{name: "staking", deps: []*genesisTestModule{bank}, validator: true, declared: true})
+
+	for _, m := range modules {
+		m.key = storetypes.NewKVStoreKey(m.name)
+	}
+	return modules
+}
+
+// newGenesisTestManager returns a manager of the modules, in their order, and
+// a store with the stores of the modules mounted.
+func newGenesisTestManager(tb testing.TB, modules []*genesisTestModule, parallel bool) (*module.Manager, store.CommitMultiStore) {
+	tb.Helper()
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	moduleMap := make(map[string]any, len(modules))
+	order := make([]string, len(modules))
+	for i, m := range modules {
+		cms.MountStoreWithDB(m.key, storetypes.StoreTypeIAVL, nil)
+		moduleMap[m.name] = m
+		if m.declared {
+			moduleMap[m.name] = declaredGenesisTestModule{m}
+		}
+		order[i] = m.name
+	}
+	require.NoError(tb, cms.LoadLatestVersion())
+
+	return &module.Manager{Modules: moduleMap, OrderInitGenesis: order, ParallelInitGenesis: parallel}, cms
+}
+
+// initGenesis initializes the genesis of the modules on a fresh store, like
+// InitChain does, and returns the resulting app hash and events.
+func initGenesis(tb testing.TB, modules []*genesisTestModule, genesisData map[string]json.RawMessage, parallel bool) ([]byte, sdk.Events) {
+	tb.Helper()
+
+	mm, cms := newGenesisTestManager(tb, modules, parallel)
+	cacheMS := cms.CacheMultiStore()
+	ctx := sdk.NewContext(cacheMS, cmtproto.Header{}, false, log.NewNopLogger())
+	res, err := mm.InitGenesis(ctx, codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), genesisData)
+	require.NoError(tb, err)
+	require.Len(tb, res.Validators, 1)
+
+	cacheMS.Write()
+	return cms.Commit().Hash, ctx.EventManager().Events()
+}
+
+func TestManager_InitGenesisParallel(t *testing.T) {
+	modules := genesisTestModules(8)
+	genesisData := make(map[string]json.RawMessage, len(modules))
+	for i, m := range modules {
+		genesisData[m.name] = json.RawMessage(fmt.Sprintf("%d", 100*(i+1)))
+	}
+
+	hash, events := initGenesis(t, modules, genesisData, false)
+	require.Len(t, events, len(modules))
+
+	// the state and events do not depend on the scheduling of the modules
+	for i := 0; i < 10; i++ {
+		parallelHash, parallelEvents := initGenesis(t, modules, genesisData, true)
+		require.Equal(t, hash, parallelHash)
+		require.Equal(t, events, parallelEvents)
+	}
+
+	// a module without genesis data is skipped
+	delete(genesisData, "module0")
+	hash, _ = initGenesis(t, modules, genesisData, false)
+	parallelHash, _ := initGenesis(t, modules, genesisData, true)
+	require.Equal(t, hash, parallelHash)
+
+	// a module not declaring its dependencies falls back to sequential
+	// initialization, which still initializes the dependencies first
+	modules[len(modules)-1].declared = false
+	parallelHash, _ = initGenesis(t, modules, genesisData, true)
+	require.Equal(t, hash, parallelHash)
+}
+
+func TestManager_InitGenesisParallelErrors(t *testing.T) {
+	modules := genesisTestModules(2)
+	genesisData := map[string]json.RawMessage{"bank": json.RawMessage(`1`), "module0": json.RawMessage(`1`), "staking": json.RawMessage(`1`)}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	initGenesis := func() error {
+		mm, cms := newGenesisTestManager(t, modules, true)
+		_, err := mm.InitGenesis(sdk.NewContext(cms.CacheMultiStore(), cmtproto.Header{}, false, log.NewNopLogger()), cdc, genesisData)
+		return err
+	}
+
+	// the panic of a module is raised by the caller
+	genesisData["module0"] = json.RawMessage(`"invalid"`)
+	require.Panics(t, func() { _ = initGenesis() })
+
+	// at most one module returns validator updates
+	genesisData["module0"] = json.RawMessage(`1`)
+	modules[1].validator = true
+	require.ErrorContains(t, initGenesis(), "validator InitGenesis updates already set by a previous module")
+}
+
+func BenchmarkManager_InitGenesis(b *testing.B) {
+	modules := genesisTestModules(6)
+	genesisData := make(map[string]json.RawMessage, len(modules))
+	for _, m := range modules {
+		genesisData[m.name] = json.RawMessage(`20000`)
+	}
+
+	for _, parallel := range []bool{false, true} {
+		b.Run(fmt.Sprintf("parallel=%t", parallel), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				initGenesis(b, modules, genesisData, parallel)
+			}
+		})
+	}
+}
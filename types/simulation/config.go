@@ -1,6 +1,10 @@
 package simulation
 
-import "testing"
+import (
+	"errors"
+	"testing"
+	"time"
+)
 
 // Config contains the necessary configuration flags for the simulator
 type Config struct {
@@ -28,12 +32,47 @@ type Config struct {
 	TB          testing.TB
 	FauxMerkle  bool
 
+	BlockTimeModel BlockTimeModel // how the block time advances between blocks
+
 	// Deprecated: unused and will be removed
 	OnOperation bool // run slow invariants every operation
 	// Deprecated: unused and will be removed
 	AllInvariants bool // print all failed invariants if a broken invariant is found
 }
 
+// BlockTimeModel configures how the simulated block time advances between
+// blocks. The time between two blocks is drawn from a mixture of tiny
+// increments of 1ms to 1s, the normal range of the simulation and large gaps
+// of 1 to 12 hours, with the given relative weights. When the tiny and gap
+// weights are zero, the time is always drawn from the normal range.
+type BlockTimeModel struct {
+	TinyWeight   int
+	NormalWeight int
+	GapWeight    int
+
+	// HaltHeight is the height of a block produced HaltDuration after the
+	// previous one, as after a chain halt. Zero disables the halt.
+	HaltHeight   int64
+	HaltDuration time.Duration
+}
+
+// Validate checks that the weights are not negative and the halt is positive.
+func (m BlockTimeModel) Validate() error {
+	if m.TinyWeight < 0 || m.NormalWeight < 0 || m.GapWeight < 0 {
+		return errors.New("block time weights cannot be negative")
+	}
+
+	if m.HaltHeight < 0 {
+		return errors.New("block time halt height cannot be negative")
+	}
+
+	if m.HaltHeight > 0 && m.HaltDuration <= 0 {
+		return errors.New("block time halt duration must be positive")
+	}
+
+	return nil
+}
+
 func (c Config) shallowCopy() Config {
 	return c
 }
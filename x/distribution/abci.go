@@ -15,3 +15,13 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 
 	return nil
 }
+
+// EndBlocker flushes the per validator dust counters batched during the block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	if err := k.EndBlocker(ctx); err != nil {
+		ctx.Logger().Error("EndBlocker failed", "error", err)
+		return err
+	}
+
+	return nil
+}
@@ -85,7 +85,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the amount of coins in the community pool",
 					Example:   fmt.Sprintf(`$ %s query distribution community-pool`, version.AppName),
 				},
+				{
+					RpcMethod: "WithdrawOperator",
+					Use:       "withdraw-operator [delegator-addr]",
+					Short:     "Query the withdraw operator registered by a delegator",
+					Example:   fmt.Sprintf(`$ %s query distribution withdraw-operator cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "DelegationRewardsAtHeight",
+					Skip:      true, // Skipping this command as it is defined in client/cli.
+				},
+				{
+					RpcMethod: "ValidatorCommissionWithholding",
+					Skip:      true, // Skipping this command as it is defined in client/cli.
+				},
+				{
+					RpcMethod: "CommunityPoolSpendable",
+					Skip:      true, // Skipping this command as it is defined in client/cli.
+				},
+				{
+					RpcMethod: "ValidatorForfeitedRewards",
+					Skip:      true, // Skipping this command as it is defined in client/cli.
+				},
 			},
+			EnhanceCustomCommand: true,
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: distributionv1beta1.Msg_ServiceDesc.ServiceName,
@@ -153,6 +179,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "SweepCommunityPoolDenoms",
+					Use:       "sweep-community-pool-denoms-proposal [denoms]",
+					Example:   fmt.Sprintf(`$ %s tx distribution sweep-community-pool-denoms-proposal ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --disposal-address [address]`, version.AppName),
+					Short:     "Submit a proposal to remove denoms from the community pool, burning them unless a disposal address is set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denoms", Varargs: true},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "RegisterWithdrawOperator",
+					Use:       "register-withdraw-operator [operator-addr]",
+					Short:     "Register the operator allowed to withdraw the delegation rewards on behalf of the delegator, or revoke it if omitted",
+					Example:   fmt.Sprintf("%s tx distribution register-withdraw-operator cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator_address", Optional: true},
+					},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// KeeperLoader loads the distribution keeper of the application whose state
+// is stored in the home directory of a stopped node, along with a context
+// reading its latest committed state. The returned function releases the state.
+type KeeperLoader func(cmd *cobra.Command) (sdk.Context, keeper.Keeper, func() error, error)
+
+// GetDebugCmd returns the root CLI command handler for the x/distribution
+// debug commands, which read the state of a stopped node.
+func GetDebugCmd(valAc address.Codec, load KeeperLoader) *cobra.Command {
+	distDebugCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Debugging commands for the distribution module state of a stopped node",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	distDebugCmd.AddCommand(
+		NewRefCountsCmd(valAc, load),
+	)
+
+	return distDebugCmd
+}
+
+// NewRefCountsCmd returns a CLI command handler for checking the reference
+// counts of the historical rewards of the validators against the references
+// to their periods.
+func NewRefCountsCmd(valAc address.Codec, load KeeperLoader) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	return &cobra.Command{
+		Use:   "refcounts [validator]",
+		Short: "Check the reference counts of the historical rewards of the validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Recompute the reference counts of the historical rewards of all the validators, or of the
+given one, from the delegations, slash events and current rewards referencing their periods, and
+print the periods whose stored reference count differs. The node must not be running.
+
+Example:
+$ %s debug distribution refcounts
+$ %s debug distribution refcounts %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		// the mismatches are reported as an error, which is not a usage error
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var val sdk.ValAddress
+			if len(args) == 1 {
+				bz, err := valAc.StringToBytes(args[0])
+				if err != nil {
+					return err
+				}
+				val = bz
+			}
+
+			ctx, k, closeFn, err := load(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFn() //nolint:errcheck // ignore close error
+
+			mismatches := k.ReferenceCountMismatches(ctx, val)
+			if len(mismatches) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no reference count mismatch")
+				return nil
+			}
+
+			var last sdk.ValAddress
+			for _, m := range mismatches {
+				if !m.Validator.Equals(last) {
+					operator, err := valAc.BytesToString(m.Validator)
+					if err != nil {
+						return err
+					}
+					fmt.Fprintln(cmd.OutOrStdout(), operator)
+					last = m.Validator
+				}
+
+				stored := fmt.Sprintf("%d", m.Actual)
+				if m.Missing {
+					stored = "missing"
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "  period %d: -%s +%d\n", m.Period, stored, m.Expected)
+			}
+
+			return fmt.Errorf("found %d historical rewards records with a mismatched reference count", len(mismatches))
+		},
+	}
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetQueryCmd returns the root CLI command handler for the x/distribution query commands
+// which are not generated by autocli.
+func GetQueryCmd(valAc, ac address.Codec) *cobra.Command {
+	distQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the distribution module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	distQueryCmd.AddCommand(
+		NewDelegationRewardsAtHeightCmd(valAc, ac),
+		NewValidatorCommissionWithholdingCmd(valAc),
+		NewCommunityPoolSpendableCmd(),
+		NewValidatorForfeitedRewardsCmd(valAc),
+	)
+
+	return distQueryCmd
+}
+
+// NewDelegationRewardsAtHeightCmd returns a CLI command handler for querying the rewards of a delegation at a historical height.
+func NewDelegationRewardsAtHeightCmd(valAc, ac address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "rewards-at-height [delegator-addr] [validator-addr] [height]",
+		Short: "Query the rewards accrued by a delegation as of a historical height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards accrued by a delegation as of a historical height, without withdrawing them.
+The query is executed against the state of the height, which must not be pruned by the queried node.
+
+Example:
+$ %s query distribution rewards-at-height %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			if _, err := valAc.StringToBytes(args[1]); err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[2], err)
+			}
+			if height <= 0 {
+				return fmt.Errorf("height must be positive, got %d", height)
+			}
+
+			// the query is routed to the state of the requested height
+			queryClient := types.NewQueryClient(clientCtx.WithHeight(height))
+			res, err := queryClient.DelegationRewardsAtHeight(cmd.Context(), &types.QueryDelegationRewardsAtHeightRequest{
+				DelegatorAddress: args[0],
+				ValidatorAddress: args[1],
+				Height:           height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewValidatorCommissionWithholdingCmd returns a CLI command handler for querying the commission withholding of a validator.
+func NewValidatorCommissionWithholdingCmd(valAc address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "commission-withholding [validator-addr]",
+		Short: "Query whether the commission of a validator is withheld and the commission withheld so far",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the commission withdrawals of a validator are withheld by governance, and the commission withheld so far.
+
+Example:
+$ %s query distribution commission-withholding %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := valAc.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorCommissionWithholding(cmd.Context(), &types.QueryValidatorCommissionWithholdingRequest{
+				ValidatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCommunityPoolSpendableCmd returns a CLI command handler for querying the spendable coins of the community pool.
+func NewCommunityPoolSpendableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-spendable",
+		Short: "Query the coins of the community pool which can be spent",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the integer coins of the community pool which can be spent, along with the decimal total of the
+community pool and its fractional remainder, which cannot be spent.
+
+Example:
+$ %s query distribution community-pool-spendable
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CommunityPoolSpendable(cmd.Context(), &types.QueryCommunityPoolSpendableRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewValidatorForfeitedRewardsCmd returns a CLI command handler for querying the rewards forfeited by a validator.
+func NewValidatorForfeitedRewardsCmd(valAc address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "forfeited-rewards [validator-addr]",
+		Short: "Query the rewards of a validator forfeited to the community pool while it had no tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative rewards of a validator forfeited to the community pool while it had no tokens, e.g. after being slashed to zero, and the heights of its most recent forfeitures.
+
+Example:
+$ %s query distribution forfeited-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := valAc.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorForfeitedRewards(cmd.Context(), &types.QueryValidatorForfeitedRewardsRequest{
+				ValidatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
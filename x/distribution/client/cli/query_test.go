@@ -0,0 +1,60 @@
+package cli_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
+)
+
+func (s *CLITestSuite) TestQueryDelegationRewardsAtHeightCmd() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	valAddr := sdk.ValAddress(val[0].Address).String()
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"invalid delegator address",
+			[]string{"foo", valAddr, "10"},
+			"decoding bech32 failed",
+		},
+		{
+			"invalid validator address",
+			[]string{val[0].Address.String(), "foo", "10"},
+			"decoding bech32 failed",
+		},
+		{
+			"invalid height",
+			[]string{val[0].Address.String(), valAddr, "foo"},
+			"invalid height foo",
+		},
+		{
+			"non positive height",
+			[]string{val[0].Address.String(), valAddr, "0"},
+			"height must be positive",
+		},
+		{
+			"valid query",
+			[]string{val[0].Address.String(), valAddr, "10"},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.NewDelegationRewardsAtHeightCmd(address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos"))
+
+			_, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagOnBehalfOf       = "on-behalf-of"
 )
 
 const (
@@ -40,6 +41,7 @@ func NewTxCmd(valAc, ac address.Codec) *cobra.Command {
 	distTxCmd.AddCommand(
 		NewWithdrawRewardsCmd(valAc, ac),
 		NewWithdrawAllRewardsCmd(valAc, ac),
+		NewWithdrawAllRewardsSingleTxCmd(ac),
 		NewSetWithdrawAddrCmd(ac),
 		NewFundCommunityPoolCmd(ac),
 		NewDepositValidatorRewardsPoolCmd(valAc, ac),
@@ -86,8 +88,12 @@ and optionally withdraw validator commission if the delegation address given is
 Example:
 $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
 $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey --commission
+
+The registered withdraw operator of a delegator can withdraw its rewards on its behalf:
+$ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from operator --on-behalf-of %s1...
 `,
 				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr,
+				version.AppName, bech32PrefixValAddr, sdk.GetConfig().GetBech32AccountAddrPrefix(),
 			),
 		),
 		Args: cobra.ExactArgs(1),
@@ -107,6 +113,12 @@ $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 
 			msgs := []sdk.Msg{types.NewMsgWithdrawDelegatorReward(delAddr, args[0])}
+			if onBehalfOf, _ := cmd.Flags().GetString(FlagOnBehalfOf); onBehalfOf != "" {
+				if _, err := ac.StringToBytes(onBehalfOf); err != nil {
+					return err
+				}
+				msgs = []sdk.Msg{types.NewMsgWithdrawDelegatorRewardOnBehalf(delAddr, args[0], onBehalfOf)}
+			}
 
 			if commission, _ := cmd.Flags().GetBool(FlagCommission); commission {
 				msgs = append(msgs, types.NewMsgWithdrawValidatorCommission(args[0]))
@@ -117,6 +129,7 @@ $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	}
 
 	cmd.Flags().Bool(FlagCommission, false, "Withdraw the validator's commission in addition to the rewards")
+	cmd.Flags().String(FlagOnBehalfOf, "", "Withdraw the rewards of the given delegator, which registered the signer as its withdraw operator")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -185,6 +198,43 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 	return cmd
 }
 
+// NewWithdrawAllRewardsSingleTxCmd returns a CLI command handler for creating a MsgWithdrawAllDelegatorRewards transaction.
+func NewWithdrawAllRewardsSingleTxCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-all-rewards-single-tx",
+		Short: "withdraw all delegations rewards for a delegator in a single message",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw all rewards for a single delegator with a single message.
+Unlike withdraw-all-rewards, the delegations are resolved on chain, so the transaction
+can be generated offline.
+
+Example:
+$ %s tx distribution withdraw-all-rewards-single-tx --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewSetWithdrawAddrCmd returns a CLI command handler for creating a MsgSetWithdrawAddress transaction.
 func NewSetWithdrawAddrCmd(ac address.Codec) *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"context"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -38,3 +40,40 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
 	return k.SetPreviousProposerConsAddr(ctx, consAddr)
 }
+
+// EndBlocker emits the implicit withdraw events aggregated outside of
+// transactions, reports the number of withdrawals of the block, flushes the
+// validator dust and the community pool remainder batched during the block,
+// removes the evicted reward denoms from a bounded number of rewards records,
+// checkpoints the rewards of a bounded number of delegations, compacts a
+// bounded number of historical rewards records and prunes the community pool
+// spend history.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	k.reportWithdrawalsPerBlock(ctx)
+
+	if err := k.FlushImplicitWithdrawEvents(ctx); err != nil {
+		return err
+	}
+
+	if err := k.FlushValidatorDust(ctx); err != nil {
+		return err
+	}
+
+	if err := k.FlushCommunityPoolRemainder(ctx); err != nil {
+		return err
+	}
+
+	if err := k.EvictRewardDenoms(ctx); err != nil {
+		return err
+	}
+
+	if err := k.CheckpointDelegationRewards(ctx); err != nil {
+		return err
+	}
+
+	if err := k.CompactHistoricalRewards(ctx); err != nil {
+		return err
+	}
+
+	return k.PruneCommunityPoolSpendHistory(ctx)
+}
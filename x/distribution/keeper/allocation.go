@@ -30,25 +30,27 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
-	feePool, err := k.FeePool.Get(ctx)
-	if err != nil {
-		return err
-	}
-
 	if totalPreviousPower == 0 {
-		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
-		return k.FeePool.Set(ctx, feePool)
+		return k.addToCommunityPool(ctx, feesCollected)
 	}
 
 	// calculate fraction allocated to validators
 	remaining := feesCollected
-	communityTax, err := k.GetCommunityTax(ctx)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
-	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
+	// the community tax is applied per fee denom, as each denom can have its own
+	// rate through the community tax overrides
+	var feeMultiplier sdk.DecCoins
+	for _, fee := range feesCollected {
+		voteMultiplier := math.LegacyOneDec().Sub(params.CommunityTaxForDenom(fee.Denom))
+		validatorsShare := sdk.NewDecCoinFromDec(fee.Denom, fee.Amount.MulTruncate(voteMultiplier))
+		if !validatorsShare.IsZero() {
+			feeMultiplier = feeMultiplier.Add(validatorsShare)
+		}
+	}
 
 	// allocate tokens proportionally to voting power
 	//
@@ -75,23 +77,40 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		remaining = remaining.Sub(reward)
 	}
 
-	// allocate community funding
-	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+	// allocate community funding, the fee pool being read after the validators
+	// as they may divert rewards to it
+	return k.addToCommunityPool(ctx, remaining)
+}
+
+// addToCommunityPool adds the tokens to the community pool of the fee pool.
+func (k Keeper) addToCommunityPool(ctx context.Context, tokens sdk.DecCoins) error {
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(tokens...)
 	return k.FeePool.Set(ctx, feePool)
 }
 
 // AllocateTokensToValidator allocate tokens to a particular validator,
-// splitting according to commission.
+// splitting according to commission. The tokens in denoms exceeding
+// MaxOutstandingRewardDenoms are diverted to the community pool.
 func (k Keeper) AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error {
-	// split tokens between validator and delegators according to commission
-	commission := tokens.MulDec(val.GetCommission())
-	shared := tokens.Sub(commission)
-
 	valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
 	if err != nil {
 		return err
 	}
 
+	tokens, err = k.capOutstandingRewardDenoms(ctx, valBz, val.GetOperator(), tokens)
+	if err != nil {
+		return err
+	}
+
+	// split tokens between validator and delegators according to commission
+	commission := tokens.MulDec(val.GetCommission())
+	shared := tokens.Sub(commission)
+
 	// update current commission
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
@@ -13,6 +13,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,6 +53,8 @@ func TestAllocateTokensToValidatorWithCommission(t *testing.T) {
 		authtypes.NewModuleAddress("gov").String(),
 	)
 
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
 	// create validator with 50% commission
 	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
 	require.NoError(t, err)
@@ -339,3 +342,93 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, val2OutstandingRewards.Rewards.IsValid())
 }
+
+func TestAllocateTokensCommunityTaxOverrides(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// 2% global community tax, 10% on usdc, 100% on full and 0% on none
+	params := disttypes.DefaultParams()
+	params.CommunityTaxOverrides = []disttypes.CommunityTaxOverride{
+		{Denom: "usdc", Rate: math.LegacyNewDecWithPrec(1, 1)},
+		{Denom: "full", Rate: math.LegacyOneDec()},
+		{Denom: "none", Rate: math.LegacyZeroDec()},
+	}
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create three validators with 10% commission and uneven power to exercise truncation
+	pks := []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2}
+	powers := []int64{11, 10, 10}
+	valAddrs := make([]sdk.ValAddress, len(pks))
+	votes := make([]abci.VoteInfo, len(pks))
+	for i, pk := range pks {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDec(0))
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val, nil).AnyTimes()
+
+		valAddrs[i] = sdk.ValAddress(pk.Address())
+		votes[i] = abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: powers[i]}}
+	}
+
+	fees := sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1234567)),
+		sdk.NewCoin("usdc", math.NewInt(1000001)),
+		sdk.NewCoin("full", math.NewInt(777)),
+		sdk.NewCoin("none", math.NewInt(1000003)),
+	)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 31, votes))
+
+	var validatorsShare sdk.DecCoins
+	for _, valAddr := range valAddrs {
+		outstanding, err := distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+		require.NoError(t, err)
+		validatorsShare = validatorsShare.Add(outstanding.Rewards...)
+	}
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+
+	// validators share + community share is exactly the collected amount for every denom
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees...), validatorsShare.Add(feePool.CommunityPool...))
+
+	// a 100% override sends everything to the community pool
+	require.True(t, validatorsShare.AmountOf("full").IsZero())
+	require.Equal(t, math.LegacyNewDec(777), feePool.CommunityPool.AmountOf("full"))
+
+	// a 0% override only leaves the truncation dust to the community pool
+	require.True(t, feePool.CommunityPool.AmountOf("none").LT(math.LegacyOneDec()))
+
+	// unlisted denoms fall back to the global community tax, overridden ones use their rate
+	require.True(t, feePool.CommunityPool.AmountOf(sdk.DefaultBondDenom).GTE(math.LegacyNewDecWithPrec(2469134, 2)))
+	require.True(t, feePool.CommunityPool.AmountOf(sdk.DefaultBondDenom).LT(math.LegacyNewDecWithPrec(2469234, 2)))
+	require.True(t, feePool.CommunityPool.AmountOf("usdc").GTE(math.LegacyNewDecWithPrec(1000001, 1)))
+	require.True(t, feePool.CommunityPool.AmountOf("usdc").LT(math.LegacyNewDecWithPrec(1000011, 1)))
+}
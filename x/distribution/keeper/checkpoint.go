@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetDelegatorAccruedRewards returns the rewards of a delegation settled by
+// reward checkpoints and not withdrawn yet.
+func (k Keeper) GetDelegatorAccruedRewards(ctx context.Context, val sdk.ValAddress, del sdk.AccAddress) (sdk.DecCoins, error) {
+	accrued, err := k.DelegatorAccruedRewards.Get(ctx, collections.Join(val, del))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.DecCoins{}, nil
+		}
+		return nil, err
+	}
+
+	return accrued.Rewards, nil
+}
+
+// CheckpointDelegationRewards inspects up to RewardCheckpointsPerBlock
+// delegations, resuming after the last delegation inspected in the previous
+// block, and checkpoints the rewards of the ones whose starting period is more
+// than RewardCheckpointInterval periods old.
+func (k Keeper) CheckpointDelegationRewards(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.RewardCheckpointInterval == 0 || params.RewardCheckpointsPerBlock == 0 {
+		return nil
+	}
+
+	cursor, err := k.RewardCheckpointCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	start := types.DelegatorStartingInfoPrefix
+	if cursor != nil {
+		start = append(cursor, 0x00)
+	}
+
+	type delegation struct {
+		key  []byte
+		info types.DelegatorStartingInfo
+	}
+
+	// collect the delegations first, as the iterator must be closed before
+	// writing to the store
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := store.Iterator(start, storetypes.PrefixEndBytes(types.DelegatorStartingInfoPrefix))
+	var delegations []delegation
+	for ; iter.Valid() && uint64(len(delegations)) < params.RewardCheckpointsPerBlock; iter.Next() {
+		var info types.DelegatorStartingInfo
+		if err := k.cdc.Unmarshal(iter.Value(), &info); err != nil {
+			iter.Close()
+			valAddr, delAddr := types.GetDelegatorStartingInfoAddresses(iter.Key())
+			return errorsmod.Wrapf(types.ErrInvalidRecord, "starting info of validator %s, delegator %s: %s", valAddr, delAddr, err)
+		}
+		delegations = append(delegations, delegation{key: bytes.Clone(iter.Key()), info: info})
+	}
+	end := !iter.Valid()
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, d := range delegations {
+		valAddr, delAddr := types.GetDelegatorStartingInfoAddresses(d.key)
+		currentRewards, err := k.GetValidatorCurrentRewards(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if currentRewards.Period-d.info.PreviousPeriod <= params.RewardCheckpointInterval {
+			continue
+		}
+
+		if err := k.checkpointDelegationRewards(ctx, valAddr, delAddr, d.info); err != nil {
+			return err
+		}
+	}
+
+	// start over from the first delegation once all of them have been inspected
+	if end {
+		return k.RewardCheckpointCursor.Remove(ctx)
+	}
+
+	return k.RewardCheckpointCursor.Set(ctx, delegations[len(delegations)-1].key)
+}
+
+// checkpointDelegationRewards settles the rewards of a delegation for the
+// periods ended by the slash events of its validator since its starting height
+// and before the current block. The settled rewards are added to the accrued
+// rewards of the delegation and its starting info is advanced past these slash
+// events, so they are not walked again when calculating its rewards.
+//
+// The periods after the last slash event are left to the next withdrawal, as
+// settling them would require ending the current period of the validator and
+// change the rounding of its reward ratio. The accrued and remaining rewards
+// therefore always add up to the exact rewards of the unsettled delegation.
+func (k Keeper) checkpointDelegationRewards(ctx context.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress, info types.DelegatorStartingInfo) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+	if height == 0 || info.Height >= height-1 {
+		return nil
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	rewards, period, stake, slashHeight, err := k.calculateSlashedPeriodsRewards(ctx, val, valAddr,
+		info.PreviousPeriod, info.Stake, info.Height, height-1, nil)
+	if err != nil {
+		return errorsmod.Wrapf(err, "delegator %s", delAddr)
+	}
+	if period == info.PreviousPeriod {
+		return nil
+	}
+
+	accrued, err := k.GetDelegatorAccruedRewards(ctx, valAddr, delAddr)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(valAddr, delAddr)
+	err = k.DelegatorAccruedRewards.Set(ctx, key, types.DelegatorAccruedRewards{Rewards: accrued.Add(rewards...)})
+	if err != nil {
+		return err
+	}
+
+	// move the reference of the delegation to the period ended by the last
+	// settled slash event
+	if err := k.incrementReferenceCount(ctx, valAddr, period); err != nil {
+		return err
+	}
+
+	if err := k.decrementReferenceCount(ctx, valAddr, info.PreviousPeriod); err != nil {
+		return err
+	}
+
+	err = k.SetDelegatorStartingInfo(ctx, valAddr, delAddr, types.NewDelegatorStartingInfo(period, stake, slashHeight))
+	if err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCheckpointRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return nil
+}
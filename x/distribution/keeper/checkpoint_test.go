@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const checkpointTestDenom = "photon"
+
+// run applies a randomized sequence of reward allocations, slashes and
+// delegation changes, ending every block with the EndBlocker.
+func (f *validatorFixture) run(t *testing.T, seed int64, blocks int) {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(seed))
+	for i := 0; i < blocks; i++ {
+		f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+
+		tokens := sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(r.Int63n(1_000_000_000)+1, 3)),
+			sdk.NewDecCoinFromDec(checkpointTestDenom, math.LegacyNewDecWithPrec(r.Int63n(1_000_000)+1, 5)),
+		)
+		require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, tokens))
+
+		// slashes of the same block end a period each
+		for r.Intn(3) == 0 {
+			distrtestutil.SlashValidator(f.Ctx, valConsAddr0, f.Ctx.BlockHeight(), 100,
+				math.LegacyNewDecWithPrec(5, 1), f.val, &f.Keeper, f.StakingKeeper)
+		}
+
+		if r.Intn(5) == 0 {
+			delAddr := f.delAddrs[r.Intn(len(f.delAddrs))]
+			f.delegate(t, delAddr, math.NewInt(r.Int63n(1_000_000)+1))
+		}
+
+		require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+	}
+}
+
+func (f *validatorFixture) hasAccruedRewards(t *testing.T) bool {
+	t.Helper()
+
+	for _, delAddr := range f.delAddrs {
+		accrued, err := f.Keeper.GetDelegatorAccruedRewards(f.Ctx, f.valAddr, delAddr)
+		require.NoError(t, err)
+		if !accrued.IsZero() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkpointParams(interval, perBlock uint64) disttypes.Params {
+	params := disttypes.DefaultParams()
+	params.RewardCheckpointInterval = interval
+	params.RewardCheckpointsPerBlock = perBlock
+	return params
+}
+
+func TestCheckpointDelegationRewardsDifferential(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		for _, params := range []disttypes.Params{checkpointParams(1, 1), checkpointParams(1, 3), checkpointParams(4, 2)} {
+			unchunked := newValidatorFixture(t, disttypes.DefaultParams())
+			unchunked.run(t, seed, 50)
+			require.False(t, unchunked.hasAccruedRewards(t))
+
+			checkpointed := newValidatorFixture(t, params)
+			checkpointed.run(t, seed, 50)
+			require.True(t, checkpointed.hasAccruedRewards(t), "seed %d: no rewards were checkpointed", seed)
+
+			// the checkpointed rewards are visible and the delegation rewards are unchanged
+			require.Equal(t, unchunked.rewards(t), checkpointed.rewards(t), "seed %d", seed)
+
+			// the same amounts are paid out on withdrawal
+			for _, delAddr := range checkpointed.delAddrs {
+				_, err := checkpointed.Keeper.WithdrawDelegationRewards(checkpointed.Ctx, delAddr, checkpointed.valAddr)
+				require.NoError(t, err)
+			}
+			require.False(t, checkpointed.hasAccruedRewards(t))
+
+			for _, delAddr := range unchunked.delAddrs {
+				_, err := unchunked.Keeper.WithdrawDelegationRewards(unchunked.Ctx, delAddr, unchunked.valAddr)
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, unchunked.Payouts, checkpointed.Payouts, "seed %d", seed)
+
+			expOutstanding, err := unchunked.Keeper.GetValidatorOutstandingRewardsCoins(unchunked.Ctx, unchunked.valAddr)
+			require.NoError(t, err)
+			outstanding, err := checkpointed.Keeper.GetValidatorOutstandingRewardsCoins(checkpointed.Ctx, checkpointed.valAddr)
+			require.NoError(t, err)
+			require.Equal(t, expOutstanding, outstanding, "seed %d", seed)
+
+			expFeePool, err := unchunked.Keeper.FeePool.Get(unchunked.Ctx)
+			require.NoError(t, err)
+			feePool, err := checkpointed.Keeper.FeePool.Get(checkpointed.Ctx)
+			require.NoError(t, err)
+			require.Equal(t, expFeePool, feePool, "seed %d", seed)
+		}
+	}
+}
+
+func TestCheckpointDelegationRewardsDisabled(t *testing.T) {
+	f := newValidatorFixture(t, checkpointParams(0, 0))
+	f.run(t, 1, 20)
+	require.False(t, f.hasAccruedRewards(t))
+
+	_, err := f.Keeper.RewardCheckpointCursor.Get(f.Ctx)
+	require.Error(t, err)
+}
+
+func TestDelegationAccruedRewardsQuery(t *testing.T) {
+	f := newValidatorFixture(t, checkpointParams(1, 3))
+	f.run(t, 3, 30)
+
+	querier := keeper.NewQuerier(f.Keeper)
+	for _, delAddr := range f.delAddrs {
+		expAccrued, err := f.Keeper.GetDelegatorAccruedRewards(f.Ctx, f.valAddr, delAddr)
+		require.NoError(t, err)
+
+		res, err := querier.DelegationAccruedRewards(f.Ctx, &disttypes.QueryDelegationAccruedRewardsRequest{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: f.valAddr.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, expAccrued, res.Rewards)
+	}
+
+	_, err := querier.DelegationAccruedRewards(f.Ctx, &disttypes.QueryDelegationAccruedRewardsRequest{
+		ValidatorAddress: f.valAddr.String(),
+	})
+	require.ErrorContains(t, err, "empty delegator address")
+}
+
+func TestDelegationRewardsAtHeightQuery(t *testing.T) {
+	f := newValidatorFixture(t, checkpointParams(0, 0))
+	f.run(t, 5, 10)
+
+	expRewards := f.rewards(t)
+	currentRewards, err := f.Keeper.GetValidatorCurrentRewards(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+
+	querier := keeper.NewQuerier(f.Keeper)
+	for i, delAddr := range f.delAddrs {
+		info, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+		require.NoError(t, err)
+
+		res, err := querier.DelegationRewardsAtHeight(f.Ctx, &disttypes.QueryDelegationRewardsAtHeightRequest{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: f.valAddr.String(),
+			Height:           f.Ctx.BlockHeight(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, expRewards[i], res.Rewards)
+		require.Equal(t, info.PreviousPeriod, res.StartingPeriod)
+		require.Equal(t, currentRewards.Period, res.EndingPeriod)
+	}
+
+	// the query does not end the current period of the validator
+	after, err := f.Keeper.GetValidatorCurrentRewards(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, currentRewards, after)
+
+	// the height must match the queried state
+	_, err = querier.DelegationRewardsAtHeight(f.Ctx, &disttypes.QueryDelegationRewardsAtHeightRequest{
+		DelegatorAddress: f.delAddrs[0].String(),
+		ValidatorAddress: f.valAddr.String(),
+		Height:           f.Ctx.BlockHeight() - 1,
+	})
+	require.ErrorContains(t, err, "does not match the height")
+
+	_, err = querier.DelegationRewardsAtHeight(f.Ctx, &disttypes.QueryDelegationRewardsAtHeightRequest{
+		DelegatorAddress: f.delAddrs[0].String(),
+		ValidatorAddress: f.valAddr.String(),
+	})
+	require.ErrorContains(t, err, "height must be positive")
+
+	// a delegation which did not exist at the height
+	_, err = querier.DelegationRewardsAtHeight(f.Ctx, &disttypes.QueryDelegationRewardsAtHeightRequest{
+		DelegatorAddress: sdk.AccAddress(PKS[3].Address()).String(),
+		ValidatorAddress: f.valAddr.String(),
+		Height:           f.Ctx.BlockHeight(),
+	})
+	require.ErrorIs(t, err, disttypes.ErrNoDelegationExists)
+}
+
+func TestDelegatorAccruedRewardsGenesis(t *testing.T) {
+	f := newValidatorFixture(t, checkpointParams(1, 3))
+	f.run(t, 3, 30)
+	require.True(t, f.hasAccruedRewards(t))
+
+	require.NoError(t, f.Keeper.SetPreviousProposerConsAddr(f.Ctx, valConsAddr0))
+	genState := f.Keeper.ExportGenesis(f.Ctx)
+	require.NotEmpty(t, genState.DelegatorAccruedRewards)
+	require.NoError(t, disttypes.ValidateGenesis(genState))
+
+	g := distrtestutil.NewKeeperFixture(t, disttypes.DefaultParams())
+	g.Ctx = g.Ctx.WithBlockHeight(f.Ctx.BlockHeight())
+	g.AccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), disttypes.ModuleName).Return(distrAcc)
+
+	holdings := genState.FeePool.CommunityPool
+	for _, rec := range genState.OutstandingRewards {
+		holdings = holdings.Add(rec.OutstandingRewards...)
+	}
+	holdingsInt, _ := holdings.TruncateDecimal()
+	g.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(holdingsInt)
+
+	g.Keeper.InitGenesis(g.Ctx, *genState)
+	require.Equal(t, genState.DelegatorAccruedRewards, g.Keeper.ExportGenesis(g.Ctx).DelegatorAccruedRewards)
+
+	// accrued rewards exceeding the outstanding rewards of the validator are rejected
+	genState.DelegatorAccruedRewards[0].AccruedRewards.Rewards = genState.OutstandingRewards[0].OutstandingRewards.MulDec(math.LegacyNewDec(2))
+	g.AccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), disttypes.ModuleName).Return(distrAcc).AnyTimes()
+	g.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(holdingsInt).AnyTimes()
+	require.PanicsWithValue(t,
+		"accrued rewards of validator "+f.valAddr.String()+" exceed its outstanding rewards: "+
+			genState.DelegatorAccruedRewards[0].AccruedRewards.Rewards.String()+" > "+genState.OutstandingRewards[0].OutstandingRewards.String(),
+		func() { g.Keeper.InitGenesis(g.Ctx, *genState) },
+	)
+}
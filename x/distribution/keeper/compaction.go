@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// validatorPeriods indexes the periods of a validator which reference a
+// historical rewards record: the starting periods of its delegations, the
+// periods ended by its slash events and its latest ended period.
+type validatorPeriods struct {
+	delegators map[uint64][]sdk.AccAddress
+	slashed    map[uint64]bool
+	// referenced are all the referenced periods, in ascending order
+	referenced []uint64
+}
+
+// CompactHistoricalRewards inspects up to HistoricalRewardsCompactionsPerBlock
+// validator historical rewards records, resuming after the last record
+// inspected in the previous block, and merges the ones which are redundant
+// into the next record of their validator.
+//
+// The cumulative reward ratio of a validator never decreases, so a delegation
+// starting at a period is owed exactly the same rewards when starting at the
+// next referenced period instead, as long as both have the same ratio and the
+// next period is not ended by a slash event. A record is merged only when it is
+// referenced by such delegations and nothing else; the delegations are moved to
+// the next record along with their references and the record is deleted.
+//
+// The starting infos and slash events of the validators of the inspected
+// records are read once per block to index their referenced periods.
+func (k Keeper) CompactHistoricalRewards(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.HistoricalRewardsCompactionsPerBlock == 0 {
+		return nil
+	}
+
+	cursor, err := k.HistoricalRewardsCompactionCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	start := types.ValidatorHistoricalRewardsPrefix
+	if cursor != nil {
+		start = append(cursor, 0x00)
+	}
+
+	// collect the keys first, as the iterator must be closed before writing to
+	// the store
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := store.Iterator(start, storetypes.PrefixEndBytes(types.ValidatorHistoricalRewardsPrefix))
+	var keys [][]byte
+	for ; iter.Valid() && uint64(len(keys)) < params.HistoricalRewardsCompactionsPerBlock; iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+	end := !iter.Valid()
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	validators := make(map[string]*validatorPeriods)
+	for _, key := range keys {
+		valAddr, period := types.GetValidatorHistoricalRewardsAddressPeriod(key)
+		periods, ok := validators[string(valAddr)]
+		if !ok {
+			periods, err = k.getValidatorPeriods(ctx, valAddr)
+			if err != nil {
+				return err
+			}
+			validators[string(valAddr)] = periods
+		}
+
+		if periods == nil {
+			continue
+		}
+
+		if err := k.compactHistoricalRewards(ctx, valAddr, period, periods); err != nil {
+			return err
+		}
+	}
+
+	// start over from the first record once all of them have been inspected
+	if end {
+		return k.HistoricalRewardsCompactionCursor.Remove(ctx)
+	}
+
+	return k.HistoricalRewardsCompactionCursor.Set(ctx, keys[len(keys)-1])
+}
+
+// compactHistoricalRewards merges the historical rewards record of a validator
+// period into the next referenced period if it is redundant.
+func (k Keeper) compactHistoricalRewards(ctx context.Context, valAddr sdk.ValAddress, period uint64, periods *validatorPeriods) error {
+	delegators := periods.delegators[period]
+	if len(delegators) == 0 {
+		return nil
+	}
+
+	i, found := slices.BinarySearch(periods.referenced, period)
+	if !found || i+1 == len(periods.referenced) {
+		return nil
+	}
+
+	// the slash events ending the periods in between would be skipped by the
+	// delegations, but there are none as every slashed period is referenced
+	next := periods.referenced[i+1]
+	if periods.slashed[next] {
+		return nil
+	}
+
+	historical, err := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	if err != nil {
+		return err
+	}
+
+	// the record is also referenced by a slash event or as the latest period
+	// of the validator
+	if historical.ReferenceCount != uint32(len(delegators)) {
+		return nil
+	}
+
+	nextHistorical, err := k.GetValidatorHistoricalRewards(ctx, valAddr, next)
+	if err != nil {
+		return err
+	}
+
+	if !historical.CumulativeRewardRatio.Equal(nextHistorical.CumulativeRewardRatio) {
+		return nil
+	}
+
+	for _, delAddr := range delegators {
+		info, err := k.GetDelegatorStartingInfo(ctx, valAddr, delAddr)
+		if err != nil {
+			return err
+		}
+
+		info.PreviousPeriod = next
+		if err := k.SetDelegatorStartingInfo(ctx, valAddr, delAddr, info); err != nil {
+			return err
+		}
+	}
+
+	nextHistorical.ReferenceCount += historical.ReferenceCount
+	if err := k.SetValidatorHistoricalRewards(ctx, valAddr, next, nextHistorical); err != nil {
+		return err
+	}
+
+	if err := k.DeleteValidatorHistoricalReward(ctx, valAddr, period); err != nil {
+		return err
+	}
+
+	periods.delegators[next] = append(periods.delegators[next], delegators...)
+	delete(periods.delegators, period)
+	periods.referenced = slices.Delete(periods.referenced, i, i+1)
+
+	return nil
+}
+
+// getValidatorPeriods indexes the referenced periods of a validator, it returns
+// nil if the validator has no current rewards.
+func (k Keeper) getValidatorPeriods(ctx context.Context, valAddr sdk.ValAddress) (*validatorPeriods, error) {
+	currentRewards, err := k.GetValidatorCurrentRewards(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentRewards.Period == 0 {
+		return nil, nil
+	}
+
+	periods := &validatorPeriods{
+		delegators: make(map[uint64][]sdk.AccAddress),
+		slashed:    make(map[uint64]bool),
+		referenced: []uint64{currentRewards.Period - 1},
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, types.GetDelegatorStartingInfoPrefix(valAddr))
+	for ; iter.Valid(); iter.Next() {
+		var info types.DelegatorStartingInfo
+		if err := k.cdc.Unmarshal(iter.Value(), &info); err != nil {
+			iter.Close()
+			_, delAddr := types.GetDelegatorStartingInfoAddresses(iter.Key())
+			return nil, errorsmod.Wrapf(types.ErrInvalidRecord, "starting info of validator %s, delegator %s: %s", valAddr, delAddr, err)
+		}
+
+		_, delAddr := types.GetDelegatorStartingInfoAddresses(iter.Key())
+		periods.delegators[info.PreviousPeriod] = append(periods.delegators[info.PreviousPeriod], delAddr)
+		periods.referenced = append(periods.referenced, info.PreviousPeriod)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	iter = storetypes.KVStorePrefixIterator(store, types.GetValidatorSlashEventPrefix(valAddr))
+	for ; iter.Valid(); iter.Next() {
+		var event types.ValidatorSlashEvent
+		if err := k.cdc.Unmarshal(iter.Value(), &event); err != nil {
+			iter.Close()
+			return nil, errorsmod.Wrapf(types.ErrInvalidRecord, "slash event of validator %s: %s", valAddr, err)
+		}
+
+		periods.slashed[event.ValidatorPeriod] = true
+		periods.referenced = append(periods.referenced, event.ValidatorPeriod)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(periods.referenced)
+	periods.referenced = slices.Compact(periods.referenced)
+
+	return periods, nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func compactionParams(perBlock uint64) disttypes.Params {
+	params := disttypes.DefaultParams()
+	params.HistoricalRewardsCompactionsPerBlock = perBlock
+	return params
+}
+
+// newCompactionFixture returns a checkpoint fixture with ten more delegations.
+func newCompactionFixture(t *testing.T, params disttypes.Params) *validatorFixture {
+	t.Helper()
+
+	f := newValidatorFixture(t, params)
+	for _, delAddr := range simtestutil.CreateIncrementalAccounts(10) {
+		f.delegate(t, delAddr, math.NewInt(1_000_000))
+		f.delAddrs = append(f.delAddrs, delAddr)
+	}
+
+	return f
+}
+
+// runBursts applies a randomized sequence of blocks in which many delegations
+// are modified, each ending a period with the same reward ratio as the previous
+// one, and rewards are allocated and slashes happen now and then, ending every
+// block with the EndBlocker.
+func (f *validatorFixture) runBursts(t *testing.T, seed int64, blocks int) {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(seed))
+	for i := 0; i < blocks; i++ {
+		f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+
+		if r.Intn(2) == 0 {
+			tokens := sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(r.Int63n(1_000_000_000)+1, 3)),
+				sdk.NewDecCoinFromDec(checkpointTestDenom, math.LegacyNewDecWithPrec(r.Int63n(1_000_000)+1, 5)),
+			)
+			require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, tokens))
+		}
+
+		if r.Intn(6) == 0 {
+			distrtestutil.SlashValidator(f.Ctx, valConsAddr0, f.Ctx.BlockHeight(), 100,
+				math.LegacyNewDecWithPrec(1, 1), f.val, &f.Keeper, f.StakingKeeper)
+		}
+
+		for j := r.Intn(len(f.delAddrs)); j > 0; j-- {
+			delAddr := f.delAddrs[r.Intn(len(f.delAddrs))]
+			f.delegate(t, delAddr, math.NewInt(r.Int63n(1_000_000)+1))
+		}
+
+		require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+	}
+}
+
+// historicalRewardsSize returns the number of historical rewards records and
+// their size in bytes, keys included.
+func (f *validatorFixture) historicalRewardsSize() (records, size int) {
+	f.Keeper.IterateValidatorHistoricalRewards(f.Ctx, func(val sdk.ValAddress, period uint64, rewards disttypes.ValidatorHistoricalRewards) bool {
+		records++
+		size += len(disttypes.GetValidatorHistoricalRewardsKey(val, period)) + rewards.Size()
+		return false
+	})
+	return records, size
+}
+
+func TestCompactHistoricalRewardsDifferential(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		for _, perBlock := range []uint64{4, 16, 100} {
+			plain := newCompactionFixture(t, disttypes.DefaultParams())
+			plain.runBursts(t, seed, 40)
+
+			compacted := newCompactionFixture(t, compactionParams(perBlock))
+			compacted.runBursts(t, seed, 40)
+
+			plainRecords, _ := plain.historicalRewardsSize()
+			compactedRecords, _ := compacted.historicalRewardsSize()
+			require.Less(t, compactedRecords, plainRecords, "seed %d, per block %d: no record was compacted", seed, perBlock)
+			require.Equal(t, plain.Keeper.GetValidatorHistoricalReferenceCount(plain.Ctx),
+				compacted.Keeper.GetValidatorHistoricalReferenceCount(compacted.Ctx), "seed %d, per block %d", seed, perBlock)
+
+			// the rewards of every delegation are unchanged
+			require.Equal(t, plain.rewards(t), compacted.rewards(t), "seed %d, per block %d", seed, perBlock)
+
+			// the same amounts are paid out on withdrawal
+			for _, f := range []*validatorFixture{plain, compacted} {
+				for _, delAddr := range f.delAddrs {
+					_, err := f.Keeper.WithdrawDelegationRewards(f.Ctx, delAddr, f.valAddr)
+					require.NoError(t, err)
+				}
+			}
+			require.Equal(t, plain.Payouts, compacted.Payouts, "seed %d, per block %d", seed, perBlock)
+
+			expOutstanding, err := plain.Keeper.GetValidatorOutstandingRewardsCoins(plain.Ctx, plain.valAddr)
+			require.NoError(t, err)
+			outstanding, err := compacted.Keeper.GetValidatorOutstandingRewardsCoins(compacted.Ctx, compacted.valAddr)
+			require.NoError(t, err)
+			require.Equal(t, expOutstanding, outstanding, "seed %d, per block %d", seed, perBlock)
+		}
+	}
+}
+
+func TestCompactHistoricalRewardsStateSize(t *testing.T) {
+	f := newCompactionFixture(t, disttypes.DefaultParams())
+	f.runBursts(t, 7, 200)
+
+	expRewards := f.rewards(t)
+	expReferences := f.Keeper.GetValidatorHistoricalReferenceCount(f.Ctx)
+	records, size := f.historicalRewardsSize()
+
+	// compact until all the records have been inspected
+	require.NoError(t, f.Keeper.Params.Set(f.Ctx, compactionParams(8)))
+	passes := 0
+	for {
+		require.NoError(t, f.Keeper.CompactHistoricalRewards(f.Ctx))
+		passes++
+		if has, err := f.Keeper.HistoricalRewardsCompactionCursor.Has(f.Ctx); !has {
+			require.NoError(t, err)
+			break
+		}
+	}
+	require.Equal(t, (records+7)/8, passes)
+
+	compactedRecords, compactedSize := f.historicalRewardsSize()
+	t.Logf("historical rewards: %d records, %d bytes before compaction; %d records, %d bytes after",
+		records, size, compactedRecords, compactedSize)
+	require.Less(t, compactedRecords, records)
+	require.Less(t, compactedSize, size)
+
+	require.Equal(t, expRewards, f.rewards(t))
+	require.Equal(t, expReferences, f.Keeper.GetValidatorHistoricalReferenceCount(f.Ctx))
+
+	// every delegation still references a record with its starting period
+	for _, delAddr := range f.delAddrs {
+		info, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+		require.NoError(t, err)
+		_, err = f.Keeper.GetValidatorHistoricalRewards(f.Ctx, f.valAddr, info.PreviousPeriod)
+		require.NoError(t, err)
+	}
+}
+
+func TestCompactHistoricalRewardsDisabled(t *testing.T) {
+	f := newCompactionFixture(t, disttypes.DefaultParams())
+	f.runBursts(t, 1, 20)
+	records, _ := f.historicalRewardsSize()
+
+	require.NoError(t, f.Keeper.CompactHistoricalRewards(f.Ctx))
+	compactedRecords, _ := f.historicalRewardsSize()
+	require.Equal(t, records, compactedRecords)
+
+	_, err := f.Keeper.HistoricalRewardsCompactionCursor.Get(f.Ctx)
+	require.Error(t, err)
+}
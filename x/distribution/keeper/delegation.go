@@ -2,8 +2,9 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,47 +45,126 @@ func (k Keeper) initializeDelegation(ctx context.Context, val sdk.ValAddress, de
 	return k.SetDelegatorStartingInfo(ctx, val, del, types.NewDelegatorStartingInfo(previousPeriod, stake, uint64(sdkCtx.BlockHeight())))
 }
 
+// historicalRewardsCache memoizes the historical rewards of a validator by
+// period, read while calculating the rewards of several of its delegations.
+type historicalRewardsCache map[uint64]types.ValidatorHistoricalRewards
+
+// getValidatorHistoricalRewards gets the historical rewards of a validator for
+// a period through the cache, which may be nil.
+func (k Keeper) getValidatorHistoricalRewards(ctx context.Context, val sdk.ValAddress, period uint64, cache historicalRewardsCache) (types.ValidatorHistoricalRewards, error) {
+	if rewards, ok := cache[period]; ok {
+		return rewards, nil
+	}
+
+	rewards, err := k.GetValidatorHistoricalRewards(ctx, val, period)
+	if err != nil || cache == nil {
+		return rewards, err
+	}
+
+	cache[period] = rewards
+	return rewards, nil
+}
+
 // calculateDelegationRewardsBetween calculates the rewards accrued by a delegation between two periods
 func (k Keeper) calculateDelegationRewardsBetween(ctx context.Context, val stakingtypes.ValidatorI,
-	startingPeriod, endingPeriod uint64, stake math.LegacyDec,
+	startingPeriod, endingPeriod uint64, stake math.LegacyDec, cache historicalRewardsCache,
 ) (sdk.DecCoins, error) {
 	// sanity check
 	if startingPeriod > endingPeriod {
-		panic("startingPeriod cannot be greater than endingPeriod")
+		return sdk.DecCoins{}, errorsmod.Wrapf(types.ErrInvalidRecord,
+			"validator %s: starting period %d is greater than ending period %d", val.GetOperator(), startingPeriod, endingPeriod)
 	}
 
 	// sanity check
 	if stake.IsNegative() {
-		panic("stake should not be negative")
+		return sdk.DecCoins{}, errorsmod.Wrapf(types.ErrInvalidRecord,
+			"validator %s: negative stake %s from period %d", val.GetOperator(), stake, startingPeriod)
 	}
 
 	valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
 	if err != nil {
-		panic(err)
+		return sdk.DecCoins{}, err
 	}
 
 	// return staking * (ending - starting)
-	starting, err := k.GetValidatorHistoricalRewards(ctx, valBz, startingPeriod)
+	starting, err := k.getValidatorHistoricalRewards(ctx, valBz, startingPeriod, cache)
 	if err != nil {
 		return sdk.DecCoins{}, err
 	}
 
-	ending, err := k.GetValidatorHistoricalRewards(ctx, valBz, endingPeriod)
+	ending, err := k.getValidatorHistoricalRewards(ctx, valBz, endingPeriod, cache)
 	if err != nil {
 		return sdk.DecCoins{}, err
 	}
 
-	difference := ending.CumulativeRewardRatio.Sub(starting.CumulativeRewardRatio)
+	// the records of the validator may not all be rid of the denoms pending
+	// eviction yet, no delegation is owed rewards in them
+	evicting, err := k.getPendingEvictedDenoms(ctx, valBz)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+	startingRatio, endingRatio := starting.CumulativeRewardRatio, ending.CumulativeRewardRatio
+	for _, denom := range evicting {
+		startingRatio = withoutDenom(startingRatio, denom)
+		endingRatio = withoutDenom(endingRatio, denom)
+	}
+
+	difference := endingRatio.Sub(startingRatio)
 	if difference.IsAnyNegative() {
-		panic("negative rewards should not be possible")
+		return sdk.DecCoins{}, errorsmod.Wrapf(types.ErrInvalidRecord,
+			"validator %s: negative rewards between periods %d and %d", val.GetOperator(), startingPeriod, endingPeriod)
 	}
 	// note: necessary to truncate so we don't allow withdrawing more rewards than owed
 	rewards := difference.MulDecTruncate(stake)
 	return rewards, nil
 }
 
+// calculateSlashedPeriodsRewards calculates the rewards of the periods ended by
+// the slash events of a validator between startingHeight and endingHeight
+// (inclusive), for a delegation starting at startingPeriod with the given
+// stake. It returns the rewards, the period and the stake after the last of
+// these slash events, and the height of that slash event, which is
+// startingHeight if no period was ended by a slash.
+func (k Keeper) calculateSlashedPeriodsRewards(ctx context.Context, val stakingtypes.ValidatorI, valAddr sdk.ValAddress,
+	startingPeriod uint64, stake math.LegacyDec, startingHeight, endingHeight uint64, cache historicalRewardsCache,
+) (rewards sdk.DecCoins, period uint64, finalStake math.LegacyDec, lastHeight uint64, err error) {
+	lastHeight = startingHeight
+	if endingHeight > startingHeight {
+		k.IterateValidatorSlashEventsBetween(ctx, valAddr, startingHeight, endingHeight,
+			func(height uint64, event types.ValidatorSlashEvent) (stop bool) {
+				endingPeriod := event.ValidatorPeriod
+				if endingPeriod > startingPeriod {
+					var delRewards sdk.DecCoins
+					delRewards, err = k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake, cache)
+					if err != nil {
+						err = errorsmod.Wrapf(err, "slash event at height %d", height)
+						return true
+					}
+					rewards = rewards.Add(delRewards...)
+
+					// Note: It is necessary to truncate so we don't allow withdrawing
+					// more rewards than owed.
+					stake = stake.MulTruncate(math.LegacyOneDec().Sub(event.Fraction))
+					startingPeriod = endingPeriod
+					lastHeight = height
+				}
+				return false
+			},
+		)
+	}
+
+	return rewards, startingPeriod, stake, lastHeight, err
+}
+
 // CalculateDelegationRewards calculates the total rewards accrued by a delegation
 func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins, err error) {
+	return k.calculateDelegationRewards(ctx, val, del, endingPeriod, nil)
+}
+
+// calculateDelegationRewards calculates the total rewards accrued by a
+// delegation, reading the historical rewards of the validator through the
+// cache, which may be nil.
+func (k Keeper) calculateDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64, cache historicalRewardsCache) (rewards sdk.DecCoins, err error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
 	if err != nil {
@@ -102,15 +182,30 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes
 		return sdk.DecCoins{}, err
 	}
 
+	// rewards settled by reward checkpoints are owed on top of the rewards
+	// calculated from the starting info
+	accrued, err := k.GetDelegatorAccruedRewards(ctx, sdk.ValAddress(valAddr), sdk.AccAddress(delAddr))
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+
+	evicting, err := k.getPendingEvictedDenoms(ctx, valAddr)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+	for _, denom := range evicting {
+		accrued = withoutDenom(accrued, denom)
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if startingInfo.Height == uint64(sdkCtx.BlockHeight()) {
 		// started this height, no rewards yet
-		return sdk.DecCoins{}, err
+		if accrued.IsZero() {
+			return sdk.DecCoins{}, nil
+		}
+		return accrued, nil
 	}
 
-	startingPeriod := startingInfo.PreviousPeriod
-	stake := startingInfo.Stake
-
 	// Iterate through slashes and withdraw with calculated staking for
 	// distribution periods. These period offsets are dependent on *when* slashes
 	// happen - namely, in BeginBlock, after rewards are allocated...
@@ -118,30 +213,14 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes
 	// delegation existed, UNLESS they were slashes of a redelegation to this
 	// validator which was itself slashed (from a fault committed by the
 	// redelegation source validator) earlier in the same BeginBlock.
-	startingHeight := startingInfo.Height
 	// Slashes this block happened after reward allocation, but we have to account
 	// for them for the stake sanity check below.
-	endingHeight := uint64(sdkCtx.BlockHeight())
-	if endingHeight > startingHeight {
-		k.IterateValidatorSlashEventsBetween(ctx, valAddr, startingHeight, endingHeight,
-			func(height uint64, event types.ValidatorSlashEvent) (stop bool) {
-				endingPeriod := event.ValidatorPeriod
-				if endingPeriod > startingPeriod {
-					delRewards, err := k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)
-					if err != nil {
-						panic(err)
-					}
-					rewards = rewards.Add(delRewards...)
-
-					// Note: It is necessary to truncate so we don't allow withdrawing
-					// more rewards than owed.
-					stake = stake.MulTruncate(math.LegacyOneDec().Sub(event.Fraction))
-					startingPeriod = endingPeriod
-				}
-				return false
-			},
-		)
+	rewards, startingPeriod, stake, _, err := k.calculateSlashedPeriodsRewards(ctx, val, valAddr,
+		startingInfo.PreviousPeriod, startingInfo.Stake, startingInfo.Height, uint64(sdkCtx.BlockHeight()), cache)
+	if err != nil {
+		return sdk.DecCoins{}, errorsmod.Wrapf(err, "delegator %s", del.GetDelegatorAddr())
 	}
+	rewards = accrued.Add(rewards...)
 
 	// A total stake sanity check; Recalculated final stake should be less than or
 	// equal to current stake here. We cannot use Equals because stake is truncated
@@ -174,24 +253,127 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val stakingtypes
 		if stake.LTE(currentStake.Add(marginOfErr)) {
 			stake = currentStake
 		} else {
-			panic(fmt.Sprintf("calculated final stake for delegator %s greater than current stake"+
-				"\n\tfinal stake:\t%s"+
-				"\n\tcurrent stake:\t%s",
-				del.GetDelegatorAddr(), stake, currentStake))
+			return sdk.DecCoins{}, errorsmod.Wrapf(types.ErrInvalidRecord,
+				"calculated final stake for delegator %s of validator %s greater than current stake"+
+					"\n\tfinal stake:\t%s"+
+					"\n\tcurrent stake:\t%s",
+				del.GetDelegatorAddr(), del.GetValidatorAddr(), stake, currentStake)
 		}
 	}
 
 	// calculate rewards for final period
-	delRewards, err := k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake)
+	delRewards, err := k.calculateDelegationRewardsBetween(ctx, val, startingPeriod, endingPeriod, stake, cache)
 	if err != nil {
-		return sdk.DecCoins{}, err
+		return sdk.DecCoins{}, errorsmod.Wrapf(err, "delegator %s", del.GetDelegatorAddr())
 	}
 
 	rewards = rewards.Add(delRewards...)
 	return rewards, nil
 }
 
-func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
+// batchDelegation is a delegation of a batch rewards calculation, with its
+// decoded addresses.
+type batchDelegation struct {
+	pair types.DelegationPair
+	val  sdk.ValAddress
+	del  sdk.AccAddress
+}
+
+// batchValidator is a validator of a batch rewards calculation, shared by its
+// delegations.
+type batchValidator struct {
+	val          stakingtypes.ValidatorI
+	endingPeriod uint64
+	historical   historicalRewardsCache
+	err          error
+}
+
+// calculateBatchDelegationRewards calculates the rewards accrued by several
+// delegations, in a branch of the state which is never written back. The
+// delegations of a validator share its ending period and the reads of its
+// historical rewards. A missing validator or delegation is reported by the
+// error of its result, any other error fails the whole batch.
+func (k Keeper) calculateBatchDelegationRewards(ctx context.Context, delegations []batchDelegation) ([]types.DelegationPairRewards, error) {
+	// the validator periods are incremented to calculate the rewards, never
+	// write them back
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	validators := make(map[string]*batchValidator)
+	results := make([]types.DelegationPairRewards, 0, len(delegations))
+	for _, d := range delegations {
+		result := types.DelegationPairRewards{
+			DelegatorAddress: d.pair.DelegatorAddress,
+			ValidatorAddress: d.pair.ValidatorAddress,
+			Rewards:          sdk.DecCoins{},
+		}
+
+		bv, ok := validators[string(d.val)]
+		if !ok {
+			bv = &batchValidator{}
+			val, err := k.stakingKeeper.Validator(cacheCtx, d.val)
+			switch {
+			case errorsmod.IsOf(err, stakingtypes.ErrNoValidatorFound) || (err == nil && val == nil):
+				bv.err = errorsmod.Wrap(types.ErrNoValidatorExists, d.pair.ValidatorAddress)
+			case err != nil:
+				return nil, err
+			default:
+				bv.val = val
+				bv.historical = make(historicalRewardsCache)
+				if bv.endingPeriod, err = k.IncrementValidatorPeriod(cacheCtx, val); err != nil {
+					return nil, err
+				}
+			}
+			validators[string(d.val)] = bv
+		}
+
+		if bv.err != nil {
+			result.Codespace, result.Code, result.Log = errorsmod.ABCIInfo(bv.err, false)
+			results = append(results, result)
+			continue
+		}
+
+		del, err := k.stakingKeeper.Delegation(cacheCtx, d.del, d.val)
+		switch {
+		case errorsmod.IsOf(err, stakingtypes.ErrNoDelegation) || (err == nil && del == nil):
+			err = errorsmod.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s", d.pair.DelegatorAddress, d.pair.ValidatorAddress)
+			result.Codespace, result.Code, result.Log = errorsmod.ABCIInfo(err, false)
+			results = append(results, result)
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		if result.Rewards, err = k.calculateDelegationRewards(cacheCtx, bv.val, del, bv.endingPeriod, bv.historical); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// withdrawDelegationRewards withdraws the rewards of a delegation. The withdrawal
+// is atomic: it is executed in a branch of the store which is only written back
+// when every step succeeded, so that a failing bank send (e.g. a blocked withdraw
+// address or a send-disabled reward denom) or distribution hook never leaves an
+// incremented period, updated outstanding rewards or a removed starting info
+// behind, regardless of whether the caller discards its own state on error.
+//
+// An implicit withdrawal is the settlement of a delegation change, its event
+// may be aggregated with the other implicit withdrawals of the delegator.
+func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, implicit bool) (sdk.Coins, error) {
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	rewards, err := k.withdrawDelegationRewardsInBranch(cacheCtx, val, del, implicit)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	return rewards, nil
+}
+
+func (k Keeper) withdrawDelegationRewardsInBranch(ctx context.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, implicit bool) (sdk.Coins, error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
 	if err != nil {
@@ -247,8 +429,10 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 	finalRewards, remainder := rewards.TruncateDecimal()
 
 	// add coins to user account
+	var withdrawAddrSource string
 	if !finalRewards.IsZero() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		var withdrawAddr sdk.AccAddress
+		withdrawAddr, withdrawAddrSource, err = k.getDelegatorWithdrawAddr(ctx, delAddr)
 		if err != nil {
 			return nil, err
 		}
@@ -257,6 +441,11 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 		if err != nil {
 			return nil, err
 		}
+
+		err = k.recordDelegationRewardsWithdrawn(ctx, sdk.ValAddress(valAddr), sdk.AccAddress(delAddr), finalRewards)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// update the outstanding rewards and the community pool only if the
@@ -277,6 +466,16 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 		return nil, err
 	}
 
+	err = k.recordValidatorDust(ctx, sdk.ValAddress(valAddr), remainder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.recordCommunityPoolRemainder(ctx, val.GetOperator(), del.GetDelegatorAddr(), remainder)
+	if err != nil {
+		return nil, err
+	}
+
 	// decrement reference count of starting period
 	startingInfo, err := k.GetDelegatorStartingInfo(ctx, sdk.ValAddress(valAddr), sdk.AccAddress(delAddr))
 	if err != nil {
@@ -295,26 +494,62 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val stakingtypes.
 		return nil, err
 	}
 
-	if finalRewards.IsZero() {
-		baseDenom, _ := sdk.GetBaseDenom()
-		if baseDenom == "" {
-			baseDenom = sdk.DefaultBondDenom
+	// the rewards settled by reward checkpoints have been paid out as well
+	err = k.DelegatorAccruedRewards.Remove(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr)))
+	if err != nil {
+		return nil, err
+	}
+
+	// the hooks observe the state once the withdrawal is complete
+	if hooks := k.distributionHooks(); hooks != nil && !finalRewards.IsZero() {
+		err = hooks.AfterDelegationRewardWithdrawn(ctx, sdk.AccAddress(delAddr), sdk.ValAddress(valAddr), finalRewards)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.reportWithdrawal(ctx, MetricLabelValueSourceDelegation, val.GetOperator(), finalRewards)
+
+	if implicit {
+		aggregated, err := k.aggregateImplicitWithdrawal(ctx, sdk.AccAddress(delAddr), finalRewards)
+		if err != nil {
+			return nil, err
 		}
 
-		// Note, we do not call the NewCoins constructor as we do not want the zero
-		// coin removed.
-		finalRewards = sdk.Coins{sdk.NewCoin(baseDenom, math.ZeroInt())}
+		if aggregated {
+			return withdrawnAmount(finalRewards), nil
+		}
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWithdrawRewards,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, finalRewards.String()),
-			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
-			sdk.NewAttribute(types.AttributeKeyDelegator, del.GetDelegatorAddr()),
-		),
+	event := sdk.NewEvent(
+		types.EventTypeWithdrawRewards,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawnAmount(finalRewards).String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, del.GetDelegatorAddr()),
 	)
+	if withdrawAddrSource != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyWithdrawAddressSource, withdrawAddrSource))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(event)
+
+	return withdrawnAmount(finalRewards), nil
+}
+
+// withdrawnAmount returns the withdrawn coins reported by the withdrawals, a
+// zero coin of the base denom if nothing was withdrawn.
+func withdrawnAmount(coins sdk.Coins) sdk.Coins {
+	if !coins.IsZero() {
+		return coins
+	}
+
+	baseDenom, _ := sdk.GetBaseDenom()
+	if baseDenom == "" {
+		baseDenom = sdk.DefaultBondDenom
+	}
 
-	return finalRewards, nil
+	// Note, we do not call the NewCoins constructor as we do not want the zero
+	// coin removed.
+	return sdk.Coins{sdk.NewCoin(baseDenom, math.ZeroInt())}
 }
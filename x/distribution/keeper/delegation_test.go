@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
@@ -336,6 +337,31 @@ func TestCalculateRewardsAfterManySlashes(t *testing.T) {
 }
 
 func TestCalculateRewardsMultiDelegator(t *testing.T) {
+	// create validator with 50% commission, and a second delegation with the
+	// same stake after some rewards were allocated
+	initial := int64(20)
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, initial))
+	scenario := distrtestutil.NewRewardScenario().
+		WithValidator(100, math.LegacyNewDecWithPrec(5, 1)).
+		Allocate(1, 0, tokens).
+		Delegate(1, 0, 0, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)).
+		Allocate(2, 0, tokens)
+	result := scenario.Run(t)
+	valAddr := scenario.Validator(0)
+
+	// rewards for del1 should be 3/4 initial
+	del1 := result.Delegation(sdk.AccAddress(valAddr), valAddr)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(initial * 3 / 4)}}, del1.Accrued)
+
+	// rewards for del2 should be 1/4 initial
+	del2 := result.Delegation(scenario.Delegator(0), valAddr)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(initial * 1 / 4)}}, del2.Accrued)
+
+	// commission should be equal to initial (50% twice)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(initial)}}, result.Commission(valAddr))
+}
+
+func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
@@ -367,72 +393,50 @@ func TestCalculateRewardsMultiDelegator(t *testing.T) {
 
 	// create validator with 50% commission
 	valAddr := sdk.ValAddress(valConsAddr0)
-	addr0 := sdk.AccAddress(valAddr)
+	addr := sdk.AccAddress(valAddr)
 	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
 	require.NoError(t, err)
 
 	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
 
-	del0 := stakingtypes.NewDelegation(addr0.String(), valAddr.String(), val.DelegatorShares)
-
-	// set mock calls
-	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(4)
-	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr0, valAddr).Return(del0, nil).Times(1)
+	// delegation mock
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(5)
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).Times(3)
 
 	// run the necessary hooks manually (given that we are not running an actual staking module)
-	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr0, valAddr)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
 	require.NoError(t, err)
 
 	// next block
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 
 	// allocate some rewards
-	initial := int64(20)
-	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(initial)}}
-	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
-
-	// second delegation
-	addr1 := sdk.AccAddress(valConsAddr1)
-	_, del1, err := distrtestutil.Delegate(ctx, distrKeeper, addr1, &val, math.NewInt(100), nil, stakingKeeper)
-	require.NoError(t, err)
-
-	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr1, valAddr).Return(del1, nil)
-	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(1)
-
-	// call necessary hooks to update a delegation
-	err = distrKeeper.Hooks().AfterDelegationModified(ctx, addr1, valAddr)
-	require.NoError(t, err)
-
-	// next block
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
 
-	// allocate some more rewards
 	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
 
-	// end period
-	endingPeriod, _ := distrKeeper.IncrementValidatorPeriod(ctx, val)
-
-	// calculate delegation rewards for del1
-	rewards, err := distrKeeper.CalculateDelegationRewards(ctx, val, del0, endingPeriod)
-	require.NoError(t, err)
-
-	// rewards for del0 should be 3/4 initial
-	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(initial * 3 / 4)}}, rewards)
+	// historical count should be 2 (initial + latest for delegation)
+	require.Equal(t, uint64(2), distrKeeper.GetValidatorHistoricalReferenceCount(ctx))
 
-	// calculate delegation rewards for del2
-	rewards, err = distrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
-	require.NoError(t, err)
+	// withdraw rewards (the bank keeper should be called with the right amount of tokens to transfer)
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, expRewards)
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddr), valAddr)
+	require.Nil(t, err)
 
-	// rewards for del2 should be 1/4 initial
-	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(initial * 1 / 4)}}, rewards)
+	// historical count should still be 2 (added one record, cleared one)
+	require.Equal(t, uint64(2), distrKeeper.GetValidatorHistoricalReferenceCount(ctx))
 
-	// commission should be equal to initial (50% twice)
-	valCommission, err := distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr)
-	require.NoError(t, err)
-	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(initial)}}, valCommission.Commission)
+	// withdraw commission (the bank keeper should be called with the right amount of tokens to transfer)
+	expCommission := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expCommission)
+	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.Nil(t, err)
 }
 
-func TestWithdrawDelegationRewardsBasic(t *testing.T) {
+func TestWithdrawDelegationRewardsSendFailureIsAtomic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
@@ -472,8 +476,8 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 
 	// delegation mock
 	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
-	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(5)
-	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).Times(3)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
 
 	// run the necessary hooks manually (given that we are not running an actual staking module)
 	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
@@ -482,29 +486,148 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	// next block
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 
-	// allocate some rewards
-	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
-	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
-
+	// allocate some rewards in a denom that cannot be sent
+	tokens := sdk.DecCoins{sdk.NewDecCoin("nosend", math.NewInt(100))}
 	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
 
-	// historical count should be 2 (initial + latest for delegation)
-	require.Equal(t, uint64(2), distrKeeper.GetValidatorHistoricalReferenceCount(ctx))
+	currentBefore, err := distrKeeper.GetValidatorCurrentRewards(ctx, valAddr)
+	require.NoError(t, err)
+	outstandingBefore, err := distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+	require.NoError(t, err)
+	startingInfoBefore, err := distrKeeper.GetDelegatorStartingInfo(ctx, valAddr, addr)
+	require.NoError(t, err)
+	feePoolBefore, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	refCountBefore := distrKeeper.GetValidatorHistoricalReferenceCount(ctx)
 
-	// withdraw rewards (the bank keeper should be called with the right amount of tokens to transfer)
-	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
-	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expRewards)
-	_, err = distrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddr), valAddr)
-	require.Nil(t, err)
+	// the bank send to the withdraw address fails
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, gomock.Any()).Return(banktypes.ErrSendDisabled)
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.ErrorIs(t, err, banktypes.ErrSendDisabled)
 
-	// historical count should still be 2 (added one record, cleared one)
-	require.Equal(t, uint64(2), distrKeeper.GetValidatorHistoricalReferenceCount(ctx))
+	// no partially applied state must persist
+	currentAfter, err := distrKeeper.GetValidatorCurrentRewards(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, currentBefore, currentAfter)
 
-	// withdraw commission (the bank keeper should be called with the right amount of tokens to transfer)
-	expCommission := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
-	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expCommission)
-	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
-	require.Nil(t, err)
+	outstandingAfter, err := distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, outstandingBefore, outstandingAfter)
+
+	startingInfoAfter, err := distrKeeper.GetDelegatorStartingInfo(ctx, valAddr, addr)
+	require.NoError(t, err)
+	require.Equal(t, startingInfoBefore, startingInfoAfter)
+
+	feePoolAfter, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, feePoolBefore, feePoolAfter)
+
+	require.Equal(t, refCountBefore, distrKeeper.GetValidatorHistoricalReferenceCount(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestWithdrawDelegationRewardsCorruptRecords(t *testing.T) {
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+
+	testCases := []struct {
+		name    string
+		corrupt func(store storetypes.KVStore, info disttypes.DelegatorStartingInfo)
+		expErr  error
+		expMsg  string
+	}{
+		{
+			name: "corrupt starting info",
+			corrupt: func(store storetypes.KVStore, _ disttypes.DelegatorStartingInfo) {
+				store.Set(disttypes.GetDelegatorStartingInfoKey(valAddr, addr), []byte{0xff})
+			},
+			expErr: disttypes.ErrInvalidRecord,
+			expMsg: "starting info of validator " + valAddr.String() + ", delegator " + addr.String(),
+		},
+		{
+			name: "corrupt historical rewards",
+			corrupt: func(store storetypes.KVStore, info disttypes.DelegatorStartingInfo) {
+				store.Set(disttypes.GetValidatorHistoricalRewardsKey(valAddr, info.PreviousPeriod), []byte{0xff})
+			},
+			expErr: disttypes.ErrInvalidRecord,
+			expMsg: "historical rewards of validator " + valAddr.String() + ", period 1",
+		},
+		{
+			name: "missing historical rewards",
+			corrupt: func(store storetypes.KVStore, info disttypes.DelegatorStartingInfo) {
+				store.Delete(disttypes.GetValidatorHistoricalRewardsKey(valAddr, info.PreviousPeriod))
+			},
+			expErr: disttypes.ErrNoHistoricalRewards,
+			expMsg: "validator " + valAddr.String() + ", period 1",
+		},
+		{
+			name: "negative starting stake",
+			corrupt: func(store storetypes.KVStore, info disttypes.DelegatorStartingInfo) {
+				info.Stake = math.LegacyNewDec(-1)
+				bz, err := moduletestutil.MakeTestEncodingConfig().Codec.Marshal(&info)
+				require.NoError(t, err)
+				store.Set(disttypes.GetDelegatorStartingInfoKey(valAddr, addr), bz)
+			},
+			expErr: disttypes.ErrInvalidRecord,
+			expMsg: "delegator " + addr.String() + ": validator " + valAddr.String() + ": negative stake -1.000000000000000000 from period 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+			accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+			stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+			stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+			require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+			// next block
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100))}))
+
+			info, err := distrKeeper.GetDelegatorStartingInfo(ctx, valAddr, addr)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), info.PreviousPeriod)
+			tc.corrupt(ctx.KVStore(key), info)
+
+			// the corrupt record fails the withdrawal instead of panicking
+			msgServer := keeper.NewMsgServerImpl(distrKeeper)
+			_, err = msgServer.WithdrawDelegatorReward(ctx, disttypes.NewMsgWithdrawDelegatorReward(addr.String(), valAddr.String()))
+			require.ErrorIs(t, err, tc.expErr)
+			require.ErrorContains(t, err, tc.expMsg)
+		})
+	}
 }
 
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
@@ -624,140 +747,32 @@ func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 }
 
 func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
-	storeService := runtime.NewKVStoreService(key)
-	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
-	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
-	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
-
-	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
-	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
-	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
-
-	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
-	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
-	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
-
-	distrKeeper := keeper.NewKeeper(
-		encCfg.Codec,
-		storeService,
-		accountKeeper,
-		bankKeeper,
-		stakingKeeper,
-		"fee_collector",
-		authtypes.NewModuleAddress("gov").String(),
-	)
-
-	// reset fee pool
-	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
-	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
-
-	valPower := int64(100)
-
-	// create validator with 50% commission
-	valAddr := sdk.ValAddress(valConsAddr0)
-	addr := sdk.AccAddress(valAddr)
-	val, err := distrtestutil.CreateValidator(valConsPk0, sdk.TokensFromConsensusPower(valPower, sdk.DefaultPowerReduction))
-	require.NoError(t, err)
-	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
-
-	// validator and delegation mocks
-	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
-	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(3)
-	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil)
-
-	// run the necessary hooks manually (given that we are not running an actual staking module)
-	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
-	require.NoError(t, err)
-	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(2)
-
-	// next block
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-
-	// allocate some rewards
-	initial := math.LegacyNewDecFromInt(sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction))
-	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial}}
-	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
-
-	// slash the validator
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
-	distrtestutil.SlashValidator(
-		ctx,
-		valConsAddr0,
-		ctx.BlockHeight(),
-		valPower,
-		math.LegacyNewDecWithPrec(5, 1),
-		&val,
-		&distrKeeper,
-		stakingKeeper,
-	)
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
-
-	// update validator mock
-	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(1)
-
-	// second delegation
-	_, del2, err := distrtestutil.Delegate(
-		ctx,
-		distrKeeper,
-		sdk.AccAddress(valConsAddr1),
-		&val,
-		sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
-		nil,
-		stakingKeeper,
-	)
-	require.NoError(t, err)
-
-	// new delegation mock and update validator mock
-	stakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(valConsAddr1), valAddr).Return(del2, nil)
-	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(1)
-
-	// call necessary hooks to update a delegation
-	err = distrKeeper.Hooks().AfterDelegationModified(ctx, sdk.AccAddress(valConsAddr1), valAddr)
-	require.NoError(t, err)
-
-	// next block
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-
-	// allocate some more rewards
-	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
-
-	// slash the validator again
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
-	distrtestutil.SlashValidator(
-		ctx,
-		valConsAddr0,
-		ctx.BlockHeight(),
-		valPower,
-		math.LegacyNewDecWithPrec(5, 1),
-		&val,
-		&distrKeeper,
-		stakingKeeper,
-	)
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
-
-	// end period
-	endingPeriod, _ := distrKeeper.IncrementValidatorPeriod(ctx, val)
-
-	// calculate delegation rewards for del1
-	rewards, err := distrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
-	require.NoError(t, err)
+	// create validator with 50% commission, slashed by half before and after a
+	// second delegation with the same tokens as the initial stake
+	initial := sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction)
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))
+	scenario := distrtestutil.NewRewardScenario().
+		WithValidator(100, math.LegacyNewDecWithPrec(5, 1)).
+		Allocate(1, 0, tokens).
+		Slash(4, 0, math.LegacyNewDecWithPrec(5, 1)).
+		Delegate(7, 0, 0, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)).
+		Allocate(8, 0, tokens).
+		Slash(11, 0, math.LegacyNewDecWithPrec(5, 1)).
+		WithBlocks(14)
+	result := scenario.Run(t)
+	valAddr := scenario.Validator(0)
+	initialDec := math.LegacyNewDecFromInt(initial)
 
 	// rewards for del1 should be 2/3 initial (half initial first period, 1/6 initial second period)
-	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial.QuoInt64(2).Add(initial.QuoInt64(6))}}, rewards)
-
-	// calculate delegation rewards for del2
-	rewards, err = distrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
-	require.NoError(t, err)
+	del1 := result.Delegation(sdk.AccAddress(valAddr), valAddr)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initialDec.QuoInt64(2).Add(initialDec.QuoInt64(6))}}, del1.Accrued)
 
 	// rewards for del2 should be initial / 3
-	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial.QuoInt64(3)}}, rewards)
+	del2 := result.Delegation(scenario.Delegator(0), valAddr)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initialDec.QuoInt64(3)}}, del2.Accrued)
 
 	// commission should be equal to initial (twice 50% commission, unaffected by slashing)
-	valCommission, err := distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr)
-	require.NoError(t, err)
-	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial}}, valCommission.Commission)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initialDec}}, result.Commission(valAddr))
 }
 
 func TestCalculateRewardsMultiDelegatorMultiWithdraw(t *testing.T) {
@@ -849,13 +864,13 @@ func TestCalculateRewardsMultiDelegatorMultiWithdraw(t *testing.T) {
 
 	// first delegator withdraws
 	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(initial*3/4))}
-	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expRewards)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, expRewards)
 	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
 	require.NoError(t, err)
 
 	// second delegator withdraws
 	expRewards = sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(initial*1/4))}
-	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, sdk.AccAddress(valConsAddr1), expRewards)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, sdk.AccAddress(valConsAddr1), expRewards)
 	_, err = distrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valConsAddr1), valAddr)
 	require.NoError(t, err)
 
@@ -898,7 +913,7 @@ func TestCalculateRewardsMultiDelegatorMultiWithdraw(t *testing.T) {
 
 	// first delegator withdraws again
 	expCommission = sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(initial*1/4))}
-	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expCommission)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, expCommission)
 	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
 	require.NoError(t, err)
 
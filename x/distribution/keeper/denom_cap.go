@@ -0,0 +1,375 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// capOutstandingRewardDenoms returns the tokens to allocate to a validator so
+// that its outstanding rewards track at most MaxOutstandingRewardDenoms denoms.
+// The rewards in the denoms exceeding the cap, whether allocated or evicted
+// from the records of the validator, are diverted to the community pool.
+//
+// The new denoms of the tokens are considered in order. Under the EVICT_SMALLEST
+// policy, the new denom is compared to the tracked denoms by the amount of the
+// allocated and outstanding rewards, and the smallest one is diverted, the
+// lowest denom first on ties. The tokens in a denom whose eviction is still
+// pending are diverted regardless of the cap.
+func (k Keeper) capOutstandingRewardDenoms(ctx context.Context, val sdk.ValAddress, operator string, tokens sdk.DecCoins) (sdk.DecCoins, error) {
+	evicting, err := k.getPendingEvictedDenoms(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(evicting) > 0 {
+		var pending sdk.DecCoins
+		for _, denom := range evicting {
+			pending = pending.Add(sdk.NewDecCoinFromDec(denom, tokens.AmountOf(denom)))
+			tokens = withoutDenom(tokens, denom)
+		}
+
+		if err := k.divertRewards(ctx, operator, pending, types.AttributeValueConditionEvictedDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.MaxOutstandingRewardDenoms == 0 {
+		return tokens, nil
+	}
+
+	outstanding, err := k.GetValidatorOutstandingRewardsCoins(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	evict := params.OutstandingRewardsDenomPolicy == types.OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_EVICT_SMALLEST
+
+	var kept, diverted sdk.DecCoins
+	for _, token := range tokens {
+		if !outstanding.AmountOf(token.Denom).IsZero() || uint32(len(outstanding)) < params.MaxOutstandingRewardDenoms {
+			kept = append(kept, token)
+			outstanding = outstanding.Add(token)
+			continue
+		}
+
+		smallest := token
+		if evict {
+			for _, coin := range outstanding {
+				if coin.Amount.LT(smallest.Amount) || (coin.Amount.Equal(smallest.Amount) && coin.Denom < smallest.Denom) {
+					smallest = coin
+				}
+			}
+		}
+
+		if smallest.Denom == token.Denom {
+			diverted = append(diverted, token)
+			continue
+		}
+
+		evicted, err := k.evictRewardDenom(ctx, val, smallest.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.divertRewards(ctx, operator, evicted, types.AttributeValueConditionEvictedDenom); err != nil {
+			return nil, err
+		}
+
+		kept = append(kept, token)
+		outstanding = withoutDenom(outstanding, smallest.Denom).Add(token)
+	}
+
+	if err := k.divertRewards(ctx, operator, diverted, types.AttributeValueConditionNewDenom); err != nil {
+		return nil, err
+	}
+
+	return kept, nil
+}
+
+// divertRewards adds rewards diverted from a validator to the community pool.
+func (k Keeper) divertRewards(ctx context.Context, operator string, rewards sdk.DecCoins, condition string) error {
+	if rewards.IsZero() {
+		return nil
+	}
+
+	if err := k.addToCommunityPool(ctx, rewards); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardsDiverted,
+			sdk.NewAttribute(types.AttributeKeyValidator, operator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyCondition, condition),
+		),
+	)
+
+	return nil
+}
+
+// rewardDenomEvictionRecordsPerBlock bounds the number of historical and
+// accrued rewards records inspected by the pending reward denom evictions at
+// the end of each block.
+const rewardDenomEvictionRecordsPerBlock = 1_000
+
+// evictRewardDenom removes a denom from the outstanding rewards, accumulated
+// commission, current rewards and latest historical rewards of a validator,
+// and returns the outstanding rewards in the denom. Its other historical
+// rewards and the accrued rewards of its delegations are left to
+// EvictRewardDenoms, so that the work done while allocating is bounded.
+//
+// Until then, the denom is ignored by the rewards calculations of the
+// delegations of the validator, and its new rewards in the denom are diverted
+// to the community pool: the delegations are owed nothing in the denom, as all
+// the cumulative reward ratios of the validator agree on it.
+func (k Keeper) evictRewardDenom(ctx context.Context, val sdk.ValAddress, denom string) (sdk.DecCoins, error) {
+	outstanding, err := k.GetValidatorOutstandingRewards(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	evicted := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, outstanding.Rewards.AmountOf(denom)))
+	outstanding.Rewards = withoutDenom(outstanding.Rewards, denom)
+	if err := k.SetValidatorOutstandingRewards(ctx, val, outstanding); err != nil {
+		return nil, err
+	}
+
+	commission, err := k.GetValidatorAccumulatedCommission(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	commission.Commission = withoutDenom(commission.Commission, denom)
+	if err := k.SetValidatorAccumulatedCommission(ctx, val, commission); err != nil {
+		return nil, err
+	}
+
+	current, err := k.GetValidatorCurrentRewards(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	current.Rewards = withoutDenom(current.Rewards, denom)
+	if err := k.SetValidatorCurrentRewards(ctx, val, current); err != nil {
+		return nil, err
+	}
+
+	// the records of the next periods are derived from the latest one
+	latest, err := k.GetValidatorHistoricalRewards(ctx, val, current.Period-1)
+	if err != nil {
+		return nil, err
+	}
+
+	latest.CumulativeRewardRatio = withoutDenom(latest.CumulativeRewardRatio, denom)
+	if err := k.SetValidatorHistoricalRewards(ctx, val, current.Period-1, latest); err != nil {
+		return nil, err
+	}
+
+	return evicted, k.RewardDenomEvictions.Set(ctx, collections.Join(val, denom), []byte{})
+}
+
+// getPendingEvictedDenoms returns the denoms whose eviction from the rewards
+// records of a validator is pending.
+func (k Keeper) getPendingEvictedDenoms(ctx context.Context, val sdk.ValAddress) ([]string, error) {
+	iter, err := k.RewardDenomEvictions.Iterate(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, string](val))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, len(keys))
+	for i, key := range keys {
+		denoms[i] = key.K2()
+	}
+
+	return denoms, nil
+}
+
+// EvictRewardDenoms removes the denoms evicted from the outstanding rewards of
+// the validators from their historical rewards and from the accrued rewards of
+// their delegations. It inspects up to rewardDenomEvictionRecordsPerBlock
+// records, resuming each eviction after the last record inspected in the
+// previous block, and completes an eviction once all of them have been
+// inspected.
+func (k Keeper) EvictRewardDenoms(ctx context.Context) error {
+	// collect the evictions first, as the iterator must be closed before
+	// writing to the store; each of them inspects at least one record
+	iter, err := k.RewardDenomEvictions.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var evictions []collections.KeyValue[collections.Pair[sdk.ValAddress, string], []byte]
+	for ; iter.Valid() && len(evictions) < rewardDenomEvictionRecordsPerBlock; iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		evictions = append(evictions, kv)
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	limit := rewardDenomEvictionRecordsPerBlock
+	for _, eviction := range evictions {
+		if limit == 0 {
+			break
+		}
+
+		inspected, cursor, err := k.evictRewardDenomRecords(ctx, eviction.Key.K1(), eviction.Key.K2(), eviction.Value, limit)
+		if err != nil {
+			return err
+		}
+		limit -= inspected
+
+		if cursor == nil {
+			err = k.RewardDenomEvictions.Remove(ctx, eviction.Key)
+		} else {
+			err = k.RewardDenomEvictions.Set(ctx, eviction.Key, cursor)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// evictRewardDenomRecords removes a denom from up to limit historical rewards
+// records of a validator and then accrued rewards records of its delegations,
+// resuming after the store key of the cursor. It returns the number of records
+// inspected and the key of the last one, which is nil once all of them have
+// been inspected.
+func (k Keeper) evictRewardDenomRecords(ctx context.Context, val sdk.ValAddress, denom string, cursor []byte, limit int) (int, []byte, error) {
+	valKey := make([]byte, sdk.ValAddressKey.SizeNonTerminal(val))
+	if _, err := sdk.ValAddressKey.EncodeNonTerminal(valKey, val); err != nil {
+		return 0, nil, err
+	}
+	accruedPrefix := append(bytes.Clone(types.DelegatorAccruedRewardsPrefix.Bytes()), valKey...)
+
+	inspected := 0
+	if !bytes.HasPrefix(cursor, accruedPrefix) {
+		n, last, err := k.evictDenomFromRecords(ctx, types.GetValidatorHistoricalRewardsPrefix(val), cursor, limit,
+			func(bz []byte) ([]byte, error) {
+				var historical types.ValidatorHistoricalRewards
+				if err := k.cdc.Unmarshal(bz, &historical); err != nil {
+					return nil, errorsmod.Wrapf(types.ErrInvalidRecord, "historical rewards of validator %s: %s", val, err)
+				}
+				if historical.CumulativeRewardRatio.AmountOf(denom).IsZero() {
+					return bz, nil
+				}
+
+				historical.CumulativeRewardRatio = withoutDenom(historical.CumulativeRewardRatio, denom)
+				return k.cdc.Marshal(&historical)
+			},
+		)
+		if err != nil || last != nil {
+			return n, last, err
+		}
+
+		inspected = n
+		cursor = nil
+	}
+
+	if inspected == limit {
+		// resume with the accrued rewards in the next block
+		return inspected, accruedPrefix, nil
+	}
+
+	n, last, err := k.evictDenomFromRecords(ctx, accruedPrefix, cursor, limit-inspected,
+		func(bz []byte) ([]byte, error) {
+			var accrued types.DelegatorAccruedRewards
+			if err := k.cdc.Unmarshal(bz, &accrued); err != nil {
+				return nil, errorsmod.Wrapf(types.ErrInvalidRecord, "accrued rewards of validator %s: %s", val, err)
+			}
+			if accrued.Rewards.AmountOf(denom).IsZero() {
+				return bz, nil
+			}
+
+			accrued.Rewards = withoutDenom(accrued.Rewards, denom)
+			if accrued.Rewards.IsZero() {
+				return nil, nil
+			}
+			return k.cdc.Marshal(&accrued)
+		},
+	)
+	return inspected + n, last, err
+}
+
+// evictDenomFromRecords updates up to limit records under a prefix, resuming
+// after the store key of the cursor, with the value returned by evict, which
+// deletes the record when nil. It returns the number of records inspected and
+// the key of the last one, which is nil once all of them have been inspected.
+func (k Keeper) evictDenomFromRecords(ctx context.Context, prefix, cursor []byte, limit int, evict func([]byte) ([]byte, error)) (int, []byte, error) {
+	start := prefix
+	if bytes.HasPrefix(cursor, prefix) && len(cursor) > len(prefix) {
+		start = append(bytes.Clone(cursor), 0x00)
+	}
+
+	type record struct {
+		key, value []byte
+	}
+
+	// collect the records first, as the iterator must be closed before writing
+	// to the store
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := store.Iterator(start, storetypes.PrefixEndBytes(prefix))
+	var records []record
+	for ; iter.Valid() && len(records) < limit; iter.Next() {
+		records = append(records, record{key: bytes.Clone(iter.Key()), value: bytes.Clone(iter.Value())})
+	}
+	end := !iter.Valid()
+	if err := iter.Close(); err != nil {
+		return 0, nil, err
+	}
+
+	for _, r := range records {
+		bz, err := evict(r.value)
+		switch {
+		case err != nil:
+			return 0, nil, err
+		case bz == nil:
+			store.Delete(r.key)
+		case !bytes.Equal(bz, r.value):
+			store.Set(r.key, bz)
+		}
+	}
+
+	if end {
+		return len(records), nil, nil
+	}
+
+	return len(records), records[len(records)-1].key, nil
+}
+
+// withoutDenom returns the coins without the given denom.
+func withoutDenom(coins sdk.DecCoins, denom string) sdk.DecCoins {
+	var res sdk.DecCoins
+	for _, coin := range coins {
+		if coin.Denom != denom {
+			res = append(res, coin)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func denomCapParams(maxDenoms uint32, policy disttypes.OutstandingRewardsDenomPolicy) disttypes.Params {
+	params := checkpointParams(1, 3)
+	params.MaxOutstandingRewardDenoms = maxDenoms
+	params.OutstandingRewardsDenomPolicy = policy
+	return params
+}
+
+func decCoins(coins ...sdk.DecCoin) sdk.DecCoins {
+	return sdk.NewDecCoins(coins...)
+}
+
+func decCoin(denom string, amount int64) sdk.DecCoin {
+	return sdk.NewDecCoinFromDec(denom, math.LegacyNewDec(amount))
+}
+
+// allocate allocates the tokens to the validator in a new block in which the
+// validator is slashed, ending a period, and returns the diversion events.
+func (f *validatorFixture) allocate(t *testing.T, tokens sdk.DecCoins) []sdk.Event {
+	t.Helper()
+
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, tokens))
+	distrtestutil.SlashValidator(f.Ctx, valConsAddr0, f.Ctx.BlockHeight(), 100,
+		math.LegacyNewDecWithPrec(1, 1), f.val, &f.Keeper, f.StakingKeeper)
+	require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+
+	var events []sdk.Event
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeRewardsDiverted {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func requireDiversion(t *testing.T, events []sdk.Event, amount sdk.DecCoins, condition string) {
+	t.Helper()
+
+	require.Len(t, events, 1)
+	attrs := make(map[string]string)
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, amount.String(), attrs[sdk.AttributeKeyAmount])
+	require.Equal(t, condition, attrs[disttypes.AttributeKeyCondition])
+}
+
+func TestOutstandingRewardDenomsCapDivertNew(t *testing.T) {
+	allocations := []sdk.DecCoins{
+		decCoins(decCoin("stake", 1_000), decCoin("uatom", 300)),
+		decCoins(decCoin("uosmo", 500)),
+		decCoins(decCoin("stake", 2_000), decCoin("ujuno", 10), decCoin("uatom", 7)),
+		decCoins(decCoin("aaa", 1_000_000), decCoin("uatom", 1)),
+	}
+	diversions := []sdk.DecCoins{
+		nil,
+		decCoins(decCoin("uosmo", 500)),
+		decCoins(decCoin("ujuno", 10)),
+		decCoins(decCoin("aaa", 1_000_000)),
+	}
+
+	for _, policy := range []disttypes.OutstandingRewardsDenomPolicy{
+		disttypes.OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_UNSPECIFIED,
+		disttypes.OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_DIVERT_NEW,
+	} {
+		capped := newValidatorFixture(t, denomCapParams(2, policy))
+		// the reference fixture is only allocated the retained denoms
+		reference := newValidatorFixture(t, checkpointParams(1, 3))
+
+		var allocated, diverted sdk.DecCoins
+		for i, tokens := range allocations {
+			events := capped.allocate(t, tokens)
+			reference.allocate(t, tokens.Sub(diversions[i]))
+
+			if diversions[i] == nil {
+				require.Empty(t, events)
+			} else {
+				requireDiversion(t, events, diversions[i], disttypes.AttributeValueConditionNewDenom)
+			}
+			allocated = allocated.Add(tokens...)
+			diverted = diverted.Add(diversions[i]...)
+
+			// the outstanding rewards are bounded and the tokens are conserved
+			require.Len(t, capped.outstanding(t), 2)
+			require.Equal(t, diverted, capped.communityPool(t))
+			require.Equal(t, allocated, capped.outstanding(t).Add(capped.communityPool(t)...))
+		}
+
+		// the withdrawal math of the retained denoms is unaffected
+		require.Equal(t, reference.rewards(t), capped.rewards(t))
+		capped.withdrawAll(t)
+		reference.withdrawAll(t)
+		require.Equal(t, reference.Payouts, capped.Payouts)
+		require.Equal(t, reference.outstanding(t), capped.outstanding(t))
+	}
+}
+
+func TestOutstandingRewardDenomsCapEvictSmallest(t *testing.T) {
+	run := func() *validatorFixture {
+		f := newValidatorFixture(t, denomCapParams(2,
+			disttypes.OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_EVICT_SMALLEST))
+
+		var allocated sdk.DecCoins
+		allocate := func(tokens sdk.DecCoins, expDiverted sdk.DecCoins, condition string) {
+			t.Helper()
+
+			outstanding := f.outstanding(t)
+			events := f.allocate(t, tokens)
+			if expDiverted == nil {
+				require.Empty(t, events)
+			} else {
+				requireDiversion(t, events, expDiverted, condition)
+			}
+
+			allocated = allocated.Add(tokens...)
+			require.LessOrEqual(t, len(f.outstanding(t)), 2)
+			require.Equal(t, allocated, f.outstanding(t).Add(f.communityPool(t)...))
+
+			// the evicted denoms are gone from all the rewards records
+			for _, coin := range outstanding {
+				if !f.outstanding(t).AmountOf(coin.Denom).IsZero() {
+					continue
+				}
+				for _, rewards := range f.rewards(t) {
+					require.True(t, rewards.AmountOf(coin.Denom).IsZero())
+				}
+				f.Keeper.IterateValidatorHistoricalRewards(f.Ctx, func(_ sdk.ValAddress, _ uint64, historical disttypes.ValidatorHistoricalRewards) bool {
+					require.True(t, historical.CumulativeRewardRatio.AmountOf(coin.Denom).IsZero())
+					return false
+				})
+			}
+		}
+
+		allocate(decCoins(decCoin("stake", 1_000), decCoin("uatom", 50)), nil, "")
+		// the new denom is smaller than all the tracked ones
+		allocate(decCoins(decCoin("uosmo", 20)), decCoins(decCoin("uosmo", 20)), disttypes.AttributeValueConditionNewDenom)
+		// the tracked uatom is the smallest, all its outstanding rewards are diverted
+		allocate(decCoins(decCoin("ujuno", 70)), decCoins(decCoin("uatom", 50)), disttypes.AttributeValueConditionEvictedDenom)
+		// ties are broken by denom: uatom is evicted before ujuno
+		allocate(decCoins(decCoin("uatom", 70)), decCoins(decCoin("uatom", 70)), disttypes.AttributeValueConditionNewDenom)
+		allocate(decCoins(decCoin("aaa", 70)), decCoins(decCoin("aaa", 70)), disttypes.AttributeValueConditionNewDenom)
+		allocate(decCoins(decCoin("zzz", 70)), decCoins(decCoin("ujuno", 70)), disttypes.AttributeValueConditionEvictedDenom)
+
+		require.Equal(t, sdk.NewDecCoins(decCoin("stake", 1_000), decCoin("zzz", 70)), f.outstanding(t))
+		return f
+	}
+
+	// the evictions are deterministic
+	f := run()
+	require.Equal(t, f.rewards(t), run().rewards(t))
+
+	// the rewards of the retained denoms are paid out in full
+	expected := f.rewards(t)
+	f.withdrawAll(t)
+	for i, delAddr := range f.delAddrs {
+		exp, _ := expected[i].TruncateDecimal()
+		require.Equal(t, exp, f.Payouts[delAddr.String()])
+	}
+}
+
+// evictionFixture returns a fixture with a delegation started in each of the
+// given number of periods, whose historical rewards records all track uatom,
+// and the gas used by the allocation evicting uatom. The rewards of the first
+// delegations are checkpointed after a slash, and accrue uatom as well.
+func evictionFixture(t *testing.T, delegations int) (*validatorFixture, storetypes.Gas) {
+	t.Helper()
+
+	f := newValidatorFixture(t, denomCapParams(2,
+		disttypes.OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_EVICT_SMALLEST))
+	require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, decCoins(decCoin("stake", 1_000_000), decCoin("uatom", 50))))
+	for i := 0; i < delegations; i++ {
+		f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+		require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, decCoins(decCoin("uatom", 1))))
+		delAddr := sdk.AccAddress(fmt.Sprintf("delegator%016d", i))
+		f.delegate(t, delAddr, math.NewInt(1_000))
+		if i%100 == 0 {
+			f.delAddrs = append(f.delAddrs, delAddr)
+		}
+		if i == 5 {
+			distrtestutil.SlashValidator(f.Ctx, valConsAddr0, f.Ctx.BlockHeight(), 100,
+				math.LegacyNewDecWithPrec(1, 1), f.val, &f.Keeper, f.StakingKeeper)
+		}
+		require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+	}
+	require.True(t, f.hasAccruedRewards(t))
+
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx.WithGasMeter(gasMeter), f.val, decCoins(decCoin("ujuno", 10_000))))
+	require.True(t, f.outstanding(t).AmountOf("uatom").IsZero())
+	return f, gasMeter.GasConsumed()
+}
+
+func TestRewardDenomEvictionBounded(t *testing.T) {
+	_, fewRecordsGas := evictionFixture(t, 10)
+	f, gas := evictionFixture(t, 1_200)
+
+	// the eviction does not depend on the number of historical rewards records
+	require.InDelta(t, fewRecordsGas, gas, 1_000)
+
+	historicalUatom := func() (records int) {
+		f.Keeper.IterateValidatorHistoricalRewards(f.Ctx, func(_ sdk.ValAddress, _ uint64, historical disttypes.ValidatorHistoricalRewards) bool {
+			if !historical.CumulativeRewardRatio.AmountOf("uatom").IsZero() {
+				records++
+			}
+			return false
+		})
+		return records
+	}
+	require.Greater(t, historicalUatom(), 1_000)
+	expRewards := f.rewards(t)
+	for _, rewards := range expRewards {
+		require.True(t, rewards.AmountOf("uatom").IsZero())
+	}
+
+	// the records are rid of uatom over two blocks, in which it is ignored by
+	// the rewards calculations and diverted from the new allocations
+	require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+	require.Positive(t, historicalUatom())
+	require.Equal(t, expRewards, f.rewards(t))
+
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, decCoins(decCoin("uatom", 10))))
+	require.True(t, f.outstanding(t).AmountOf("uatom").IsZero())
+	require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+	require.Zero(t, historicalUatom())
+
+	pending, err := f.Keeper.RewardDenomEvictions.Has(f.Ctx, collections.Join(f.valAddr, "uatom"))
+	require.NoError(t, err)
+	require.False(t, pending)
+	require.Equal(t, expRewards, f.rewards(t))
+	for _, delAddr := range f.delAddrs {
+		accrued, err := f.Keeper.GetDelegatorAccruedRewards(f.Ctx, f.valAddr, delAddr)
+		require.NoError(t, err)
+		require.True(t, accrued.AmountOf("uatom").IsZero())
+	}
+
+	// the denom can be allocated again
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+	require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, decCoins(decCoin("uatom", 1_000_000))))
+	require.Equal(t, decCoin("uatom", 1_000_000).Amount, f.outstanding(t).AmountOf("uatom"))
+	f.withdrawAll(t)
+}
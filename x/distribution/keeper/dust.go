@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetValidatorDustStats returns the cumulative dust sent to the community pool
+// on withdrawals from a validator, including the dust of the current block
+// which has not been flushed yet.
+func (k Keeper) GetValidatorDustStats(ctx context.Context, val sdk.ValAddress) (types.ValidatorDustStats, error) {
+	stats, err := k.ValidatorDustStats.Get(ctx, val)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorDustStats{}, err
+	}
+
+	if k.pendingValidatorDust != nil {
+		pending, err := k.pendingValidatorDust.Get(ctx, val)
+		switch {
+		case err == nil:
+			stats.Dust = stats.Dust.Add(pending.Dust...)
+		case !errors.Is(err, collections.ErrNotFound):
+			return types.ValidatorDustStats{}, err
+		}
+	}
+
+	return stats, nil
+}
+
+// FlushValidatorDust moves the dust batched in the transient store during the
+// current block to the cumulative per validator dust counters.
+func (k Keeper) FlushValidatorDust(ctx context.Context) error {
+	if k.pendingValidatorDust == nil {
+		return nil
+	}
+
+	iter, err := k.pendingValidatorDust.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if err := k.addValidatorDust(ctx, k.ValidatorDustStats, kv.Key, kv.Value.Dust); err != nil {
+			return err
+		}
+	}
+
+	return k.pendingValidatorDust.Clear(ctx, nil)
+}
+
+// recordValidatorDust attributes dust sent to the community pool to a
+// validator. The dust is batched in the transient store when available.
+func (k Keeper) recordValidatorDust(ctx context.Context, val sdk.ValAddress, dust sdk.DecCoins) error {
+	if dust.IsZero() {
+		return nil
+	}
+
+	if k.pendingValidatorDust != nil {
+		return k.addValidatorDust(ctx, *k.pendingValidatorDust, val, dust)
+	}
+
+	return k.addValidatorDust(ctx, k.ValidatorDustStats, val, dust)
+}
+
+func (k Keeper) addValidatorDust(
+	ctx context.Context,
+	m collections.Map[sdk.ValAddress, types.ValidatorDustStats],
+	val sdk.ValAddress,
+	dust sdk.DecCoins,
+) error {
+	stats, err := m.Get(ctx, val)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	stats.Dust = stats.Dust.Add(dust...)
+	return m.Set(ctx, val, stats)
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// withdrawWithDust withdraws the rewards of two validators in a single block,
+// leaving 0.5stake of dust for the first one and 1stake for the second one.
+func withdrawWithDust(t *testing.T, batched bool) (sdk.Context, keeper.Keeper, []sdk.ValAddress) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(disttypes.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, tkey)
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	var opts []keeper.InitOption
+	if batched {
+		opts = append(opts, keeper.WithTransientStoreService(runtime.NewTransientStoreService(tkey)))
+	}
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+		opts...,
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create two validators with 50% commission
+	var (
+		vals     []*stakingtypes.Validator
+		valAddrs []sdk.ValAddress
+	)
+	for _, consPk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		valAddr := sdk.ValAddress(consPk.Address())
+		val, err := distrtestutil.CreateValidator(consPk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+		selfAddr := sdk.AccAddress(valAddr)
+		selfDel := stakingtypes.NewDelegation(selfAddr.String(), valAddr.String(), val.DelegatorShares)
+
+		// always return the latest validator state, as it is updated by the delegation below
+		stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).DoAndReturn(
+			func(context.Context, sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+				return val, nil
+			},
+		).AnyTimes()
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), selfAddr, valAddr).Return(selfDel, nil).AnyTimes()
+
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, selfAddr, valAddr))
+
+		vals = append(vals, &val)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	// the second validator also has an external delegation with the same stake
+	extAddr := sdk.AccAddress(valConsAddr2)
+	_, extDel, err := distrtestutil.Delegate(ctx, distrKeeper, extAddr, vals[1], math.NewInt(100), nil, stakingKeeper)
+	require.NoError(t, err)
+
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), extAddr, valAddrs[1]).Return(extDel, nil).AnyTimes()
+	require.NoError(t, distrKeeper.Hooks().AfterDelegationModified(ctx, extAddr, valAddrs[1]))
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// 3stake leave 1.5stake of rewards to the delegators of the first validator,
+	// of which 0.5stake are dust
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(3)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, *vals[0], tokens))
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+	require.NoError(t, err)
+
+	// 6stake leave 1.5stake of rewards to each delegator of the second
+	// validator, of which 0.5stake are dust for each of the two withdrawals
+	tokens = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(6)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, *vals[1], tokens))
+	for _, delAddr := range []sdk.AccAddress{sdk.AccAddress(valAddrs[1]), extAddr} {
+		_, err = distrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddrs[1])
+		require.NoError(t, err)
+	}
+
+	return ctx, distrKeeper, valAddrs
+}
+
+func TestValidatorDustStats(t *testing.T) {
+	expDust := []sdk.DecCoins{
+		{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(5, 1)}},
+		{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(1)}},
+	}
+
+	ctx, distrKeeper, valAddrs := withdrawWithDust(t, false)
+
+	querier := keeper.NewQuerier(distrKeeper)
+	for i, valAddr := range valAddrs {
+		res, err := querier.ValidatorDustStats(ctx, &disttypes.QueryValidatorDustStatsRequest{ValidatorAddress: valAddr.String()})
+		require.NoError(t, err)
+		require.Equal(t, expDust[i], res.Stats.Dust)
+	}
+
+	// the dust attributed to the validators matches the dust in the community pool
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expDust[0].Add(expDust[1]...), feePool.CommunityPool)
+
+	// the counters are part of the exported genesis
+	require.NoError(t, distrKeeper.SetPreviousProposerConsAddr(ctx, valConsAddr0))
+	genState := distrKeeper.ExportGenesis(ctx)
+	require.Len(t, genState.ValidatorDustStats, 2)
+	require.NoError(t, disttypes.ValidateGenesis(genState))
+}
+
+func TestValidatorDustStatsBatched(t *testing.T) {
+	immediateCtx, immediateKeeper, valAddrs := withdrawWithDust(t, false)
+	batchedCtx, batchedKeeper, _ := withdrawWithDust(t, true)
+
+	for _, valAddr := range valAddrs {
+		expStats, err := immediateKeeper.GetValidatorDustStats(immediateCtx, valAddr)
+		require.NoError(t, err)
+
+		// nothing is persisted before the end of the block
+		_, err = batchedKeeper.ValidatorDustStats.Get(batchedCtx, valAddr)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+
+		// but the pending dust is already visible
+		stats, err := batchedKeeper.GetValidatorDustStats(batchedCtx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, expStats, stats)
+	}
+
+	require.NoError(t, batchedKeeper.EndBlocker(batchedCtx))
+
+	for _, valAddr := range valAddrs {
+		expStats, err := immediateKeeper.ValidatorDustStats.Get(immediateCtx, valAddr)
+		require.NoError(t, err)
+
+		stats, err := batchedKeeper.ValidatorDustStats.Get(batchedCtx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, expStats, stats)
+
+		// the pending dust has been cleared, so it is not counted twice
+		stats, err = batchedKeeper.GetValidatorDustStats(batchedCtx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, expStats, stats)
+	}
+}
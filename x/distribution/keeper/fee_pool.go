@@ -2,8 +2,13 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -15,6 +20,13 @@ func (k Keeper) DistributeFromFeePool(ctx context.Context, amount sdk.Coins, rec
 		return err
 	}
 
+	// only the integer part of the community pool can be spent, the module
+	// account does not hold the fractional part
+	spendable, _ := feePool.CommunityPool.TruncateDecimal()
+	if shortfall := coinsShortfall(spendable, amount); !shortfall.IsZero() {
+		return errorsmod.Wrapf(types.ErrBadDistribution, "spendable community pool %s is short of %s by %s", spendable, amount, shortfall)
+	}
+
 	// NOTE the community pool isn't a module account, however its coins
 	// are held in the distribution module account. Thus the community pool
 	// must be reduced separately from the SendCoinsFromModuleToAccount call
@@ -32,3 +44,140 @@ func (k Keeper) DistributeFromFeePool(ctx context.Context, amount sdk.Coins, rec
 
 	return k.FeePool.Set(ctx, feePool)
 }
+
+// SweepCommunityPoolDenoms removes the spendable amounts of the given denoms
+// from the community pool, burning them or sending them to the disposal address
+// if set, and returns the amount removed. The fractional parts of the denoms,
+// which the module account does not hold, are left in the pool. The community
+// pool allowed denoms cannot be swept.
+func (k Keeper) SweepCommunityPoolDenoms(ctx context.Context, denoms []string, disposalAddr sdk.AccAddress) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	spendable, _ := feePool.CommunityPool.TruncateDecimal()
+	amount := sdk.Coins{}
+	for _, denom := range denoms {
+		if slices.Contains(params.CommunityPoolAllowedDenoms, denom) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot sweep the community pool allowed denom %s", denom)
+		}
+
+		if coinAmount := spendable.AmountOf(denom); coinAmount.IsPositive() {
+			amount = amount.Add(sdk.NewCoin(denom, coinAmount))
+		}
+	}
+
+	if amount.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no spendable amount of %v in the community pool", denoms)
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoinsFromCoins(amount...))
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return nil, err
+	}
+
+	destination := types.AttributeValueDestinationBurn
+	if disposalAddr == nil {
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount)
+	} else {
+		destination = disposalAddr.String()
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, disposalAddr, amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSweepCommunityPool,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, destination),
+		),
+	)
+
+	return amount, nil
+}
+
+// CommunityPoolSpendable returns the integer coins of the community pool which
+// can be spent, the decimal total of the community pool and its fractional
+// remainder, which is held as dust until it adds up to whole coins.
+func (k Keeper) CommunityPoolSpendable(ctx context.Context) (spendable sdk.Coins, total, remainder sdk.DecCoins, err error) {
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	spendable, remainder = feePool.CommunityPool.TruncateDecimal()
+	return spendable, feePool.CommunityPool, remainder, nil
+}
+
+// CheckCommunityPoolBacking checks that the community pool is backed by the
+// module account. The balance of the module account not owed to validators,
+// as outstanding rewards or withheld commission, is attributable to the
+// community pool. It must not differ from the decimal community pool by more
+// than the fractional parts accumulated in the pool and the outstanding
+// rewards, which the integer balance cannot represent.
+func (k Keeper) CheckCommunityPoolBacking(ctx context.Context) error {
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	owed := sdk.DecCoins{}
+	fractional := decCoinsFractional(feePool.CommunityPool)
+	k.IterateValidatorOutstandingRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+		owed = owed.Add(rewards.Rewards...)
+		fractional = fractional.Add(decCoinsFractional(rewards.Rewards)...)
+		return false
+	})
+
+	err = k.ValidatorCommissionWithholdings.Walk(ctx, nil, func(_ sdk.ValAddress, withholding types.ValidatorCommissionWithholding) (stop bool, err error) {
+		owed = owed.Add(sdk.NewDecCoinsFromCoins(withholding.Amount...)...)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	balance := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(types.ModuleName))...)
+	attributable, negative := balance.SafeSub(owed)
+	if negative {
+		return fmt.Errorf("distribution module balance %s does not cover the rewards and commission owed to validators %s", balance, owed)
+	}
+
+	for _, coin := range feePool.CommunityPool.Add(attributable...) {
+		drift := feePool.CommunityPool.AmountOf(coin.Denom).Sub(attributable.AmountOf(coin.Denom)).Abs()
+		if drift.GT(fractional.AmountOf(coin.Denom)) {
+			return fmt.Errorf(
+				"community pool %s drifted from the module balance attributable to it %s by %s%s, more than the accumulated fractional parts %s",
+				feePool.CommunityPool, attributable, drift, coin.Denom, fractional,
+			)
+		}
+	}
+
+	return nil
+}
+
+// coinsShortfall returns the amounts of required exceeding available.
+func coinsShortfall(available, required sdk.Coins) sdk.Coins {
+	shortfall := sdk.Coins{}
+	for _, coin := range required {
+		if missing := coin.Amount.Sub(available.AmountOf(coin.Denom)); missing.IsPositive() {
+			shortfall = shortfall.Add(sdk.NewCoin(coin.Denom, missing))
+		}
+	}
+	return shortfall
+}
+
+// decCoinsFractional returns the fractional parts of decimal coins.
+func decCoinsFractional(coins sdk.DecCoins) sdk.DecCoins {
+	_, remainder := coins.TruncateDecimal()
+	return remainder
+}
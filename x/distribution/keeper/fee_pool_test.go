@@ -0,0 +1,286 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// newFeePoolKeeper returns a keeper whose module account balance is read from
+// balance, with a community pool with large fractional parts.
+func newFeePoolKeeper(t *testing.T, balance *sdk.Coins) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) error {
+			*balance = balance.Sub(amt...)
+			return nil
+		},
+	).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), disttypes.ModuleName, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+			*balance = balance.Add(amt...)
+			return nil
+		},
+	).AnyTimes()
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), disttypes.ModuleName, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, amt sdk.Coins) error {
+			*balance = balance.Sub(amt...)
+			return nil
+		},
+	).AnyTimes()
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).DoAndReturn(
+		func(context.Context, sdk.AccAddress) sdk.Coins { return *balance },
+	).AnyTimes()
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, k.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, k.FeePool.Set(ctx, disttypes.FeePool{
+		CommunityPool: sdk.DecCoins{
+			sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("3.999999999999999999")),
+			sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("1000.75")),
+		},
+	}))
+
+	return ctx, k
+}
+
+func TestCommunityPoolSpendable(t *testing.T) {
+	balance := sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	ctx, k := newFeePoolKeeper(t, &balance)
+	querier := keeper.NewQuerier(k)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	res, err := querier.CommunityPoolSpendable(ctx, &disttypes.QueryCommunityPoolSpendableRequest{})
+	require.NoError(t, err)
+	require.Equal(t, balance, res.Spendable)
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("3.999999999999999999")),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("1000.75")),
+	}, res.Total)
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.999999999999999999")),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("0.75")),
+	}, res.Remainder)
+
+	// spends exceeding the spendable coins name the shortfall, even when the
+	// decimal total would cover them
+	recipient := sdk.AccAddress("recipient").String()
+	authority := authtypes.NewModuleAddress("gov").String()
+	_, err = msgServer.CommunityPoolSpend(ctx, &disttypes.MsgCommunityPoolSpend{
+		Authority: authority,
+		Recipient: recipient,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("atom", 4), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+	})
+	require.ErrorIs(t, err, disttypes.ErrBadDistribution)
+	require.ErrorContains(t, err, "spendable community pool 3atom,1000stake is short of 4atom,1000stake by 1atom")
+
+	_, err = msgServer.CommunityPoolSpend(ctx, &disttypes.MsgCommunityPoolSpend{
+		Authority: authority,
+		Recipient: recipient,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+	})
+	require.ErrorContains(t, err, "by 1other")
+
+	// the whole spendable amount can be spent, leaving the remainder
+	_, err = msgServer.CommunityPoolSpend(ctx, &disttypes.MsgCommunityPoolSpend{
+		Authority: authority,
+		Recipient: recipient,
+		Amount:    res.Spendable,
+	})
+	require.NoError(t, err)
+
+	spendable, total, remainder, err := k.CommunityPoolSpendable(ctx)
+	require.NoError(t, err)
+	require.True(t, spendable.IsZero())
+	require.Equal(t, res.Remainder, total)
+	require.Equal(t, res.Remainder, remainder)
+	require.NoError(t, k.CheckCommunityPoolBacking(ctx))
+}
+
+func TestCheckCommunityPoolBacking(t *testing.T) {
+	// the pool, the outstanding rewards and the withheld commission add up to
+	// 2013.25stake and 3.999999999999999999atom, of which the module holds the
+	// integer part
+	balance := sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 2013))
+	ctx, k := newFeePoolKeeper(t, &balance)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(valConsPk0.Address()), sdk.ValAddress(valConsPk1.Address())}
+	require.NoError(t, k.SetValidatorOutstandingRewards(ctx, valAddrs[0], disttypes.ValidatorOutstandingRewards{
+		Rewards: sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("500.75"))},
+	}))
+	require.NoError(t, k.SetValidatorOutstandingRewards(ctx, valAddrs[1], disttypes.ValidatorOutstandingRewards{
+		Rewards: sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("501.75"))},
+	}))
+	require.NoError(t, k.ValidatorCommissionWithholdings.Set(ctx, valAddrs[0], disttypes.ValidatorCommissionWithholding{
+		Withheld: true,
+		Amount:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+	}))
+	require.NoError(t, k.CheckCommunityPoolBacking(ctx))
+
+	// coins sent to the module account outside of the distribution are drift
+	balance = balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))
+	require.ErrorContains(t, k.CheckCommunityPoolBacking(ctx), "drifted from the module balance attributable to it")
+
+	// a community pool exceeding its backing is drift as well
+	balance = sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 2010))
+	require.ErrorContains(t, k.CheckCommunityPoolBacking(ctx), "by 3.250000000000000000stake")
+
+	// the module must at least hold what is owed to the validators
+	balance = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.ErrorContains(t, k.CheckCommunityPoolBacking(ctx), "does not cover the rewards and commission owed to validators")
+}
+
+func TestCommunityPoolAllowedDenoms(t *testing.T) {
+	balance := sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	ctx, k := newFeePoolKeeper(t, &balance)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := disttypes.DefaultParams()
+	params.CommunityPoolAllowedDenoms = []string{sdk.DefaultBondDenom}
+	require.NoError(t, k.Params.Set(ctx, params))
+	pool := func() sdk.DecCoins {
+		_, total, _, err := k.CommunityPoolSpendable(ctx)
+		require.NoError(t, err)
+		return total
+	}
+	before := pool()
+
+	// funding with a denom not allowed is rejected as a whole
+	depositor := sdk.AccAddress("depositor").String()
+	_, err := msgServer.FundCommunityPool(ctx, disttypes.NewMsgFundCommunityPool(
+		sdk.NewCoins(sdk.NewInt64Coin("spam", 1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), depositor))
+	require.ErrorIs(t, err, disttypes.ErrDenomNotAllowed)
+	require.ErrorContains(t, err, "spam")
+	require.Equal(t, before, pool())
+
+	// and so is the funding by other modules
+	require.ErrorIs(t, k.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin("spam", 1)), sdk.AccAddress("module")), disttypes.ErrDenomNotAllowed)
+
+	_, err = msgServer.FundCommunityPool(ctx, disttypes.NewMsgFundCommunityPool(
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), depositor))
+	require.NoError(t, err)
+	require.Equal(t, before.Add(decCoin(sdk.DefaultBondDenom, 10)), pool())
+	require.NoError(t, k.CheckCommunityPoolBacking(ctx))
+}
+
+func TestCommunityPoolAllowedDenomsRemainder(t *testing.T) {
+	params := disttypes.DefaultParams()
+	params.CommunityPoolAllowedDenoms = []string{sdk.DefaultBondDenom}
+	f := newValidatorFixture(t, params)
+
+	// the remainders of the withdrawals reach the pool whatever their denom
+	f.allocateBlock(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("33.333333"))))
+	pool := f.communityPool(t)
+	f.withdrawAll(t)
+	require.True(t, f.communityPool(t).AmountOf("atom").GT(pool.AmountOf("atom")))
+}
+
+func TestSweepCommunityPoolDenoms(t *testing.T) {
+	balance := sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	ctx, k := newFeePoolKeeper(t, &balance)
+	msgServer := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	// the pool holds spam funded before the allowed denoms were set
+	depositor := sdk.AccAddress("depositor")
+	spam := sdk.NewCoins(sdk.NewInt64Coin("spam", 100), sdk.NewInt64Coin("junk", 7))
+	require.NoError(t, k.FundCommunityPool(ctx, spam, depositor))
+	params := disttypes.DefaultParams()
+	params.CommunityPoolAllowedDenoms = []string{sdk.DefaultBondDenom}
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	pool := func() sdk.DecCoins {
+		_, total, _, err := k.CommunityPoolSpendable(ctx)
+		require.NoError(t, err)
+		return total
+	}
+
+	// the allowed denoms cannot be swept
+	_, err := msgServer.SweepCommunityPoolDenoms(ctx, disttypes.NewMsgSweepCommunityPoolDenoms(authority, []string{"spam", sdk.DefaultBondDenom}, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// only the authority can sweep
+	_, err = msgServer.SweepCommunityPoolDenoms(ctx, disttypes.NewMsgSweepCommunityPoolDenoms(depositor.String(), []string{"spam"}, ""))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// the swept amounts are burned, the pool decreasing by exactly them
+	before, moduleBalance := pool(), balance
+	res, err := msgServer.SweepCommunityPoolDenoms(ctx, disttypes.NewMsgSweepCommunityPoolDenoms(authority, []string{"spam", "other"}, ""))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("spam", 100)), res.Amount)
+	require.Equal(t, before.Sub(sdk.NewDecCoinsFromCoins(res.Amount...)), pool())
+	require.Equal(t, moduleBalance.Sub(res.Amount...), balance)
+	require.NoError(t, k.CheckCommunityPoolBacking(ctx))
+
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeSweepCommunityPool {
+			events = append(events, event)
+		}
+	}
+	require.Equal(t, []sdk.Event{sdk.NewEvent(
+		disttypes.EventTypeSweepCommunityPool,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "100spam"),
+		sdk.NewAttribute(disttypes.AttributeKeyDestination, disttypes.AttributeValueDestinationBurn),
+	)}, events)
+
+	// or sent to the disposal address, the fractional part staying in the pool
+	disposal := sdk.AccAddress("disposal")
+	before, moduleBalance = pool(), balance
+	res, err = msgServer.SweepCommunityPoolDenoms(ctx, disttypes.NewMsgSweepCommunityPoolDenoms(authority, []string{"junk", "atom"}, disposal.String()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("junk", 7)), res.Amount)
+	require.Equal(t, before.Sub(sdk.NewDecCoinsFromCoins(res.Amount...)), pool())
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("0.999999999999999999")),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("1000.75")),
+	}, pool())
+	require.Equal(t, moduleBalance.Sub(res.Amount...), balance)
+	require.NoError(t, k.CheckCommunityPoolBacking(ctx))
+
+	// nothing is left to sweep
+	_, err = msgServer.SweepCommunityPoolDenoms(ctx, disttypes.NewMsgSweepCommunityPoolDenoms(authority, []string{"spam", "atom"}, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// validatorFixture is a validator with 10% commission and three delegations:
+// its self-delegation of 100 power and two of 50 power.
+type validatorFixture struct {
+	*distrtestutil.KeeperFixture
+
+	val      *stakingtypes.Validator
+	valAddr  sdk.ValAddress
+	delAddrs []sdk.AccAddress
+}
+
+func newValidatorFixture(t *testing.T, params disttypes.Params) *validatorFixture {
+	t.Helper()
+
+	f := &validatorFixture{
+		KeeperFixture: distrtestutil.NewKeeperFixture(t, params),
+		valAddr:       sdk.ValAddress(valConsAddr0),
+	}
+	f.val = f.CreateValidator(t, valConsPk0, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction), math.LegacyNewDecWithPrec(1, 1))
+	f.delAddrs = append(f.delAddrs, sdk.AccAddress(f.valAddr))
+
+	for _, delAddr := range []sdk.AccAddress{sdk.AccAddress(valConsAddr1), sdk.AccAddress(valConsAddr2)} {
+		f.delegate(t, delAddr, sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction))
+		f.delAddrs = append(f.delAddrs, delAddr)
+	}
+
+	return f
+}
+
+func (f *validatorFixture) delegate(t *testing.T, delAddr sdk.AccAddress, amount math.Int) {
+	t.Helper()
+	f.Delegate(t, delAddr, f.valAddr, amount)
+}
+
+// delegation returns the delegation of the delegator to the validator.
+func (f *validatorFixture) delegation(delAddr sdk.AccAddress) (stakingtypes.Delegation, bool) {
+	del, ok := f.Delegations[distrtestutil.DelegationKey(delAddr, f.valAddr)]
+	return del, ok
+}
+
+// allocateBlock allocates the tokens to the validator in a new block, 22.5% of
+// them accrue to each of the delegators other than the validator.
+func (f *validatorFixture) allocateBlock(t *testing.T, tokens sdk.DecCoins) {
+	t.Helper()
+
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, tokens))
+}
+
+// rewards returns the rewards of the delegations, in the order of delAddrs.
+func (f *validatorFixture) rewards(t *testing.T) []sdk.DecCoins {
+	t.Helper()
+
+	querier := keeper.NewQuerier(f.Keeper)
+	rewards := make([]sdk.DecCoins, len(f.delAddrs))
+	for i, delAddr := range f.delAddrs {
+		res, err := querier.DelegationRewards(f.Ctx, &disttypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: f.valAddr.String(),
+		})
+		require.NoError(t, err)
+		rewards[i] = res.Rewards
+	}
+
+	return rewards
+}
+
+func (f *validatorFixture) withdrawAll(t *testing.T) {
+	t.Helper()
+
+	for _, delAddr := range f.delAddrs {
+		_, err := f.Keeper.WithdrawDelegationRewards(f.Ctx, delAddr, f.valAddr)
+		require.NoError(t, err)
+	}
+}
+
+func (f *validatorFixture) withdrawEvents() []sdk.Event {
+	var events []sdk.Event
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeWithdrawRewards {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func (f *validatorFixture) outstanding(t *testing.T) sdk.DecCoins {
+	t.Helper()
+
+	outstanding, err := f.Keeper.GetValidatorOutstandingRewardsCoins(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	return outstanding
+}
+
+func (f *validatorFixture) communityPool(t *testing.T) sdk.DecCoins {
+	t.Helper()
+
+	feePool, err := f.Keeper.FeePool.Get(f.Ctx)
+	require.NoError(t, err)
+	return feePool.CommunityPool
+}
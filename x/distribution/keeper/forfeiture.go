@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorForfeitedRewards returns the rewards of a validator forfeited to
+// the community pool while it had no tokens. Empty forfeited rewards are
+// returned if the validator never forfeited any.
+func (k Keeper) GetValidatorForfeitedRewards(ctx context.Context, val sdk.ValAddress) (types.ValidatorForfeitedRewards, error) {
+	forfeited, err := k.ValidatorForfeitedRewards.Get(ctx, val)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorForfeitedRewards{}, err
+	}
+
+	return forfeited, nil
+}
+
+// recordRewardsForfeiture records the current rewards of a validator without
+// tokens, which were sent to the community pool as they cannot be attributed
+// to its delegators. Only the last MaxRecentRewardsForfeitures forfeitures are
+// kept along with the cumulative amount.
+func (k Keeper) recordRewardsForfeiture(ctx context.Context, val stakingtypes.ValidatorI, valAddr sdk.ValAddress, rewards sdk.DecCoins) error {
+	if rewards.IsZero() {
+		return nil
+	}
+
+	forfeited, err := k.GetValidatorForfeitedRewards(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	forfeited.Forfeited = forfeited.Forfeited.Add(rewards...)
+	forfeited.Recent = append(forfeited.Recent, types.RewardsForfeiture{
+		Height: sdkCtx.BlockHeight(),
+		Amount: rewards,
+	})
+	if n := len(forfeited.Recent); n > types.MaxRecentRewardsForfeitures {
+		forfeited.Recent = forfeited.Recent[n-types.MaxRecentRewardsForfeitures:]
+	}
+
+	if err := k.ValidatorForfeitedRewards.Set(ctx, valAddr, forfeited); err != nil {
+		return err
+	}
+
+	// delegations left on a validator without tokens have been slashed to zero
+	condition := types.AttributeValueConditionNoTokens
+	if val.GetDelegatorShares().IsPositive() {
+		condition = types.AttributeValueConditionSlashedToZero
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardsForfeited,
+			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyCondition, condition),
+		),
+	)
+
+	return nil
+}
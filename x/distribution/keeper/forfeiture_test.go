@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type forfeitureFixture struct {
+	*distrtestutil.KeeperFixture
+	val     *stakingtypes.Validator
+	valAddr sdk.ValAddress
+}
+
+// newForfeitureFixture returns a keeper with a validator with 50% commission
+// and a single self delegation.
+func newForfeitureFixture(t *testing.T) *forfeitureFixture {
+	t.Helper()
+
+	f := &forfeitureFixture{
+		KeeperFixture: distrtestutil.NewKeeperFixture(t, disttypes.DefaultParams()),
+		valAddr:       sdk.ValAddress(valConsAddr0),
+	}
+	f.val = f.CreateValidator(t, valConsPk0, math.NewInt(1000), math.LegacyNewDecWithPrec(5, 1))
+
+	return f
+}
+
+func (f *forfeitureFixture) allocate(t *testing.T, amount int64) {
+	t.Helper()
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(amount))}
+	require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, tokens))
+}
+
+// slashToZero slashes all the tokens of the validator, keeping its delegations.
+func (f *forfeitureFixture) slashToZero(t *testing.T) {
+	t.Helper()
+	require.NoError(t, f.Keeper.Hooks().BeforeValidatorSlashed(f.Ctx, f.valAddr, math.LegacyOneDec()))
+	f.val.Tokens = math.ZeroInt()
+}
+
+func TestRewardsForfeitedAfterSlashToZero(t *testing.T) {
+	f := newForfeitureFixture(t)
+
+	// the rewards allocated before the slash are owed to the delegator
+	f.Ctx = f.Ctx.WithBlockHeight(2)
+	f.allocate(t, 100)
+	f.Ctx = f.Ctx.WithBlockHeight(3)
+	f.slashToZero(t)
+
+	// the rewards allocated to the validator without tokens cannot be
+	// attributed to the delegator anymore
+	f.allocate(t, 20)
+
+	f.Ctx = f.Ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	rewards, err := f.Keeper.WithdrawDelegationRewards(f.Ctx, sdk.AccAddress(f.valAddr), f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), rewards)
+
+	forfeited := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(10))}
+	res, err := keeper.NewQuerier(f.Keeper).ValidatorForfeitedRewards(f.Ctx, &disttypes.QueryValidatorForfeitedRewardsRequest{
+		ValidatorAddress: f.valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, disttypes.ValidatorForfeitedRewards{
+		Forfeited: forfeited,
+		Recent:    []disttypes.RewardsForfeiture{{Height: 4, Amount: forfeited}},
+	}, res.ForfeitedRewards)
+
+	var found bool
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type != disttypes.EventTypeRewardsForfeited {
+			continue
+		}
+		found = true
+		require.Equal(t, []string{f.valAddr.String(), forfeited.String(), disttypes.AttributeValueConditionSlashedToZero},
+			[]string{event.Attributes[0].Value, event.Attributes[1].Value, event.Attributes[2].Value})
+	}
+	require.True(t, found, "rewards_forfeited event not emitted")
+
+	// the community pool received exactly the forfeited rewards, and the
+	// commission is all that is left outstanding of the 120 allocated
+	feePool, err := f.Keeper.FeePool.Get(f.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, forfeited, feePool.CommunityPool)
+
+	commission, err := f.Keeper.GetValidatorAccumulatedCommission(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(60))}, commission.Commission)
+	outstanding, err := f.Keeper.GetValidatorOutstandingRewards(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, commission.Commission, outstanding.Rewards)
+
+	// nothing is recorded while there are no rewards to forfeit
+	_, err = f.Keeper.IncrementValidatorPeriod(f.Ctx, f.val)
+	require.NoError(t, err)
+	stored, err := f.Keeper.GetValidatorForfeitedRewards(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, res.ForfeitedRewards, stored)
+}
+
+func TestRecentRewardsForfeituresBounded(t *testing.T) {
+	f := newForfeitureFixture(t)
+	f.slashToZero(t)
+
+	for height := int64(2); height < 2+disttypes.MaxRecentRewardsForfeitures+2; height++ {
+		f.Ctx = f.Ctx.WithBlockHeight(height)
+		f.allocate(t, 2)
+		_, err := f.Keeper.IncrementValidatorPeriod(f.Ctx, f.val)
+		require.NoError(t, err)
+	}
+
+	forfeited, err := f.Keeper.GetValidatorForfeitedRewards(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(disttypes.MaxRecentRewardsForfeitures+2))}, forfeited.Forfeited)
+	require.Len(t, forfeited.Recent, disttypes.MaxRecentRewardsForfeitures)
+	require.Equal(t, int64(4), forfeited.Recent[0].Height)
+	require.Equal(t, f.Ctx.BlockHeight(), forfeited.Recent[disttypes.MaxRecentRewardsForfeitures-1].Height)
+	require.NoError(t, forfeited.Validate())
+}
+
+func TestRewardsForfeitedGenesis(t *testing.T) {
+	f := newForfeitureFixture(t)
+	f.slashToZero(t)
+	f.Ctx = f.Ctx.WithBlockHeight(2)
+	f.allocate(t, 20)
+	_, err := f.Keeper.IncrementValidatorPeriod(f.Ctx, f.val)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Keeper.SetPreviousProposerConsAddr(f.Ctx, valConsAddr0))
+	genState := f.Keeper.ExportGenesis(f.Ctx)
+	require.Len(t, genState.ValidatorForfeitedRewards, 1)
+	require.NoError(t, disttypes.ValidateGenesis(genState))
+
+	g := newForfeitureFixture(t)
+	g.AccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), disttypes.ModuleName).Return(distrAcc).AnyTimes()
+	g.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)))
+	g.Keeper.InitGenesis(g.Ctx, *genState)
+	require.Equal(t, genState.ValidatorForfeitedRewards, g.Keeper.ExportGenesis(g.Ctx).ValidatorForfeitedRewards)
+
+	// out of order forfeitures are rejected
+	genState.ValidatorForfeitedRewards[0].ForfeitedRewards.Recent = append(
+		genState.ValidatorForfeitedRewards[0].ForfeitedRewards.Recent,
+		disttypes.RewardsForfeiture{Height: 1},
+	)
+	require.ErrorContains(t, disttypes.ValidateGenesis(genState), "precedes the previous one")
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -115,6 +117,124 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 	}
 
+	for _, rec := range data.ValidatorRewardDistributionStats {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorRewardDistributionStats.Set(ctx, valAddr, rec.Stats)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rec := range data.ValidatorDustStats {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorDustStats.Set(ctx, valAddr, rec.DustStats)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rec := range data.ValidatorForfeitedRewards {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorForfeitedRewards.Set(ctx, valAddr, rec.ForfeitedRewards)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if !data.CommunityPoolRemainderAccrued.IsZero() {
+		err := k.CommunityPoolRemainderAccrued.Set(ctx, types.CommunityPoolRemainderAccrued{Remainder: data.CommunityPoolRemainderAccrued})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rec := range data.WithdrawOperators {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(rec.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		operatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(rec.OperatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.WithdrawOperators.Set(ctx, delegatorAddress, operatorAddress)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// the accrued rewards are part of the outstanding rewards of the validators
+	accrued := make(map[string]sdk.DecCoins)
+	for _, rec := range data.DelegatorAccruedRewards {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(rec.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.DelegatorAccruedRewards.Set(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delegatorAddress)), rec.AccruedRewards)
+		if err != nil {
+			panic(err)
+		}
+
+		total := accrued[rec.ValidatorAddress].Add(rec.AccruedRewards.Rewards...)
+		accrued[rec.ValidatorAddress] = total
+
+		outstanding, err := k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+		if err != nil {
+			panic(err)
+		}
+		if _, hasNeg := outstanding.SafeSub(total); hasNeg {
+			panic(fmt.Sprintf("accrued rewards of validator %s exceed its outstanding rewards: %s > %s", rec.ValidatorAddress, total, outstanding))
+		}
+	}
+
+	var nextSpendID uint64
+	for _, spend := range data.CommunityPoolSpendHistory {
+		if err := k.CommunityPoolSpendHistory.Set(ctx, collections.Join(spend.Height, spend.Id), spend); err != nil {
+			panic(err)
+		}
+		nextSpendID = max(nextSpendID, spend.Id+1)
+	}
+	if err := k.CommunityPoolSpendSequence.Set(ctx, nextSpendID); err != nil {
+		panic(err)
+	}
+
+	var nextAdjustmentID uint64
+	for _, adjustment := range data.RewardAdjustments {
+		if err := k.RewardAdjustments.Set(ctx, adjustment.Id, adjustment); err != nil {
+			panic(err)
+		}
+		nextAdjustmentID = max(nextAdjustmentID, adjustment.Id+1)
+	}
+	if err := k.RewardAdjustmentSequence.Set(ctx, nextAdjustmentID); err != nil {
+		panic(err)
+	}
+
+	// the withheld commission is held by the module until it is resolved
+	for _, rec := range data.ValidatorCommissionWithholdings {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorCommissionWithholdings.Set(ctx, valAddr, rec.Withholding)
+		if err != nil {
+			panic(err)
+		}
+		moduleHoldings = moduleHoldings.Add(sdk.NewDecCoinsFromCoins(rec.Withholding.Amount...)...)
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -182,9 +302,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
+	// the records are exported as if the pending reward denom evictions were
+	// complete
+	evicting := make(map[string][]string)
+	err = k.RewardDenomEvictions.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, string], _ []byte) (stop bool, err error) {
+		evicting[string(key.K1())] = append(evicting[string(key.K1())], key.K2())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	his := make([]types.ValidatorHistoricalRewardsRecord, 0)
 	k.IterateValidatorHistoricalRewards(ctx,
 		func(val sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) (stop bool) {
+			for _, denom := range evicting[string(val)] {
+				rewards.CumulativeRewardRatio = withoutDenom(rewards.CumulativeRewardRatio, denom)
+			}
 			his = append(his, types.ValidatorHistoricalRewardsRecord{
 				ValidatorAddress: val.String(),
 				Period:           period,
@@ -230,5 +364,135 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	stats := make([]types.ValidatorRewardDistributionStatsRecord, 0)
+	err = k.ValidatorRewardDistributionStats.Walk(ctx, nil,
+		func(val sdk.ValAddress, valStats types.ValidatorRewardDistributionStats) (stop bool, err error) {
+			stats = append(stats, types.ValidatorRewardDistributionStatsRecord{
+				ValidatorAddress: val.String(),
+				Stats:            valStats,
+			})
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// flush the dust of the current block so that it is part of the export
+	if err := k.FlushValidatorDust(ctx); err != nil {
+		panic(err)
+	}
+
+	dust := make([]types.ValidatorDustStatsRecord, 0)
+	err = k.ValidatorDustStats.Walk(ctx, nil,
+		func(val sdk.ValAddress, dustStats types.ValidatorDustStats) (stop bool, err error) {
+			dust = append(dust, types.ValidatorDustStatsRecord{
+				ValidatorAddress: val.String(),
+				DustStats:        dustStats,
+			})
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	accrued := make([]types.DelegatorAccruedRewardsRecord, 0)
+	err = k.DelegatorAccruedRewards.Walk(ctx, nil,
+		func(key collections.Pair[sdk.ValAddress, sdk.AccAddress], rewards types.DelegatorAccruedRewards) (stop bool, err error) {
+			if denoms := evicting[string(key.K1())]; len(denoms) > 0 {
+				for _, denom := range denoms {
+					rewards.Rewards = withoutDenom(rewards.Rewards, denom)
+				}
+				if rewards.Rewards.IsZero() {
+					return false, nil
+				}
+			}
+			accrued = append(accrued, types.DelegatorAccruedRewardsRecord{
+				DelegatorAddress: key.K2().String(),
+				ValidatorAddress: key.K1().String(),
+				AccruedRewards:   rewards,
+			})
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	spends := make([]types.CommunityPoolSpendRecord, 0)
+	err = k.CommunityPoolSpendHistory.Walk(ctx, nil,
+		func(_ collections.Pair[int64, uint64], spend types.CommunityPoolSpendRecord) (stop bool, err error) {
+			spends = append(spends, spend)
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	withholdings := make([]types.ValidatorCommissionWithholdingRecord, 0)
+	err = k.ValidatorCommissionWithholdings.Walk(ctx, nil,
+		func(val sdk.ValAddress, withholding types.ValidatorCommissionWithholding) (stop bool, err error) {
+			withholdings = append(withholdings, types.ValidatorCommissionWithholdingRecord{
+				ValidatorAddress: val.String(),
+				Withholding:      withholding,
+			})
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	forfeited := make([]types.ValidatorForfeitedRewardsRecord, 0)
+	err = k.ValidatorForfeitedRewards.Walk(ctx, nil,
+		func(val sdk.ValAddress, forfeitedRewards types.ValidatorForfeitedRewards) (stop bool, err error) {
+			forfeited = append(forfeited, types.ValidatorForfeitedRewardsRecord{
+				ValidatorAddress: val.String(),
+				ForfeitedRewards: forfeitedRewards,
+			})
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// flush the remainder of the current block so that it is part of the export
+	if err := k.FlushCommunityPoolRemainder(ctx); err != nil {
+		panic(err)
+	}
+
+	remainder, err := k.GetCommunityPoolRemainderAccrued(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	operators := make([]types.WithdrawOperatorRecord, 0)
+	err = k.WithdrawOperators.Walk(ctx, nil,
+		func(del, operator sdk.AccAddress) (stop bool, err error) {
+			operators = append(operators, types.WithdrawOperatorRecord{
+				DelegatorAddress: del.String(),
+				OperatorAddress:  operator.String(),
+			})
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	adjustments := make([]types.RewardAdjustmentRecord, 0)
+	err = k.RewardAdjustments.Walk(ctx, nil,
+		func(_ uint64, adjustment types.RewardAdjustmentRecord) (stop bool, err error) {
+			adjustments = append(adjustments, adjustment)
+			return false, nil
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, stats, dust, accrued, spends, withholdings, forfeited, remainder, operators, adjustments)
 }
@@ -6,12 +6,15 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -156,7 +159,9 @@ func (k Querier) ValidatorCommission(ctx context.Context, req *types.QueryValida
 		return nil, err
 	}
 
-	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
+	withdrawable, _ := commission.Commission.TruncateDecimal()
+
+	return &types.QueryValidatorCommissionResponse{Commission: commission, Withdrawable: withdrawable}, nil
 }
 
 // ValidatorSlashes queries slash events of a validator
@@ -253,7 +258,12 @@ func (k Querier) DelegationRewards(ctx context.Context, req *types.QueryDelegati
 		return nil, err
 	}
 
-	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
+	quotes, quoteTotal, err := k.quoteRewards(ctx, rewards, req.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationRewardsResponse{Rewards: rewards, Quotes: quotes, QuoteTotal: quoteTotal}, nil
 }
 
 // DelegationTotalRewards the total rewards accrued by each validator
@@ -274,27 +284,32 @@ func (k Querier) DelegationTotalRewards(ctx context.Context, req *types.QueryDel
 		return nil, err
 	}
 
+	var iterErr error
 	err = k.stakingKeeper.IterateDelegations(
 		ctx, delAdr,
 		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
 			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
 			if err != nil {
-				panic(err)
+				iterErr = err
+				return true
 			}
 
 			val, err := k.stakingKeeper.Validator(ctx, valAddr)
 			if err != nil {
-				panic(err)
+				iterErr = err
+				return true
 			}
 
 			endingPeriod, err := k.IncrementValidatorPeriod(ctx, val)
 			if err != nil {
-				panic(err)
+				iterErr = err
+				return true
 			}
 
 			delReward, err := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 			if err != nil {
-				panic(err)
+				iterErr = err
+				return true
 			}
 
 			delRewards = append(delRewards, types.NewDelegationDelegatorReward(del.GetValidatorAddr(), delReward))
@@ -305,8 +320,50 @@ func (k Querier) DelegationTotalRewards(ctx context.Context, req *types.QueryDel
 	if err != nil {
 		return nil, err
 	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	quotes, quoteTotal, err := k.quoteRewards(ctx, total, req.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total, Quotes: quotes, QuoteTotal: quoteTotal}, nil
+}
+
+// quoteRewards values the rewards in the quote denom with the price source. A
+// denom without a price is flagged instead of failing the query, and left out
+// of the total. Nothing is returned if no quote denom is requested or no price
+// source is configured.
+func (k Querier) quoteRewards(ctx context.Context, rewards sdk.DecCoins, quoteDenom string) ([]types.RewardQuote, *sdk.DecCoin, error) {
+	if quoteDenom == "" || k.priceSource == nil {
+		return nil, nil, nil
+	}
+
+	if err := sdk.ValidateDenom(quoteDenom); err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	quotes := make([]types.RewardQuote, 0, len(rewards))
+	total := sdk.NewDecCoin(quoteDenom, math.ZeroInt())
+	for _, reward := range rewards {
+		price := math.LegacyOneDec()
+		if reward.Denom != quoteDenom {
+			var err error
+			price, err = k.priceSource.Price(ctx, reward.Denom, quoteDenom)
+			if err != nil || price.IsNil() || price.IsNegative() {
+				quotes = append(quotes, types.RewardQuote{Denom: reward.Denom, Value: math.LegacyZeroDec(), PriceUnavailable: true})
+				continue
+			}
+		}
+
+		value := reward.Amount.Mul(price)
+		quotes = append(quotes, types.RewardQuote{Denom: reward.Denom, Value: value})
+		total.Amount = total.Amount.Add(value)
+	}
 
-	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
+	return quotes, &total, nil
 }
 
 // DelegatorValidators queries the validators list of a delegator
@@ -374,3 +431,363 @@ func (k Querier) CommunityPool(ctx context.Context, _ *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool.CommunityPool}, nil
 }
+
+// ValidatorRewardDistributionStats queries the cumulative self-bond rewards,
+// external delegator rewards and commission withdrawn from a validator
+func (k Querier) ValidatorRewardDistributionStats(ctx context.Context, req *types.QueryValidatorRewardDistributionStatsRequest) (*types.QueryValidatorRewardDistributionStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := k.GetValidatorRewardDistributionStats(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorRewardDistributionStatsResponse{Stats: stats}, nil
+}
+
+// ValidatorDustStats queries the cumulative dust sent to the community pool on
+// withdrawals from a validator
+func (k Querier) ValidatorDustStats(ctx context.Context, req *types.QueryValidatorDustStatsRequest) (*types.QueryValidatorDustStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := k.GetValidatorDustStats(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorDustStatsResponse{Stats: stats}, nil
+}
+
+// DelegationAccruedRewards queries the rewards of a delegation settled by reward
+// checkpoints and not withdrawn yet
+func (k Querier) DelegationAccruedRewards(ctx context.Context, req *types.QueryDelegationAccruedRewardsRequest) (*types.QueryDelegationAccruedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.GetDelegatorAccruedRewards(ctx, valAdr, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationAccruedRewardsResponse{Rewards: rewards}, nil
+}
+
+// CommunityPoolSpendHistory queries the community pool spends executed within
+// the retention period of the spend history
+func (k Querier) CommunityPoolSpendHistory(ctx context.Context, req *types.QueryCommunityPoolSpendHistoryRequest) (*types.QueryCommunityPoolSpendHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "min height greater than max height (%d > %d)", req.MinHeight, req.MaxHeight)
+	}
+
+	spends, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.CommunityPoolSpendHistory, req.Pagination,
+		func(key collections.Pair[int64, uint64], _ types.CommunityPoolSpendRecord) (bool, error) {
+			height := key.K1()
+			return height >= req.MinHeight && (req.MaxHeight == 0 || height <= req.MaxHeight), nil
+		}, func(_ collections.Pair[int64, uint64], record types.CommunityPoolSpendRecord) (types.CommunityPoolSpendRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommunityPoolSpendHistoryResponse{Spends: spends, Pagination: pageRes}, nil
+}
+
+// DelegationRewardsAtHeight queries the rewards accrued by a delegation as of a
+// historical height. The rewards are calculated on a branch of the state the
+// query is executed against, which must be the state of the requested height.
+func (k Querier) DelegationRewardsAtHeight(ctx context.Context, req *types.QueryDelegationRewardsAtHeightRequest) (*types.QueryDelegationRewardsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	// the historical state is selected by the query context, the pruned
+	// heights are rejected when the query context is created
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req.Height != sdkCtx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"requested height %d does not match the height %d of the queried state, set the %s header to the requested height",
+			req.Height, sdkCtx.BlockHeight(), grpctypes.GRPCBlockHeightHeader)
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the validator period is incremented to calculate the rewards, never
+	// write it back
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	val, err := k.stakingKeeper.Validator(cacheCtx, valAdr)
+	switch {
+	case errors.IsOf(err, stakingtypes.ErrNoValidatorFound) || (err == nil && val == nil):
+		return nil, errors.Wrapf(types.ErrNoValidatorExists, "%s at height %d", req.ValidatorAddress, req.Height)
+	case err != nil:
+		return nil, err
+	}
+
+	del, err := k.stakingKeeper.Delegation(cacheCtx, delAdr, valAdr)
+	switch {
+	case errors.IsOf(err, stakingtypes.ErrNoDelegation) || (err == nil && del == nil):
+		return nil, errors.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s at height %d", req.DelegatorAddress, req.ValidatorAddress, req.Height)
+	case err != nil:
+		return nil, err
+	}
+
+	found, err := k.HasDelegatorStartingInfo(cacheCtx, valAdr, delAdr)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s at height %d", req.DelegatorAddress, req.ValidatorAddress, req.Height)
+	}
+
+	startingInfo, err := k.GetDelegatorStartingInfo(cacheCtx, valAdr, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	endingPeriod, err := k.IncrementValidatorPeriod(cacheCtx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := k.CalculateDelegationRewards(cacheCtx, val, del, endingPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationRewardsAtHeightResponse{
+		Rewards:        rewards,
+		StartingPeriod: startingInfo.PreviousPeriod,
+		EndingPeriod:   endingPeriod,
+	}, nil
+}
+
+// ValidatorCommissionWithholding queries the commission withholding of a
+// validator and the commission withheld so far
+func (k Querier) ValidatorCommissionWithholding(ctx context.Context, req *types.QueryValidatorCommissionWithholdingRequest) (*types.QueryValidatorCommissionWithholdingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	withholding, err := k.GetValidatorCommissionWithholding(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorCommissionWithholdingResponse{Withholding: withholding}, nil
+}
+
+// CommunityPoolSpendable queries the integer coins of the community pool which
+// can be spent
+func (k Querier) CommunityPoolSpendable(ctx context.Context, _ *types.QueryCommunityPoolSpendableRequest) (*types.QueryCommunityPoolSpendableResponse, error) {
+	if k.HasExternalCommunityPool() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "external community pool is enabled - use the CommunityPool query exposed by the external community pool")
+	}
+
+	spendable, total, remainder, err := k.Keeper.CommunityPoolSpendable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCommunityPoolSpendableResponse{Spendable: spendable, Total: total, Remainder: remainder}, nil
+}
+
+// CommunityPoolRemainderAccrued queries the cumulative truncation remainders of
+// the withdrawals added to the community pool
+func (k Querier) CommunityPoolRemainderAccrued(ctx context.Context, _ *types.QueryCommunityPoolRemainderAccruedRequest) (*types.QueryCommunityPoolRemainderAccruedResponse, error) {
+	remainder, err := k.GetCommunityPoolRemainderAccrued(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCommunityPoolRemainderAccruedResponse{Remainder: remainder}, nil
+}
+
+// WithdrawOperator queries the withdraw operator registered by a delegator
+func (k Querier) WithdrawOperator(ctx context.Context, req *types.QueryWithdrawOperatorRequest) (*types.QueryWithdrawOperatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := k.GetWithdrawOperator(ctx, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawOperatorResponse{OperatorAddress: operator.String()}, nil
+}
+
+// RewardAdjustments queries the reward adjustments applied by the module
+// authority
+func (k Querier) RewardAdjustments(ctx context.Context, req *types.QueryRewardAdjustmentsRequest) (*types.QueryRewardAdjustmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	adjustments, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.RewardAdjustments, req.Pagination,
+		func(_ uint64, record types.RewardAdjustmentRecord) (types.RewardAdjustmentRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardAdjustmentsResponse{Adjustments: adjustments, Pagination: pageRes}, nil
+}
+
+// ValidatorForfeitedRewards queries the rewards of a validator forfeited to the
+// community pool while it had no tokens
+func (k Querier) ValidatorForfeitedRewards(ctx context.Context, req *types.QueryValidatorForfeitedRewardsRequest) (*types.QueryValidatorForfeitedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	forfeited, err := k.GetValidatorForfeitedRewards(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorForfeitedRewardsResponse{ForfeitedRewards: forfeited}, nil
+}
+
+// BatchDelegationRewards queries the rewards accrued by several delegations at
+// the height of the queried state
+func (k Querier) BatchDelegationRewards(ctx context.Context, req *types.QueryBatchDelegationRewardsRequest) (*types.QueryBatchDelegationRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Pairs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty delegation pairs")
+	}
+
+	if len(req.Pairs) > types.MaxBatchDelegationRewardsPairs {
+		return nil, status.Errorf(codes.InvalidArgument, "too many delegation pairs: %d > %d", len(req.Pairs), types.MaxBatchDelegationRewardsPairs)
+	}
+
+	seen := make(map[string]struct{}, len(req.Pairs))
+	delegations := make([]batchDelegation, 0, len(req.Pairs))
+	for i, pair := range req.Pairs {
+		delAdr, err := k.authKeeper.AddressCodec().StringToBytes(pair.DelegatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address at index %d: %s", i, err)
+		}
+
+		valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(pair.ValidatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address at index %d: %s", i, err)
+		}
+
+		key := string(types.GetDelegatorStartingInfoKey(valAdr, delAdr))
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		delegations = append(delegations, batchDelegation{pair: pair, val: valAdr, del: delAdr})
+	}
+
+	results, err := k.calculateBatchDelegationRewards(ctx, delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBatchDelegationRewardsResponse{
+		Results: results,
+		Height:  sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	}, nil
+}
@@ -0,0 +1,453 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// mapPriceSource prices a base denom in a quote denom from the price keyed by
+// base/quote.
+type mapPriceSource map[string]math.LegacyDec
+
+func (s mapPriceSource) Price(_ context.Context, baseDenom, quoteDenom string) (math.LegacyDec, error) {
+	price, ok := s[baseDenom+"/"+quoteDenom]
+	if !ok {
+		return math.LegacyDec{}, errors.New("no price")
+	}
+	return price, nil
+}
+
+// newRewardsQuerier returns a querier for a single delegation with pending
+// rewards of 10atom and 100stake.
+func newRewardsQuerier(t *testing.T, opts ...keeper.InitOption) (sdk.Context, keeper.Querier, sdk.AccAddress, sdk.ValAddress) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	delAddr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(1000))
+	require.NoError(t, err)
+	del := stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(del, nil).AnyTimes()
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), delAddr, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) error {
+			fn(0, del)
+			return nil
+		},
+	).AnyTimes()
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+		opts...,
+	)
+
+	require.NoError(t, k.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, k.Params.Set(ctx, disttypes.DefaultParams()))
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, k, delAddr, valAddr))
+
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{
+		sdk.NewDecCoin("atom", math.NewInt(10)),
+		sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100)),
+	}))
+
+	return ctx, keeper.NewQuerier(k), delAddr, valAddr
+}
+
+func TestDelegationRewardsQuote(t *testing.T) {
+	prices := mapPriceSource{
+		"atom/usd":   math.LegacyMustNewDecFromStr("2.5"),
+		"stake/usd":  math.LegacyMustNewDecFromStr("0.1"),
+		"atom/stake": math.LegacyNewDec(4),
+	}
+	quote := func(denom, value string) disttypes.RewardQuote {
+		return disttypes.RewardQuote{Denom: denom, Value: math.LegacyMustNewDecFromStr(value)}
+	}
+	unavailable := func(denom string) disttypes.RewardQuote {
+		return disttypes.RewardQuote{Denom: denom, Value: math.LegacyZeroDec(), PriceUnavailable: true}
+	}
+	total := func(denom string, amount int64) *sdk.DecCoin {
+		coin := sdk.NewDecCoin(denom, math.NewInt(amount))
+		return &coin
+	}
+
+	specs := map[string]struct {
+		priceSource disttypes.PriceSource
+		quoteDenom  string
+		expQuotes   []disttypes.RewardQuote
+		expTotal    *sdk.DecCoin
+		expErr      codes.Code
+	}{
+		"all denoms priced": {
+			priceSource: prices,
+			quoteDenom:  "usd",
+			expQuotes:   []disttypes.RewardQuote{quote("atom", "25"), quote(sdk.DefaultBondDenom, "10")},
+			expTotal:    total("usd", 35),
+		},
+		"quote denom among the rewards": {
+			priceSource: prices,
+			quoteDenom:  sdk.DefaultBondDenom,
+			expQuotes:   []disttypes.RewardQuote{quote("atom", "40"), quote(sdk.DefaultBondDenom, "100")},
+			expTotal:    total(sdk.DefaultBondDenom, 140),
+		},
+		"partially priced": {
+			priceSource: mapPriceSource{"atom/usd": math.LegacyMustNewDecFromStr("2.5")},
+			quoteDenom:  "usd",
+			expQuotes:   []disttypes.RewardQuote{quote("atom", "25"), unavailable(sdk.DefaultBondDenom)},
+			expTotal:    total("usd", 25),
+		},
+		"no price at all": {
+			priceSource: mapPriceSource{},
+			quoteDenom:  "usd",
+			expQuotes:   []disttypes.RewardQuote{unavailable("atom"), unavailable(sdk.DefaultBondDenom)},
+			expTotal:    total("usd", 0),
+		},
+		"no quote denom": {
+			priceSource: prices,
+		},
+		"no price source": {
+			quoteDenom: "usd",
+		},
+		"invalid quote denom": {
+			priceSource: prices,
+			quoteDenom:  "!",
+			expErr:      codes.InvalidArgument,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var opts []keeper.InitOption
+			if spec.priceSource != nil {
+				opts = append(opts, keeper.WithPriceSource(spec.priceSource))
+			}
+			ctx, querier, delAddr, valAddr := newRewardsQuerier(t, opts...)
+			expRewards := sdk.DecCoins{
+				sdk.NewDecCoin("atom", math.NewInt(10)),
+				sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100)),
+			}
+
+			res, err := querier.DelegationRewards(ctx, &disttypes.QueryDelegationRewardsRequest{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				QuoteDenom:       spec.quoteDenom,
+			})
+			if spec.expErr != codes.OK {
+				require.Equal(t, spec.expErr, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, expRewards, res.Rewards)
+			require.Equal(t, spec.expQuotes, res.Quotes)
+			require.Equal(t, spec.expTotal, res.QuoteTotal)
+
+			totalRes, err := querier.DelegationTotalRewards(ctx, &disttypes.QueryDelegationTotalRewardsRequest{
+				DelegatorAddress: delAddr.String(),
+				QuoteDenom:       spec.quoteDenom,
+			})
+			require.NoError(t, err)
+			require.Equal(t, expRewards, totalRes.Total)
+			require.Equal(t, spec.expQuotes, totalRes.Quotes)
+			require.Equal(t, spec.expTotal, totalRes.QuoteTotal)
+		})
+	}
+}
+
+// countingStoreService counts the reads of the keys with a prefix.
+type countingStoreService struct {
+	store.KVStoreService
+	prefix []byte
+	reads  *int
+}
+
+func (s countingStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	return countingStore{KVStore: s.KVStoreService.OpenKVStore(ctx), prefix: s.prefix, reads: s.reads}
+}
+
+type countingStore struct {
+	store.KVStore
+	prefix []byte
+	reads  *int
+}
+
+func (s countingStore) Get(key []byte) ([]byte, error) {
+	if bytes.HasPrefix(key, s.prefix) {
+		*s.reads++
+	}
+	return s.KVStore.Get(key)
+}
+
+// newBatchRewardsQuerier returns a querier for a validator with three
+// delegations with pending rewards, and the number of reads of the historical
+// rewards of the validator.
+func newBatchRewardsQuerier(t *testing.T) (sdk.Context, keeper.Keeper, sdk.ValAddress, []sdk.AccAddress, *int) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	val, err := distrtestutil.CreateValidator(valConsPk0, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+	require.NoError(t, err)
+
+	delegations := make(map[string]stakingtypes.Delegation)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).DoAndReturn(
+		func(context.Context, sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+			return val, nil
+		},
+	).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).Return(nil, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), gomock.Any(), valAddr).DoAndReturn(
+		func(_ context.Context, del sdk.AccAddress, _ sdk.ValAddress) (stakingtypes.DelegationI, error) {
+			delegation, ok := delegations[del.String()]
+			if !ok {
+				return nil, stakingtypes.ErrNoDelegation
+			}
+			return delegation, nil
+		},
+	).AnyTimes()
+
+	reads := new(int)
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		countingStoreService{
+			KVStoreService: runtime.NewKVStoreService(key),
+			prefix:         disttypes.GetValidatorHistoricalRewardsPrefix(valAddr),
+			reads:          reads,
+		},
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, k.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, k.Params.Set(ctx, disttypes.DefaultParams()))
+
+	selfAddr := sdk.AccAddress(valAddr)
+	delegations[selfAddr.String()] = stakingtypes.NewDelegation(selfAddr.String(), valAddr.String(), val.DelegatorShares)
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, k, selfAddr, valAddr))
+	delAddrs := []sdk.AccAddress{selfAddr}
+
+	for _, delAddr := range []sdk.AccAddress{sdk.AccAddress(valConsAddr1), sdk.AccAddress(valConsAddr2)} {
+		_, del, err := distrtestutil.Delegate(ctx, k, delAddr, &val, sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction), nil, stakingKeeper)
+		require.NoError(t, err)
+		delegations[delAddr.String()] = del
+		require.NoError(t, k.Hooks().AfterDelegationModified(ctx, delAddr, valAddr))
+		delAddrs = append(delAddrs, delAddr)
+	}
+
+	ctx = ctx.WithBlockHeight(2)
+	require.NoError(t, k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{
+		sdk.NewDecCoin("atom", math.NewInt(10)),
+		sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(100)),
+	}))
+	*reads = 0
+
+	return ctx, k, valAddr, delAddrs, reads
+}
+
+func TestBatchDelegationRewards(t *testing.T) {
+	ctx, k, valAddr, delAddrs, _ := newBatchRewardsQuerier(t)
+	querier := keeper.NewQuerier(k)
+	unknownVal := sdk.ValAddress(valConsAddr1)
+	unknownDel := sdk.AccAddress(PKS[3].Address())
+	pair := func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) disttypes.DelegationPair {
+		return disttypes.DelegationPair{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String()}
+	}
+
+	currentBefore, err := k.GetValidatorCurrentRewards(ctx, valAddr)
+	require.NoError(t, err)
+
+	res, err := querier.BatchDelegationRewards(ctx, &disttypes.QueryBatchDelegationRewardsRequest{
+		Pairs: []disttypes.DelegationPair{
+			pair(delAddrs[1], valAddr),
+			pair(delAddrs[0], unknownVal),
+			pair(unknownDel, valAddr),
+			pair(delAddrs[0], valAddr),
+			pair(delAddrs[1], valAddr),
+			pair(delAddrs[2], valAddr),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), res.Height)
+	require.Len(t, res.Results, 5)
+
+	// the batch query never writes back the incremented period
+	currentAfter, err := k.GetValidatorCurrentRewards(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, currentBefore, currentAfter)
+
+	// the duplicate pair is queried once, in the order of the request
+	expDels := []sdk.AccAddress{delAddrs[1], delAddrs[0], unknownDel, delAddrs[0], delAddrs[2]}
+	expVals := []sdk.ValAddress{valAddr, unknownVal, valAddr, valAddr, valAddr}
+	for i, result := range res.Results {
+		require.Equal(t, expDels[i].String(), result.DelegatorAddress)
+		require.Equal(t, expVals[i].String(), result.ValidatorAddress)
+	}
+
+	// the missing validator and delegation are reported per pair
+	require.Equal(t, disttypes.ModuleName, res.Results[1].Codespace)
+	require.Equal(t, disttypes.ErrNoValidatorExists.ABCICode(), res.Results[1].Code)
+	require.Empty(t, res.Results[1].Rewards)
+	require.Equal(t, disttypes.ModuleName, res.Results[2].Codespace)
+	require.Equal(t, disttypes.ErrNoDelegationExists.ABCICode(), res.Results[2].Code)
+	require.Empty(t, res.Results[2].Rewards)
+
+	// the rewards are the ones of the single delegation queries
+	var total sdk.DecCoins
+	for _, i := range []int{0, 3, 4} {
+		result := res.Results[i]
+		require.Zero(t, result.Code, result.Log)
+		single, err := querier.DelegationRewards(ctx, &disttypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: result.DelegatorAddress,
+			ValidatorAddress: result.ValidatorAddress,
+		})
+		require.NoError(t, err)
+		require.False(t, single.Rewards.IsZero())
+		require.Equal(t, single.Rewards, result.Rewards)
+		total = total.Add(result.Rewards...)
+	}
+	outstanding, err := k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+	require.NoError(t, err)
+	commission, err := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, outstanding, total.Add(commission.Commission...))
+}
+
+func TestBatchDelegationRewardsValidation(t *testing.T) {
+	ctx, k, valAddr, delAddrs, _ := newBatchRewardsQuerier(t)
+	querier := keeper.NewQuerier(k)
+	valid := disttypes.DelegationPair{DelegatorAddress: delAddrs[0].String(), ValidatorAddress: valAddr.String()}
+
+	tooMany := make([]disttypes.DelegationPair, disttypes.MaxBatchDelegationRewardsPairs+1)
+	for i := range tooMany {
+		tooMany[i] = valid
+	}
+
+	specs := map[string]struct {
+		req    *disttypes.QueryBatchDelegationRewardsRequest
+		expErr string
+	}{
+		"nil request": {
+			expErr: "invalid request",
+		},
+		"no pairs": {
+			req:    &disttypes.QueryBatchDelegationRewardsRequest{},
+			expErr: "empty delegation pairs",
+		},
+		"too many pairs": {
+			req:    &disttypes.QueryBatchDelegationRewardsRequest{Pairs: tooMany},
+			expErr: fmt.Sprintf("too many delegation pairs: %d > %d", len(tooMany), disttypes.MaxBatchDelegationRewardsPairs),
+		},
+		"malformed delegator address": {
+			req: &disttypes.QueryBatchDelegationRewardsRequest{Pairs: []disttypes.DelegationPair{
+				valid, valid, {DelegatorAddress: "cosmos1invalid", ValidatorAddress: valAddr.String()},
+			}},
+			expErr: "invalid delegator address at index 2",
+		},
+		"malformed validator address": {
+			req: &disttypes.QueryBatchDelegationRewardsRequest{Pairs: []disttypes.DelegationPair{
+				valid, {DelegatorAddress: delAddrs[1].String(), ValidatorAddress: delAddrs[1].String()},
+			}},
+			expErr: "invalid validator address at index 1",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, err := querier.BatchDelegationRewards(ctx, spec.req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			require.ErrorContains(t, err, spec.expErr)
+		})
+	}
+
+	// the maximum number of pairs is accepted
+	res, err := querier.BatchDelegationRewards(ctx, &disttypes.QueryBatchDelegationRewardsRequest{
+		Pairs: tooMany[:disttypes.MaxBatchDelegationRewardsPairs],
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 1)
+}
+
+func TestBatchDelegationRewardsSharedReads(t *testing.T) {
+	ctx, k, valAddr, delAddrs, reads := newBatchRewardsQuerier(t)
+	querier := keeper.NewQuerier(k)
+
+	pairs := make([]disttypes.DelegationPair, len(delAddrs))
+	for i, delAddr := range delAddrs {
+		pairs[i] = disttypes.DelegationPair{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String()}
+	}
+
+	// a single delegation reads the previous period twice to increment the
+	// period of the validator, and the starting and ending periods of its
+	// rewards
+	_, err := querier.DelegationRewards(ctx, &disttypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: pairs[0].DelegatorAddress,
+		ValidatorAddress: pairs[0].ValidatorAddress,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, *reads)
+
+	// the delegations of the batch share the period increment and the ending
+	// period, and start at different periods
+	*reads = 0
+	_, err = querier.BatchDelegationRewards(ctx, &disttypes.QueryBatchDelegationRewardsRequest{Pairs: pairs})
+	require.NoError(t, err)
+	require.Equal(t, 2+1+len(pairs), *reads)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -65,16 +66,35 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 			return err
 		}
 
-		// add to validator account
+		if err := h.k.recordValidatorDust(ctx, valAddr, remainder); err != nil {
+			return err
+		}
+
+		if err := h.k.recordCommunityPoolRemainder(ctx, valAddr.String(), "", remainder); err != nil {
+			return err
+		}
+
+		// add to validator account, unless the commission is withheld
 		if !coins.IsZero() {
-			accAddr := sdk.AccAddress(valAddr)
-			withdrawAddr, err := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
+			withholding, err := h.k.GetValidatorCommissionWithholding(ctx, valAddr)
 			if err != nil {
 				return err
 			}
 
-			if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
-				return err
+			if withholding.Withheld {
+				if err := h.k.withholdCommission(ctx, valAddr, withholding, coins); err != nil {
+					return err
+				}
+			} else {
+				accAddr := sdk.AccAddress(valAddr)
+				withdrawAddr, err := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
+				if err != nil {
+					return err
+				}
+
+				if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -93,6 +113,10 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 		return err
 	}
 
+	if err := h.k.recordValidatorDust(ctx, valAddr, outstanding); err != nil {
+		return err
+	}
+
 	// delete outstanding
 	err = h.k.DeleteValidatorOutstandingRewards(ctx, valAddr)
 	if err != nil {
@@ -111,6 +135,12 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 	// clear historical rewards
 	h.k.DeleteValidatorHistoricalRewards(ctx, valAddr)
 
+	// and the reward denom evictions pending in them
+	err = h.k.RewardDenomEvictions.Clear(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, string](valAddr))
+	if err != nil {
+		return err
+	}
+
 	// clear current rewards
 	err = h.k.DeleteValidatorCurrentRewards(ctx, valAddr)
 	if err != nil {
@@ -131,8 +161,19 @@ func (h Hooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddre
 	return err
 }
 
-// BeforeDelegationSharesModified withdraws delegation rewards (which also increments period)
+// BeforeDelegationSharesModified withdraws delegation rewards (which also increments period),
+// unless the settlement can be deferred
 func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	deferred, err := h.k.canDeferSettlement(ctx, valAddr, delAddr)
+	if err != nil || deferred {
+		return err
+	}
+
+	return h.settleDelegation(ctx, delAddr, valAddr)
+}
+
+// settleDelegation withdraws the rewards of a delegation
+func (h Hooks) settleDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	val, err := h.k.stakingKeeper.Validator(ctx, valAddr)
 	if err != nil {
 		return err
@@ -143,15 +184,25 @@ func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.A
 		return err
 	}
 
-	if _, err := h.k.withdrawDelegationRewards(ctx, val, del); err != nil {
+	if _, err := h.k.withdrawDelegationRewards(ctx, val, del, true); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// AfterDelegationModified creates a new delegation period record
+// AfterDelegationModified creates a new delegation period record, or merges
+// the new stake into the current one if the settlement was deferred
 func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	deferred, err := h.k.canDeferSettlement(ctx, valAddr, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if deferred {
+		return h.k.mergeDelegationStake(ctx, valAddr, delAddr)
+	}
+
 	return h.k.initializeDelegation(ctx, valAddr, delAddr)
 }
 
@@ -172,8 +223,15 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// BeforeDelegationRemoved settles the rewards of the delegation if its
+// settlement was deferred, as it is not initialized again
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	hasInfo, err := h.k.HasDelegatorStartingInfo(ctx, valAddr, delAddr)
+	if err != nil || !hasInfo {
+		return err
+	}
+
+	return h.settleDelegation(ctx, delAddr, valAddr)
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// RegisterInvariants registers all distribution invariants
+//
+//nolint:staticcheck // the invariants are still checked by the apps wiring x/crisis
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reference-count", ReferenceCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative-commission", NonNegativeCommissionInvariant(k))
+}
+
+// ReferenceCountInvariant checks that the reference count of every historical
+// rewards record matches the number of delegations, slash events and current
+// rewards referencing its period.
+//
+//nolint:staticcheck // the invariants are still checked by the apps wiring x/crisis
+func ReferenceCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		mismatches := k.ReferenceCountMismatches(ctx, nil)
+
+		var msg strings.Builder
+		for _, mismatch := range mismatches {
+			fmt.Fprintf(&msg, "\t%s\n", mismatch)
+		}
+
+		broken := len(mismatches) != 0
+		return sdk.FormatInvariant(types.ModuleName, "reference count", fmt.Sprintf(
+			"%d historical rewards records with a mismatched reference count\n%s", len(mismatches), msg.String())), broken
+	}
+}
+
+// NonNegativeCommissionInvariant checks that the accumulated commission of
+// every validator, including the remainder left by a withdrawal, is not
+// negative.
+//
+//nolint:staticcheck // the invariants are still checked by the apps wiring x/crisis
+func NonNegativeCommissionInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   strings.Builder
+			count int
+		)
+		k.IterateValidatorAccumulatedCommissions(ctx, func(val sdk.ValAddress, commission types.ValidatorAccumulatedCommission) bool {
+			if commission.Commission.IsAnyNegative() {
+				count++
+				fmt.Fprintf(&msg, "\tvalidator %s: %s\n", val, commission.Commission)
+			}
+			return false
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "nonnegative commission", fmt.Sprintf(
+			"%d validators with a negative accumulated commission\n%s", count, msg.String())), broken
+	}
+}
+
+// ReferenceCountMismatch is a period of a validator whose historical rewards
+// reference count differs from the number of references to the period.
+type ReferenceCountMismatch struct {
+	Validator sdk.ValAddress
+	Period    uint64
+	// Expected is the number of references to the period.
+	Expected uint32
+	// Actual is the reference count of the historical rewards record.
+	Actual uint32
+	// Missing is whether the historical rewards record of the period is missing.
+	Missing bool
+}
+
+func (m ReferenceCountMismatch) String() string {
+	actual := fmt.Sprintf("%d", m.Actual)
+	if m.Missing {
+		actual = "missing"
+	}
+
+	return fmt.Sprintf("validator %s, period %d: expected %d references, got %s", m.Validator, m.Period, m.Expected, actual)
+}
+
+// ReferenceCountMismatches recomputes the reference counts of the historical
+// rewards of a validator, or of all the validators if val is nil, and returns
+// the periods whose record disagrees, sorted by validator and period.
+//
+// A period is referenced by every delegation starting after it, by the slash
+// event ending it and, for the last period, by the current rewards.
+func (k Keeper) ReferenceCountMismatches(ctx context.Context, val sdk.ValAddress) []ReferenceCountMismatch {
+	type key struct {
+		val    string
+		period uint64
+	}
+
+	expected := make(map[key]uint32)
+	include := func(v sdk.ValAddress) bool {
+		return val == nil || v.Equals(val)
+	}
+
+	k.IterateDelegatorStartingInfos(ctx, func(v sdk.ValAddress, _ sdk.AccAddress, info types.DelegatorStartingInfo) bool {
+		if include(v) {
+			expected[key{string(v), info.PreviousPeriod}]++
+		}
+		return false
+	})
+
+	k.IterateValidatorSlashEvents(ctx, func(v sdk.ValAddress, _ uint64, event types.ValidatorSlashEvent) bool {
+		if include(v) {
+			expected[key{string(v), event.ValidatorPeriod}]++
+		}
+		return false
+	})
+
+	k.IterateValidatorCurrentRewards(ctx, func(v sdk.ValAddress, rewards types.ValidatorCurrentRewards) bool {
+		if include(v) && rewards.Period > 0 {
+			expected[key{string(v), rewards.Period - 1}]++
+		}
+		return false
+	})
+
+	var mismatches []ReferenceCountMismatch
+	k.IterateValidatorHistoricalRewards(ctx, func(v sdk.ValAddress, period uint64, rewards types.ValidatorHistoricalRewards) bool {
+		if !include(v) {
+			return false
+		}
+
+		id := key{string(v), period}
+		if expected[id] != rewards.ReferenceCount {
+			mismatches = append(mismatches, ReferenceCountMismatch{
+				Validator: v,
+				Period:    period,
+				Expected:  expected[id],
+				Actual:    rewards.ReferenceCount,
+			})
+		}
+		delete(expected, id)
+		return false
+	})
+
+	// the remaining periods are referenced without a historical rewards record
+	for id, count := range expected {
+		mismatches = append(mismatches, ReferenceCountMismatch{
+			Validator: sdk.ValAddress(id.val),
+			Period:    id.period,
+			Expected:  count,
+			Missing:   true,
+		})
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool {
+		if c := bytes.Compare(mismatches[i].Validator, mismatches[j].Validator); c != 0 {
+			return c < 0
+		}
+		return mismatches[i].Period < mismatches[j].Period
+	})
+
+	return mismatches
+}
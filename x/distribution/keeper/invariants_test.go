@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// refCounts runs the refcounts debug command against the state of the
+// fixture and returns its output.
+func (f *validatorFixture) refCounts(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	load := func(*cobra.Command) (sdk.Context, keeper.Keeper, func() error, error) {
+		return f.Ctx, f.Keeper, func() error { return nil }, nil
+	}
+
+	var out bytes.Buffer
+	cmd := cli.NewRefCountsCmd(address.NewBech32Codec(sdk.Bech32PrefixValAddr), load)
+	cmd.SetArgs(args)
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestReferenceCountInvariant(t *testing.T) {
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+	invariant := keeper.ReferenceCountInvariant(f.Keeper)
+
+	// end a few periods, referenced by slash events and delegations
+	for i := 0; i < 3; i++ {
+		f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1_000)))
+		distrtestutil.SlashValidator(f.Ctx, valConsAddr0, f.Ctx.BlockHeight(), 100,
+			math.LegacyNewDecWithPrec(1, 1), f.val, &f.Keeper, f.StakingKeeper)
+		f.delegate(t, f.delAddrs[i], math.NewInt(1_000))
+	}
+
+	_, broken := invariant(f.Ctx)
+	require.False(t, broken)
+	out, err := f.refCounts(t)
+	require.NoError(t, err)
+	require.Equal(t, "no reference count mismatch\n", out)
+
+	// corrupt the reference count of the period of a delegation
+	info, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, f.delAddrs[1])
+	require.NoError(t, err)
+	historical, err := f.Keeper.GetValidatorHistoricalRewards(f.Ctx, f.valAddr, info.PreviousPeriod)
+	require.NoError(t, err)
+	expected := historical.ReferenceCount
+	historical.ReferenceCount++
+	require.NoError(t, f.Keeper.SetValidatorHistoricalRewards(f.Ctx, f.valAddr, info.PreviousPeriod, historical))
+
+	msg, broken := invariant(f.Ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, fmt.Sprintf("validator %s, period %d: expected %d references, got %d",
+		f.valAddr, info.PreviousPeriod, expected, expected+1))
+
+	out, err = f.refCounts(t)
+	require.ErrorContains(t, err, "found 1 historical rewards records with a mismatched reference count")
+	require.Equal(t, fmt.Sprintf("%s\n  period %d: -%d +%d\n", f.valAddr, info.PreviousPeriod, expected+1, expected), out)
+
+	// the command can be restricted to a single validator
+	out, err = f.refCounts(t, sdk.ValAddress(valConsAddr1).String())
+	require.NoError(t, err)
+	require.Equal(t, "no reference count mismatch\n", out)
+
+	// a referenced period without a record is reported too
+	require.NoError(t, f.Keeper.DeleteValidatorHistoricalReward(f.Ctx, f.valAddr, info.PreviousPeriod))
+	msg, broken = invariant(f.Ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, fmt.Sprintf("validator %s, period %d: expected %d references, got missing",
+		f.valAddr, info.PreviousPeriod, expected))
+
+	out, err = f.refCounts(t, f.valAddr.String())
+	require.Error(t, err)
+	require.Equal(t, fmt.Sprintf("%s\n  period %d: -missing +%d\n", f.valAddr, info.PreviousPeriod, expected), out)
+}
+
+func TestNonNegativeCommissionInvariant(t *testing.T) {
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+	invariant := keeper.NonNegativeCommissionInvariant(f.Keeper)
+
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 15)))
+	_, err := f.Keeper.WithdrawValidatorCommission(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	_, broken := invariant(f.Ctx)
+	require.False(t, broken)
+
+	// corrupt the accumulated commission of the validator
+	negative := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(-5, 1)}}
+	require.NoError(t, f.Keeper.SetValidatorAccumulatedCommission(f.Ctx, f.valAddr, disttypes.ValidatorAccumulatedCommission{Commission: negative}))
+
+	msg, broken := invariant(f.Ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "1 validators with a negative accumulated commission")
+	require.Contains(t, msg, fmt.Sprintf("validator %s: -0.500000000000000000stake", f.valAddr))
+}
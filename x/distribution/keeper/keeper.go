@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log/v2"
@@ -13,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -29,10 +31,62 @@ type Keeper struct {
 	Schema  collections.Schema
 	Params  collections.Item[types.Params]
 	FeePool collections.Item[types.FeePool]
+	// ValidatorRewardDistributionStats key: valAddr | value: cumulative reward distribution stats
+	ValidatorRewardDistributionStats collections.Map[sdk.ValAddress, types.ValidatorRewardDistributionStats]
+	// ValidatorDustStats key: valAddr | value: cumulative dust sent to the community pool
+	ValidatorDustStats collections.Map[sdk.ValAddress, types.ValidatorDustStats]
+	// DelegatorAccruedRewards key: valAddr+delAddr | value: rewards settled by reward checkpoints
+	DelegatorAccruedRewards collections.Map[collections.Pair[sdk.ValAddress, sdk.AccAddress], types.DelegatorAccruedRewards]
+	// RewardCheckpointCursor is the starting info key of the last delegation inspected for reward checkpointing
+	RewardCheckpointCursor collections.Item[[]byte]
+	// CommunityPoolSpendHistory key: height+id | value: executed community pool spend
+	CommunityPoolSpendHistory collections.Map[collections.Pair[int64, uint64], types.CommunityPoolSpendRecord]
+	// CommunityPoolSpendSequence is the id of the next community pool spend recorded in the history
+	CommunityPoolSpendSequence collections.Sequence
+	// ValidatorCommissionWithholdings key: valAddr | value: commission withholding and withheld commission
+	ValidatorCommissionWithholdings collections.Map[sdk.ValAddress, types.ValidatorCommissionWithholding]
+	// ValidatorForfeitedRewards key: valAddr | value: rewards forfeited to the community pool while the validator had no tokens
+	ValidatorForfeitedRewards collections.Map[sdk.ValAddress, types.ValidatorForfeitedRewards]
+	// HistoricalRewardsCompactionCursor is the historical rewards key of the last record inspected for compaction
+	HistoricalRewardsCompactionCursor collections.Item[[]byte]
+	// CommunityPoolRemainderAccrued is the cumulative truncation remainders of the withdrawals added to the community pool
+	CommunityPoolRemainderAccrued collections.Item[types.CommunityPoolRemainderAccrued]
+	// WithdrawOperators key: delAddr | value: address allowed to withdraw the delegation rewards on behalf of the delegator
+	WithdrawOperators collections.Map[sdk.AccAddress, sdk.AccAddress]
+	// RewardAdjustments key: id | value: reward adjustment applied by the module authority
+	RewardAdjustments collections.Map[uint64, types.RewardAdjustmentRecord]
+	// RewardAdjustmentSequence is the id of the next reward adjustment recorded in the audit log
+	RewardAdjustmentSequence collections.Sequence
+	// RewardDenomEvictions key: valAddr+denom | value: key of the last rewards record the eviction of the denom was removed from
+	RewardDenomEvictions collections.Map[collections.Pair[sdk.ValAddress, string], []byte]
+
+	// pendingValidatorDust batches the dust of the current block in the
+	// transient store, it is nil when no transient store is configured.
+	pendingValidatorDust *collections.Map[sdk.ValAddress, types.ValidatorDustStats]
+	// pendingCommunityPoolRemainder batches the remainders of the current
+	// block in the transient store, it is nil when no transient store is
+	// configured.
+	pendingCommunityPoolRemainder *collections.Item[types.CommunityPoolRemainderAccrued]
+	// pendingImplicitWithdrawals aggregates the implicit withdrawals of the
+	// current transaction per delegator in the transient store, ordered by
+	// implicitWithdrawalsSequence. They are nil when no transient store is
+	// configured.
+	pendingImplicitWithdrawals  *collections.Map[sdk.AccAddress, types.ImplicitWithdrawals]
+	implicitWithdrawalsSequence *collections.Sequence
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 
 	externalCommunityPool types.ExternalCommunityPoolKeeper
+
+	withdrawAddressProviders []types.WithdrawAddressProvider
+
+	priceSource types.PriceSource
+
+	// hooks points to the hooks observing the withdrawals, so that they are
+	// shared by the copies of the keeper made before SetHooks is called
+	hooks *types.DistributionHooks
+
+	metrics *withdrawalMetrics
 }
 
 type InitOption func(*Keeper)
@@ -50,6 +104,66 @@ func WithExternalCommunityPool(poolKeeper types.ExternalCommunityPoolKeeper) Ini
 	}
 }
 
+// WithWithdrawAddressProviders registers providers answering the withdraw address
+// of the delegator accounts they manage. They are consulted in order, before the
+// withdraw address set by the delegator, and the first one answering wins.
+func WithWithdrawAddressProviders(providers ...types.WithdrawAddressProvider) InitOption {
+	return func(k *Keeper) {
+		k.withdrawAddressProviders = append(k.withdrawAddressProviders, providers...)
+	}
+}
+
+// WithPriceSource enables valuing the rewards returned by the delegation rewards
+// queries in a quote denom, using the prices of the provided source. The price
+// source is only consulted by queries.
+func WithPriceSource(priceSource types.PriceSource) InitOption {
+	return func(k *Keeper) {
+		k.priceSource = priceSource
+	}
+}
+
+// WithTransientStoreService will batch the per validator dust counters and the
+// community pool remainder of a block in the provided transient store, flushing
+// them to the main store in EndBlock instead of writing them on every
+// withdrawal. It is also required to aggregate the implicit withdraw events.
+func WithTransientStoreService(transientService store.TransientStoreService) InitOption {
+	return func(k *Keeper) {
+		sb := collections.NewSchemaBuilderFromAccessor(transientService.OpenTransientStore)
+		pending := collections.NewMap(
+			sb,
+			types.PendingValidatorDustPrefix,
+			"pending_validator_dust",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorDustStats](k.cdc),
+		)
+		pendingRemainder := collections.NewItem(
+			sb,
+			types.PendingCommunityPoolRemainderKey,
+			"pending_community_pool_remainder",
+			codec.CollValue[types.CommunityPoolRemainderAccrued](k.cdc),
+		)
+		pendingImplicitWithdrawals := collections.NewMap(
+			sb,
+			types.PendingImplicitWithdrawalsPrefix,
+			"pending_implicit_withdrawals",
+			sdk.AccAddressKey,
+			codec.CollValue[types.ImplicitWithdrawals](k.cdc),
+		)
+		implicitWithdrawalsSequence := collections.NewSequence(
+			sb,
+			types.ImplicitWithdrawalsSequenceKey,
+			"implicit_withdrawals_sequence",
+		)
+		if _, err := sb.Build(); err != nil {
+			panic(err)
+		}
+		k.pendingValidatorDust = &pending
+		k.pendingCommunityPoolRemainder = &pendingRemainder
+		k.pendingImplicitWithdrawals = &pendingImplicitWithdrawals
+		k.implicitWithdrawalsSequence = &implicitWithdrawalsSequence
+	}
+}
+
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec,
@@ -67,15 +181,108 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		storeService:          storeService,
-		cdc:                   cdc,
-		authKeeper:            ak,
-		bankKeeper:            bk,
-		stakingKeeper:         sk,
-		feeCollectorName:      feeCollectorName,
-		authority:             authority,
-		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		FeePool:               collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
+		storeService:     storeService,
+		cdc:              cdc,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		hooks:            new(types.DistributionHooks),
+		metrics:          new(withdrawalMetrics),
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FeePool:          collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
+		ValidatorRewardDistributionStats: collections.NewMap(
+			sb,
+			types.ValidatorRewardDistributionStatsPrefix,
+			"validator_reward_distribution_stats",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorRewardDistributionStats](cdc),
+		),
+		ValidatorDustStats: collections.NewMap(
+			sb,
+			types.ValidatorDustStatsPrefix,
+			"validator_dust_stats",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorDustStats](cdc),
+		),
+		DelegatorAccruedRewards: collections.NewMap(
+			sb,
+			types.DelegatorAccruedRewardsPrefix,
+			"delegator_accrued_rewards",
+			collections.PairKeyCodec(sdk.ValAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.DelegatorAccruedRewards](cdc),
+		),
+		RewardCheckpointCursor: collections.NewItem(
+			sb,
+			types.RewardCheckpointCursorKey,
+			"reward_checkpoint_cursor",
+			collections.BytesValue,
+		),
+		CommunityPoolSpendHistory: collections.NewMap(
+			sb,
+			types.CommunityPoolSpendHistoryPrefix,
+			"community_pool_spend_history",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+			codec.CollValue[types.CommunityPoolSpendRecord](cdc),
+		),
+		CommunityPoolSpendSequence: collections.NewSequence(
+			sb,
+			types.CommunityPoolSpendSequenceKey,
+			"community_pool_spend_sequence",
+		),
+		ValidatorCommissionWithholdings: collections.NewMap(
+			sb,
+			types.ValidatorCommissionWithholdingPrefix,
+			"validator_commission_withholdings",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorCommissionWithholding](cdc),
+		),
+		ValidatorForfeitedRewards: collections.NewMap(
+			sb,
+			types.ValidatorForfeitedRewardsPrefix,
+			"validator_forfeited_rewards",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ValidatorForfeitedRewards](cdc),
+		),
+		HistoricalRewardsCompactionCursor: collections.NewItem(
+			sb,
+			types.HistoricalRewardsCompactionCursorKey,
+			"historical_rewards_compaction_cursor",
+			collections.BytesValue,
+		),
+		CommunityPoolRemainderAccrued: collections.NewItem(
+			sb,
+			types.CommunityPoolRemainderAccruedKey,
+			"community_pool_remainder_accrued",
+			codec.CollValue[types.CommunityPoolRemainderAccrued](cdc),
+		),
+		WithdrawOperators: collections.NewMap(
+			sb,
+			types.WithdrawOperatorsPrefix,
+			"withdraw_operators",
+			sdk.AccAddressKey,
+			collcodec.KeyToValueCodec(sdk.AccAddressKey),
+		),
+		RewardAdjustments: collections.NewMap(
+			sb,
+			types.RewardAdjustmentsPrefix,
+			"reward_adjustments",
+			collections.Uint64Key,
+			codec.CollValue[types.RewardAdjustmentRecord](cdc),
+		),
+		RewardAdjustmentSequence: collections.NewSequence(
+			sb,
+			types.RewardAdjustmentSequenceKey,
+			"reward_adjustment_sequence",
+		),
+		RewardDenomEvictions: collections.NewMap(
+			sb,
+			types.RewardDenomEvictionsPrefix,
+			"reward_denom_evictions",
+			collections.PairKeyCodec(sdk.ValAddressKey, collections.StringKey),
+			collections.BytesValue,
+		),
 		externalCommunityPool: nil,
 	}
 
@@ -105,6 +312,26 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetHooks sets the distribution hooks. The hooks are shared by every copy of
+// the keeper, including the copies made before SetHooks is called.
+func (k Keeper) SetHooks(dh types.DistributionHooks) Keeper {
+	if *k.hooks != nil {
+		panic("cannot set distribution hooks twice")
+	}
+
+	*k.hooks = dh
+	return k
+}
+
+// distributionHooks returns the distribution hooks, nil if none is set.
+func (k Keeper) distributionHooks() types.DistributionHooks {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return *k.hooks
+}
+
 // HasExternalCommunityPool is a helper function to denote whether the x/distribution module
 // is using its native community pool, or using an external pool.
 func (k Keeper) HasExternalCommunityPool() bool {
@@ -143,8 +370,19 @@ func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr
 	return k.SetDelegatorWithdrawAddr(ctx, delegatorAddr, withdrawAddr)
 }
 
-// WithdrawDelegationRewards withdraws rewards from a delegation
+// WithdrawDelegationRewards withdraws rewards from a delegation. If the
+// withdrawal fails, none of its state changes are persisted, even when the
+// caller does not discard its own store branch on error.
 func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	return k.withdrawDelegationRewardsAboveMinimum(ctx, delAddr, valAddr, nil)
+}
+
+// withdrawDelegationRewardsAboveMinimum withdraws rewards from a delegation as
+// WithdrawDelegationRewards, unless none of its rewards reach the minimum
+// amount of their denom, in which case the delegation keeps accruing rewards.
+// The minimum of a denom it does not list is zero, and an empty minimum allows
+// every withdrawal.
+func (k Keeper) withdrawDelegationRewardsAboveMinimum(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, minimum sdk.Coins) (sdk.Coins, error) {
 	val, err := k.stakingKeeper.Validator(ctx, valAddr)
 	if err != nil {
 		return nil, err
@@ -163,20 +401,115 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 		return nil, types.ErrEmptyDelegationDistInfo
 	}
 
-	// withdraw rewards
-	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	// withdraw rewards and reinitialize the delegation in a single branch
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+	rewards, err := k.withdrawDelegationRewards(cacheCtx, val, del, false)
 	if err != nil {
 		return nil, err
 	}
 
-	// reinitialize the delegation
-	err = k.initializeDelegation(ctx, valAddr, delAddr)
+	if !minimum.IsZero() && !reachesMinWithdrawal(rewards, minimum) {
+		return nil, errorsmod.Wrapf(types.ErrBelowMinWithdrawal, "rewards %s, minimum %s", rewards, minimum)
+	}
+
+	err = k.initializeDelegation(cacheCtx, valAddr, delAddr)
 	if err != nil {
 		return nil, err
 	}
+
+	writeCache()
 	return rewards, nil
 }
 
+// reachesMinWithdrawal reports whether any of the rewards reaches the minimum
+// amount of its denom. Any rewards in a denom the minimum does not list reach
+// it.
+func reachesMinWithdrawal(rewards, minimum sdk.Coins) bool {
+	for _, coin := range rewards {
+		if coin.IsPositive() && coin.Amount.GTE(minimum.AmountOf(coin.Denom)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of
+// a delegator and returns the total withdrawn. Delegations without starting
+// info are skipped. A withdraw_rewards event is emitted for each delegation and
+// one without validator for the total. As WithdrawDelegationRewards, it is not
+// subject to MinWithdrawalAmount.
+func (k Keeper) WithdrawAllDelegationRewards(ctx context.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	return k.withdrawAllDelegationRewards(ctx, delAddr, nil)
+}
+
+// withdrawAllDelegationRewards withdraws the rewards of all the delegations of
+// a delegator as WithdrawAllDelegationRewards, skipping the delegations whose
+// rewards are below the minimum, which keep accruing rewards. The withdrawal
+// is rejected if the rewards of every delegation are below the minimum.
+func (k Keeper) withdrawAllDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, minimum sdk.Coins) (sdk.Coins, error) {
+	var valAddrs []sdk.ValAddress
+	var iterErr error
+	err := k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		valAddrs = append(valAddrs, valAddr)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	total := sdk.NewCoins()
+	withdrawn, belowMinimum := 0, 0
+	for _, valAddr := range valAddrs {
+		found, err := k.HasDelegatorStartingInfo(ctx, valAddr, delAddr)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		rewards, err := k.withdrawDelegationRewardsAboveMinimum(ctx, delAddr, valAddr, minimum)
+		if errorsmod.IsOf(err, types.ErrBelowMinWithdrawal) {
+			belowMinimum++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		withdrawn++
+		total = total.Add(rewards...)
+	}
+
+	if withdrawn == 0 && belowMinimum > 0 {
+		return nil, errorsmod.Wrapf(types.ErrBelowMinWithdrawal, "rewards of %d delegations, minimum %s", belowMinimum, minimum)
+	}
+
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddrStr),
+		),
+	)
+
+	return total, nil
+}
+
 // WithdrawValidatorCommission withdraws validator commission.
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -206,9 +539,23 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 		return nil, err
 	}
 
+	var withdrawAddrSource string
 	if !commission.IsZero() {
+		// withheld commission is kept by the module until governance resolves it
+		withholding, err := k.GetValidatorCommissionWithholding(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		if withholding.Withheld {
+			if err := k.withholdCommission(ctx, valAddr, withholding, commission); err != nil {
+				return nil, err
+			}
+			return sdk.NewCoins(), nil
+		}
+
 		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, accAddr)
+		var withdrawAddr sdk.AccAddress
+		withdrawAddr, withdrawAddrSource, err = k.getDelegatorWithdrawAddr(ctx, accAddr)
 		if err != nil {
 			return nil, err
 		}
@@ -217,15 +564,31 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 		if err != nil {
 			return nil, err
 		}
+
+		if err = k.recordCommissionWithdrawn(ctx, valAddr, commission); err != nil {
+			return nil, err
+		}
+
+		k.reportWithdrawal(ctx, MetricLabelValueSourceCommission, valAddr.String(), commission)
+
+		if hooks := k.distributionHooks(); hooks != nil {
+			if err := hooks.AfterValidatorCommissionWithdrawn(ctx, valAddr, commission); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWithdrawCommission,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
-		),
+	event := sdk.NewEvent(
+		types.EventTypeWithdrawCommission,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+		sdk.NewAttribute(types.AttributeKeyRemainder, remainder.String()),
 	)
+	if withdrawAddrSource != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyWithdrawAddressSource, withdrawAddrSource))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(event)
 
 	return commission, nil
 }
@@ -245,8 +608,20 @@ func (k Keeper) GetTotalRewards(ctx context.Context) (totalRewards sdk.DecCoins)
 // FundCommunityPool allows an account to directly fund the community fund pool.
 // The amount is first added to the distribution module account and then directly
 // added to the pool. An error is returned if the amount cannot be sent to the
-// module account.
+// module account, or if it contains denoms not in the community pool allowed
+// denoms.
 func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range amount {
+		if !params.CommunityPoolDenomAllowed(coin.Denom) {
+			return errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s", coin.Denom)
+		}
+	}
+
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
 		return err
 	}
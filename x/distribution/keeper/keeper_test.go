@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
@@ -70,6 +72,99 @@ func TestSetWithdrawAddr(t *testing.T) {
 	require.Error(t, distrKeeper.SetWithdrawAddr(ctx, delegatorAddr, distrAcc.GetAddress()))
 }
 
+// withdrawAddressProvider answers the withdraw address of the delegators in its map.
+type withdrawAddressProvider map[string]sdk.AccAddress
+
+func (p withdrawAddressProvider) GetWithdrawAddress(_ context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, bool, error) {
+	withdrawAddr, ok := p[delAddr.String()]
+	return withdrawAddr, ok, nil
+}
+
+func TestWithdrawAddressProviders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(6)
+
+	managedAddr, providedAddr, delegatorAddr, storedAddr, blockedManagedAddr, overriddenAddr := addrs[0], addrs[1], addrs[2], addrs[3], addrs[4], addrs[5]
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).DoAndReturn(func(addr sdk.AccAddress) bool {
+		return addr.Equals(distrAcc.GetAddress())
+	}).AnyTimes()
+
+	// the first provider answering for a delegator wins
+	first := withdrawAddressProvider{
+		managedAddr.String():        providedAddr,
+		blockedManagedAddr.String(): distrAcc.GetAddress(),
+	}
+	second := withdrawAddressProvider{
+		managedAddr.String():   overriddenAddr,
+		delegatorAddr.String(): providedAddr,
+	}
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+		keeper.WithWithdrawAddressProviders(first, second),
+	)
+	require.NoError(t, distrKeeper.Params.Set(ctx, types.DefaultParams()))
+
+	// a provider takes precedence over the withdraw address set by the delegator
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, managedAddr, storedAddr))
+	withdrawAddr, err := distrKeeper.GetDelegatorWithdrawAddr(ctx, managedAddr)
+	require.NoError(t, err)
+	require.Equal(t, providedAddr, withdrawAddr)
+
+	withdrawAddr, err = distrKeeper.GetDelegatorWithdrawAddr(ctx, delegatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, providedAddr, withdrawAddr)
+
+	// the withdraw address set by the delegator takes precedence over the delegator
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, storedAddr, overriddenAddr))
+	withdrawAddr, err = distrKeeper.GetDelegatorWithdrawAddr(ctx, storedAddr)
+	require.NoError(t, err)
+	require.Equal(t, overriddenAddr, withdrawAddr)
+
+	withdrawAddr, err = distrKeeper.GetDelegatorWithdrawAddr(ctx, overriddenAddr)
+	require.NoError(t, err)
+	require.Equal(t, overriddenAddr, withdrawAddr)
+
+	// the withdrawal reports the source of the withdraw address
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(2))}
+	valAddr := sdk.ValAddress(managedAddr)
+	require.NoError(t, distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission}))
+	require.NoError(t, distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission}))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, providedAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 2))).Return(nil)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+	events := ctx.EventManager().Events()
+	source, ok := events[len(events)-1].GetAttribute(types.AttributeKeyWithdrawAddressSource)
+	require.True(t, ok)
+	require.Equal(t, types.AttributeValueWithdrawAddressProvider, source.Value)
+
+	// a blocked address answered by a provider is rejected at withdrawal time
+	valAddr = sdk.ValAddress(blockedManagedAddr)
+	require.NoError(t, distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission}))
+	require.NoError(t, distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission}))
+	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
 func TestWithdrawValidatorCommission(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -101,6 +196,7 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 		"fee_collector",
 		authtypes.NewModuleAddress("gov").String(),
 	)
+	require.NoError(t, distrKeeper.Params.Set(ctx, types.DefaultParams()))
 
 	// set outstanding rewards
 	require.NoError(t, distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: valCommission}))
@@ -126,6 +222,62 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}, remainder)
 }
 
+func TestWithdrawValidatorCommissionRemainder(t *testing.T) {
+	f := newValidatorFixture(t, types.DefaultParams())
+	querier := keeper.NewQuerier(f.Keeper)
+	invariant := keeper.NonNegativeCommissionInvariant(f.Keeper)
+	selfAddr := sdk.AccAddress(f.valAddr)
+
+	withdraw := func(paid, remainder string) {
+		t.Helper()
+
+		res, err := querier.ValidatorCommission(f.Ctx, &types.QueryValidatorCommissionRequest{ValidatorAddress: f.valAddr.String()})
+		require.NoError(t, err)
+		withdrawable := res.Withdrawable
+
+		f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+		coins, err := f.Keeper.WithdrawValidatorCommission(f.Ctx, f.valAddr)
+		require.NoError(t, err)
+		require.Equal(t, paid, coins.String())
+		require.Equal(t, withdrawable, coins)
+
+		events := f.Ctx.EventManager().Events()
+		event := events[len(events)-1]
+		require.Equal(t, types.EventTypeWithdrawCommission, event.Type)
+		amount, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+		require.True(t, ok)
+		require.Equal(t, paid, amount.Value)
+		retained, ok := event.GetAttribute(types.AttributeKeyRemainder)
+		require.True(t, ok)
+		require.Equal(t, remainder, retained.Value)
+
+		// the remainder stays accumulated
+		commission, err := f.Keeper.GetValidatorAccumulatedCommission(f.Ctx, f.valAddr)
+		require.NoError(t, err)
+		require.Equal(t, remainder, commission.Commission.String())
+
+		_, broken := invariant(f.Ctx)
+		require.False(t, broken)
+	}
+
+	// 10% commission on 17.5 tokens
+	f.allocateBlock(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("17.5"))))
+	res, err := querier.ValidatorCommission(f.Ctx, &types.QueryValidatorCommissionRequest{ValidatorAddress: f.valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, "1.750000000000000000stake", res.Commission.Commission.String())
+	require.Equal(t, "1stake", res.Withdrawable.String())
+	withdraw("1stake", "0.750000000000000000stake")
+
+	// the fraction carried over adds up with the next commission
+	f.allocateBlock(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("17.5"))))
+	withdraw("2stake", "0.500000000000000000stake")
+
+	// until it is eventually paid out
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 5)))
+	withdraw("1stake", "")
+	require.Equal(t, "4stake", f.Payouts[selfAddr.String()].String())
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -195,6 +347,7 @@ func TestFundCommunityPool(t *testing.T) {
 
 	// reset fee pool
 	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, types.DefaultParams()))
 
 	initPool, err := distrKeeper.FeePool.Get(ctx)
 	require.NoError(t, err)
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// distribution metric keys and labels
+const (
+	MetricKeyRewardsWithdrawn         = "rewards_withdrawn"
+	MetricKeyRemainderToCommunityPool = "remainder_to_community_pool"
+	MetricKeyWithdrawalsPerBlock      = "withdrawals_per_block"
+	MetricLabelDenom                  = "denom"
+	MetricLabelSource                 = "source"
+	MetricLabelValidator              = "validator"
+	MetricLabelValueSourceDelegation  = "delegation"
+	MetricLabelValueSourceCommission  = "commission"
+)
+
+// withdrawalMetrics reports the withdrawals to telemetry. It is shared by the
+// copies of the keeper, so that the withdrawals of a block are counted once.
+type withdrawalMetrics struct {
+	// detailed labels the metrics with the validator of the withdrawal
+	detailed bool
+	// withdrawals is the number of withdrawals of the current block
+	withdrawals atomic.Uint64
+}
+
+// WithDetailedMetrics labels the withdrawal metrics with the operator address
+// of their validator. It multiplies the number of series by the number of
+// validators, so it should only be enabled by nodes which can afford it.
+func WithDetailedMetrics() InitOption {
+	return func(k *Keeper) {
+		k.metrics.detailed = true
+	}
+}
+
+// reportsMetrics returns whether the metrics are reported in the context:
+// telemetry must be enabled, and the transaction neither checked nor simulated.
+func reportsMetrics(ctx context.Context) bool {
+	if !telemetry.IsTelemetryEnabled() { //nolint:staticcheck // TODO: switch to OpenTelemetry
+		return false
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return !sdkCtx.IsCheckTx() && !sdkCtx.IsReCheckTx() && sdkCtx.ExecMode() != sdk.ExecModeSimulate
+}
+
+// labels returns the labels of a metric of a denom, along with the validator
+// for detailed metrics.
+func (m *withdrawalMetrics) labels(denom, operator string, labels ...metrics.Label) []metrics.Label {
+	labels = append(labels, telemetry.NewLabel(MetricLabelDenom, denom)) //nolint:staticcheck // TODO: switch to OpenTelemetry
+	if m.detailed {
+		labels = append(labels, telemetry.NewLabel(MetricLabelValidator, operator)) //nolint:staticcheck // TODO: switch to OpenTelemetry
+	}
+
+	return labels
+}
+
+// reportWithdrawal counts a withdrawal of the rewards or the commission of a
+// validator, adding the withdrawn coins to the rewards withdrawn per denom.
+func (k Keeper) reportWithdrawal(ctx context.Context, source, operator string, withdrawn sdk.Coins) {
+	if !reportsMetrics(ctx) {
+		return
+	}
+
+	k.metrics.withdrawals.Add(1)
+	for _, coin := range withdrawn {
+		telemetry.IncrCounterWithLabels( //nolint:staticcheck // TODO: switch to OpenTelemetry
+			[]string{types.ModuleName, MetricKeyRewardsWithdrawn},
+			intMetricValue(coin.Amount),
+			k.metrics.labels(coin.Denom, operator, telemetry.NewLabel(MetricLabelSource, source)), //nolint:staticcheck // TODO: switch to OpenTelemetry
+		)
+	}
+}
+
+// reportCommunityPoolRemainder adds a truncation remainder sent to the
+// community pool to the remainders per denom.
+func (k Keeper) reportCommunityPoolRemainder(ctx context.Context, operator string, remainder sdk.DecCoins) {
+	if !reportsMetrics(ctx) {
+		return
+	}
+
+	for _, coin := range remainder {
+		telemetry.IncrCounterWithLabels( //nolint:staticcheck // TODO: switch to OpenTelemetry
+			[]string{types.ModuleName, MetricKeyRemainderToCommunityPool},
+			decMetricValue(coin.Amount),
+			k.metrics.labels(coin.Denom, operator),
+		)
+	}
+}
+
+// reportWithdrawalsPerBlock sets the number of withdrawals of the block ending
+// and resets it for the next block.
+func (k Keeper) reportWithdrawalsPerBlock(ctx context.Context) {
+	withdrawals := k.metrics.withdrawals.Swap(0)
+	if !reportsMetrics(ctx) {
+		return
+	}
+
+	telemetry.SetGauge(float32(withdrawals), types.ModuleName, MetricKeyWithdrawalsPerBlock) //nolint:staticcheck // TODO: switch to OpenTelemetry
+}
+
+// intMetricValue and decMetricValue convert an amount to a metric value,
+// which may lose precision but not overflow.
+func intMetricValue(amount math.Int) float32 {
+	value, _ := new(big.Float).SetInt(amount.BigInt()).Float32()
+	return value
+}
+
+func decMetricValue(amount math.LegacyDec) float32 {
+	value, _ := amount.Float64()
+	return float32(value)
+}
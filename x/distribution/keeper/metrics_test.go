@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// newMetricsSink captures the metrics in memory until the end of the test,
+// telemetry is disabled afterwards.
+func newMetricsSink(t *testing.T) *metrics.InmemSink {
+	t.Helper()
+
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{Enabled: false})
+		require.NoError(t, err)
+		_, err = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+		require.NoError(t, err)
+	})
+
+	return sink
+}
+
+func TestWithdrawalMetrics(t *testing.T) {
+	sink := newMetricsSink(t)
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+
+	counter := func(key string) float64 {
+		if value, ok := sink.Data()[0].Counters[key]; ok {
+			return value.Sum
+		}
+		return 0
+	}
+
+	// nothing is reported while telemetry is disabled
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1000)))
+	_, err := f.Keeper.WithdrawDelegationRewards(f.Ctx, f.delAddrs[1], f.valAddr)
+	require.NoError(t, err)
+	require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+	require.Empty(t, sink.Data()[0].Counters)
+	require.Empty(t, sink.Data()[0].Gauges)
+
+	telemetry.EnableTelemetry() //nolint:staticcheck // TODO: switch to OpenTelemetry
+
+	// 1001stake leave 225.225stake to each delegator other than the validator,
+	// and 100.1stake of commission on top of the 100stake of the previous block
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1001)))
+	_, err = f.Keeper.WithdrawDelegationRewards(f.Ctx, f.delAddrs[1], f.valAddr)
+	require.NoError(t, err)
+	_, err = f.Keeper.WithdrawValidatorCommission(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+
+	// the withdrawals of checked and simulated transactions are not reported
+	_, err = f.Keeper.WithdrawDelegationRewards(f.Ctx.WithIsCheckTx(true), f.delAddrs[2], f.valAddr)
+	require.NoError(t, err)
+	_, err = f.Keeper.WithdrawDelegationRewards(f.Ctx.WithExecMode(sdk.ExecModeSimulate), f.delAddrs[0], f.valAddr)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+
+	require.Equal(t, 225.0, counter("distribution.rewards_withdrawn;source=delegation;denom=stake"))
+	require.Equal(t, 200.0, counter("distribution.rewards_withdrawn;source=commission;denom=stake"))
+	require.InDelta(t, 0.225, counter("distribution.remainder_to_community_pool;denom=stake"), 1e-6)
+
+	gauge, ok := sink.Data()[0].Gauges["distribution.withdrawals_per_block"]
+	require.True(t, ok)
+	require.Equal(t, float32(2), gauge.Value)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// newMinWithdrawalFixture returns a fixture whose withdrawals of less than
+// 10atom and 1000stake are rejected.
+func newMinWithdrawalFixture(t *testing.T) *validatorFixture {
+	t.Helper()
+
+	params := disttypes.DefaultParams()
+	params.MinWithdrawalAmount = sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	return newValidatorFixture(t, params)
+}
+
+func TestWithdrawDelegatorRewardMinWithdrawal(t *testing.T) {
+	f := newMinWithdrawalFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.Keeper)
+	delAddr := f.delAddrs[1]
+	msg := disttypes.NewMsgWithdrawDelegatorReward(delAddr.String(), f.valAddr.String())
+
+	startingInfo, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+	require.NoError(t, err)
+
+	// 9atom and 90stake are below the minimum in every denom
+	f.allocateBlock(t, decCoins(decCoin("atom", 40), decCoin(sdk.DefaultBondDenom, 400)))
+	_, err = msgServer.WithdrawDelegatorReward(f.Ctx, msg)
+	require.ErrorIs(t, err, disttypes.ErrBelowMinWithdrawal)
+	require.Empty(t, f.withdrawEvents())
+	require.Equal(t, decCoins(decCoin("atom", 40), decCoin(sdk.DefaultBondDenom, 400)), f.outstanding(t))
+
+	// the rewards keep accruing
+	info, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+	require.NoError(t, err)
+	require.Equal(t, startingInfo, info)
+	require.Equal(t, decCoins(decCoin("atom", 9), decCoin(sdk.DefaultBondDenom, 90)), f.rewards(t)[1])
+
+	// 18atom exceed the minimum, while 180stake are still below it
+	f.allocateBlock(t, decCoins(decCoin("atom", 40), decCoin(sdk.DefaultBondDenom, 400)))
+	res, err := msgServer.WithdrawDelegatorReward(f.Ctx, msg)
+	require.NoError(t, err)
+	expRewards := sdk.NewCoins(sdk.NewInt64Coin("atom", 18), sdk.NewInt64Coin(sdk.DefaultBondDenom, 180))
+	require.Equal(t, expRewards, res.Amount)
+	require.Len(t, f.withdrawEvents(), 1)
+
+	// a withdrawal without rewards is rejected as well
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+	_, err = msgServer.WithdrawDelegatorReward(f.Ctx, msg)
+	require.ErrorIs(t, err, disttypes.ErrBelowMinWithdrawal)
+}
+
+func TestWithdrawDelegatorRewardMinWithdrawalUnlistedDenom(t *testing.T) {
+	f := newMinWithdrawalFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.Keeper)
+	msg := disttypes.NewMsgWithdrawDelegatorReward(f.delAddrs[1].String(), f.valAddr.String())
+
+	// the minimum of a denom it does not list is zero
+	f.allocateBlock(t, decCoins(decCoin(checkpointTestDenom, 40)))
+	res, err := msgServer.WithdrawDelegatorReward(f.Ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(checkpointTestDenom, 9)), res.Amount)
+	require.Len(t, f.withdrawEvents(), 1)
+
+	// even if the rewards of the listed denoms are below their minimum
+	f.allocateBlock(t, decCoins(decCoin(checkpointTestDenom, 40), decCoin(sdk.DefaultBondDenom, 400)))
+	res, err = msgServer.WithdrawDelegatorReward(f.Ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(checkpointTestDenom, 9), sdk.NewInt64Coin(sdk.DefaultBondDenom, 90)), res.Amount)
+}
+
+func TestMinWithdrawalBypassedByDelegationChanges(t *testing.T) {
+	f := newMinWithdrawalFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.Keeper)
+	delAddr := f.delAddrs[2]
+
+	f.allocateBlock(t, decCoins(decCoin("atom", 40), decCoin(sdk.DefaultBondDenom, 400)))
+	_, err := msgServer.WithdrawDelegatorReward(f.Ctx, disttypes.NewMsgWithdrawDelegatorReward(delAddr.String(), f.valAddr.String()))
+	require.ErrorIs(t, err, disttypes.ErrBelowMinWithdrawal)
+
+	// the rewards below the minimum are withdrawn when the delegation changes
+	f.delegate(t, delAddr, math.NewInt(1_000_000))
+	require.Len(t, f.withdrawEvents(), 1)
+	require.Equal(t, "9atom,90stake", f.withdrawEvents()[0].Attributes[0].Value)
+	require.Empty(t, f.rewards(t)[2])
+
+	// and so are they by the keeper, on behalf of other modules
+	expRewards, _ := f.rewards(t)[1].TruncateDecimal()
+	rewards, err := f.Keeper.WithdrawDelegationRewards(f.Ctx, f.delAddrs[1], f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 9), sdk.NewInt64Coin(sdk.DefaultBondDenom, 90)), expRewards)
+	require.Equal(t, expRewards, rewards)
+}
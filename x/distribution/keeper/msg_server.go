@@ -57,7 +57,24 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
 	}
 
-	amount, err := k.WithdrawDelegationRewards(ctx, delegatorAddress, valAddr)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var amount sdk.Coins
+	if msg.OnBehalfOf == "" {
+		amount, err = k.withdrawDelegationRewardsAboveMinimum(ctx, delegatorAddress, valAddr, params.MinWithdrawalAmount)
+	} else {
+		// the signer is the withdraw operator of the delegator
+		var onBehalfOf []byte
+		onBehalfOf, err = k.authKeeper.AddressCodec().StringToBytes(msg.OnBehalfOf)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid on behalf of address: %s", err)
+		}
+
+		amount, err = k.withdrawDelegationRewardsOnBehalf(ctx, delegatorAddress, onBehalfOf, valAddr, params.MinWithdrawalAmount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +94,37 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
 }
 
+func (k msgServer) WithdrawAllDelegatorRewards(ctx context.Context, msg *types.MsgWithdrawAllDelegatorRewards) (*types.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.withdrawAllDelegationRewards(ctx, delegatorAddress, params.MinWithdrawalAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels( //nolint:staticcheck // TODO: switch to OpenTelemetry
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)}, //nolint:staticcheck // TODO: switch to OpenTelemetry
+				)
+			}
+		}
+	}()
+
+	return &types.MsgWithdrawAllDelegatorRewardsResponse{Amount: amount}, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
 	if err != nil {
@@ -171,6 +219,10 @@ func (k msgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 		return nil, err
 	}
 
+	if err := k.recordCommunityPoolSpend(ctx, msg.Authority, msg.Recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	logger := k.Logger(ctx)
 	logger.Info("transferred from the community pool to recipient", "amount", msg.Amount.String(), "recipient", msg.Recipient)
 
@@ -250,6 +302,153 @@ func (k msgServer) DepositValidatorRewardsPool(ctx context.Context, msg *types.M
 	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
 
+func (k msgServer) ResetValidatorRewardDistributionStats(ctx context.Context, msg *types.MsgResetValidatorRewardDistributionStats) (*types.MsgResetValidatorRewardDistributionStatsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.ResetValidatorRewardDistributionStats(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("reset validator reward distribution stats", "validator", msg.ValidatorAddress)
+
+	return &types.MsgResetValidatorRewardDistributionStatsResponse{}, nil
+}
+
+func (k msgServer) SetCommissionWithholding(ctx context.Context, msg *types.MsgSetCommissionWithholding) (*types.MsgSetCommissionWithholdingResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	// the withholding of a removed validator can still be lifted
+	if msg.Withheld {
+		validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if validator == nil {
+			return nil, errors.Wrapf(types.ErrNoValidatorExists, "%s", msg.ValidatorAddress)
+		}
+	}
+
+	if err := k.Keeper.SetCommissionWithholding(ctx, valAddr, msg.Withheld); err != nil {
+		return nil, err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("set validator commission withholding", "validator", msg.ValidatorAddress, "withheld", msg.Withheld)
+
+	return &types.MsgSetCommissionWithholdingResponse{}, nil
+}
+
+func (k msgServer) ResolveCommissionWithholding(ctx context.Context, msg *types.MsgResolveCommissionWithholding) (*types.MsgResolveCommissionWithholdingResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	amount, err := k.Keeper.ResolveCommissionWithholding(ctx, valAddr, msg.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("resolved validator commission withholding", "validator", msg.ValidatorAddress, "amount", amount.String(), "destination", msg.Destination.String())
+
+	return &types.MsgResolveCommissionWithholdingResponse{Amount: amount}, nil
+}
+
+func (k msgServer) ApplyRewardAdjustment(ctx context.Context, msg *types.MsgApplyRewardAdjustment) (*types.MsgApplyRewardAdjustmentResponse, error) {
+	if k.HasExternalCommunityPool() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "external community pool is enabled - reward adjustments cannot be financed from the community pool")
+	}
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.ApplyRewardAdjustment(ctx, msg.Authority, msg.Adjustments, msg.Justification, msg.StartHeight, msg.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("applied reward adjustment", "id", id, "validators", len(msg.Adjustments), "start_height", msg.StartHeight, "end_height", msg.EndHeight)
+
+	return &types.MsgApplyRewardAdjustmentResponse{Id: id}, nil
+}
+
+func (k msgServer) SweepCommunityPoolDenoms(ctx context.Context, msg *types.MsgSweepCommunityPoolDenoms) (*types.MsgSweepCommunityPoolDenomsResponse, error) {
+	if k.HasExternalCommunityPool() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "external community pool is enabled - denoms cannot be swept from the community pool")
+	}
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	var disposalAddr sdk.AccAddress
+	if msg.DisposalAddress != "" {
+		var err error
+		disposalAddr, err = k.authKeeper.AddressCodec().StringToBytes(msg.DisposalAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid disposal address: %s", err)
+		}
+
+		if k.bankKeeper.BlockedAddr(disposalAddr) {
+			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", msg.DisposalAddress)
+		}
+	}
+
+	amount, err := k.Keeper.SweepCommunityPoolDenoms(ctx, msg.Denoms, disposalAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("swept denoms from the community pool", "amount", amount.String(), "disposal_address", msg.DisposalAddress)
+
+	return &types.MsgSweepCommunityPoolDenomsResponse{Amount: amount}, nil
+}
+
+func (k msgServer) RegisterWithdrawOperator(ctx context.Context, msg *types.MsgRegisterWithdrawOperator) (*types.MsgRegisterWithdrawOperatorResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	var operatorAddress []byte
+	if msg.OperatorAddress != "" {
+		operatorAddress, err = k.authKeeper.AddressCodec().StringToBytes(msg.OperatorAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address: %s", err)
+		}
+	}
+
+	if err := k.Keeper.RegisterWithdrawOperator(ctx, delegatorAddress, operatorAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterWithdrawOperatorResponse{}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
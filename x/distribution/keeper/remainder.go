@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetCommunityPoolRemainderAccrued returns the cumulative truncation remainders
+// of the withdrawals added to the community pool, including the remainders of
+// the current block which have not been flushed yet.
+func (k Keeper) GetCommunityPoolRemainderAccrued(ctx context.Context) (sdk.DecCoins, error) {
+	accrued, err := k.CommunityPoolRemainderAccrued.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	if k.pendingCommunityPoolRemainder != nil {
+		pending, err := k.pendingCommunityPoolRemainder.Get(ctx)
+		switch {
+		case err == nil:
+			accrued.Remainder = accrued.Remainder.Add(pending.Remainder...)
+		case !errors.Is(err, collections.ErrNotFound):
+			return nil, err
+		}
+	}
+
+	return accrued.Remainder, nil
+}
+
+// FlushCommunityPoolRemainder moves the remainders batched in the transient
+// store during the current block to the cumulative remainders.
+func (k Keeper) FlushCommunityPoolRemainder(ctx context.Context) error {
+	if k.pendingCommunityPoolRemainder == nil {
+		return nil
+	}
+
+	pending, err := k.pendingCommunityPoolRemainder.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.addCommunityPoolRemainder(ctx, k.CommunityPoolRemainderAccrued, pending.Remainder); err != nil {
+		return err
+	}
+
+	return k.pendingCommunityPoolRemainder.Remove(ctx)
+}
+
+// recordCommunityPoolRemainder emits the truncation remainder of a withdrawal
+// added to the community pool and adds it to the cumulative remainders. The
+// delegator is empty for the commission of the validator. The remainder is
+// batched in the transient store when available.
+func (k Keeper) recordCommunityPoolRemainder(ctx context.Context, operator, delegator string, remainder sdk.DecCoins) error {
+	if remainder.IsZero() {
+		return nil
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyAmount, remainder.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, operator),
+	}
+	if delegator != "" {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyDelegator, delegator))
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCommunityPoolRemainder, attrs...))
+	k.reportCommunityPoolRemainder(ctx, operator, remainder)
+
+	if k.pendingCommunityPoolRemainder != nil {
+		return k.addCommunityPoolRemainder(ctx, *k.pendingCommunityPoolRemainder, remainder)
+	}
+
+	return k.addCommunityPoolRemainder(ctx, k.CommunityPoolRemainderAccrued, remainder)
+}
+
+func (k Keeper) addCommunityPoolRemainder(
+	ctx context.Context,
+	item collections.Item[types.CommunityPoolRemainderAccrued],
+	remainder sdk.DecCoins,
+) error {
+	accrued, err := item.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	accrued.Remainder = accrued.Remainder.Add(remainder...)
+	return item.Set(ctx, accrued)
+}
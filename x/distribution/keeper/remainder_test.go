@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// remainderEvents returns the attributes of the community pool remainder
+// events emitted so far.
+func remainderEvents(ctx sdk.Context) []map[string]string {
+	var events []map[string]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != disttypes.EventTypeCommunityPoolRemainder {
+			continue
+		}
+
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		events = append(events, attrs)
+	}
+
+	return events
+}
+
+func TestCommunityPoolRemainderEvents(t *testing.T) {
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+	querier := keeper.NewQuerier(f.Keeper)
+
+	f.allocateBlock(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("33.333333")),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("1000.7")),
+	))
+	pool := f.communityPool(t)
+	f.withdrawAll(t)
+
+	// every delegation left a remainder, all of which reached the pool
+	events := remainderEvents(f.Ctx)
+	require.Len(t, events, len(f.delAddrs))
+
+	var total sdk.DecCoins
+	for i, attrs := range events {
+		require.Equal(t, f.valAddr.String(), attrs[disttypes.AttributeKeyValidator])
+		require.Equal(t, f.delAddrs[i].String(), attrs[disttypes.AttributeKeyDelegator])
+
+		remainder, err := sdk.ParseDecCoins(attrs[sdk.AttributeKeyAmount])
+		require.NoError(t, err)
+		require.False(t, remainder.IsZero())
+		total = total.Add(remainder...)
+	}
+	require.Equal(t, total, f.communityPool(t).Sub(pool))
+
+	res, err := querier.CommunityPoolRemainderAccrued(f.Ctx, &disttypes.QueryCommunityPoolRemainderAccruedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, total, res.Remainder)
+
+	// withdrawals without a remainder emit no event
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 0)))
+	f.withdrawAll(t)
+	require.Empty(t, remainderEvents(f.Ctx))
+
+	// the remainder of the commission of a removed validator is reported too
+	f.allocateBlock(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("12.34"))))
+	commission, err := f.Keeper.GetValidatorAccumulatedCommission(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	_, expRemainder := commission.Commission.TruncateDecimal()
+	require.NoError(t, f.Keeper.Hooks().AfterValidatorRemoved(f.Ctx, valConsAddr0, f.valAddr))
+
+	events = remainderEvents(f.Ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, map[string]string{
+		sdk.AttributeKeyAmount:          expRemainder.String(),
+		disttypes.AttributeKeyValidator: f.valAddr.String(),
+	}, events[0])
+
+	res, err = querier.CommunityPoolRemainderAccrued(f.Ctx, &disttypes.QueryCommunityPoolRemainderAccruedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, total.Add(expRemainder...), res.Remainder)
+}
+
+func TestCommunityPoolRemainderBatched(t *testing.T) {
+	expRemainder := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(15, 1)}}
+
+	immediateCtx, immediateKeeper, _ := withdrawWithDust(t, false)
+	batchedCtx, batchedKeeper, _ := withdrawWithDust(t, true)
+
+	remainder, err := immediateKeeper.GetCommunityPoolRemainderAccrued(immediateCtx)
+	require.NoError(t, err)
+	require.Equal(t, expRemainder, remainder)
+
+	// nothing is persisted before the end of the block
+	_, err = batchedKeeper.CommunityPoolRemainderAccrued.Get(batchedCtx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// but the pending remainder is already visible
+	remainder, err = batchedKeeper.GetCommunityPoolRemainderAccrued(batchedCtx)
+	require.NoError(t, err)
+	require.Equal(t, expRemainder, remainder)
+
+	require.NoError(t, batchedKeeper.EndBlocker(batchedCtx))
+
+	accrued, err := batchedKeeper.CommunityPoolRemainderAccrued.Get(batchedCtx)
+	require.NoError(t, err)
+	require.Equal(t, expRemainder, accrued.Remainder)
+
+	// the pending remainder has been cleared, so it is not counted twice
+	remainder, err = batchedKeeper.GetCommunityPoolRemainderAccrued(batchedCtx)
+	require.NoError(t, err)
+	require.Equal(t, expRemainder, remainder)
+
+	// the remainder is part of the exported genesis
+	require.NoError(t, batchedKeeper.SetPreviousProposerConsAddr(batchedCtx, valConsAddr0))
+	genState := batchedKeeper.ExportGenesis(batchedCtx)
+	require.Equal(t, expRemainder, genState.CommunityPoolRemainderAccrued)
+	require.NoError(t, disttypes.ValidateGenesis(genState))
+}
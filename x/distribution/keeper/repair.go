@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// RepairDelegationStartingInfo initializes the missing starting info of an
+// existing delegation, as left behind by a botched migration or an
+// inconsistent genesis. The delegation starts tracking rewards at the period
+// ended by the repair: whatever it accrued before is forfeited and remains in
+// the outstanding rewards of the validator.
+//
+// It fails if the delegation already has a starting info.
+func (k Keeper) RepairDelegationStartingInfo(ctx context.Context, valAddr sdk.ValAddress, delAddr sdk.AccAddress) error {
+	found, err := k.HasDelegatorStartingInfo(ctx, valAddr, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "delegation of %s to %s already has a starting info", delAddr, valAddr)
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if val == nil {
+		return errorsmod.Wrap(types.ErrNoValidatorExists, valAddr.String())
+	}
+
+	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if del == nil {
+		return types.ErrNoDelegationExists
+	}
+
+	// end the current period so the delegation starts after the rewards it
+	// missed, then track the period just ended like a new delegation would
+	period, err := k.IncrementValidatorPeriod(ctx, val)
+	if err != nil {
+		return err
+	}
+
+	if err := k.initializeDelegation(ctx, valAddr, delAddr); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRepairStartingInfo,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPeriod, strconv.FormatUint(period, 10)),
+		),
+	)
+
+	return nil
+}
+
+// RepairAllMissingStartingInfos repairs the starting info of every delegation
+// which lacks one, returning the number of delegations repaired. It is meant
+// to be called from an upgrade handler.
+func (k Keeper) RepairAllMissingStartingInfos(ctx context.Context) (int, error) {
+	delegations, err := k.stakingKeeper.GetAllSDKDelegations(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	repaired := 0
+	for _, del := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+		if err != nil {
+			return repaired, err
+		}
+
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(del.GetDelegatorAddr())
+		if err != nil {
+			return repaired, err
+		}
+
+		found, err := k.HasDelegatorStartingInfo(ctx, valAddr, delAddr)
+		if err != nil {
+			return repaired, err
+		}
+
+		if found {
+			continue
+		}
+
+		if err := k.RepairDelegationStartingInfo(ctx, valAddr, delAddr); err != nil {
+			return repaired, err
+		}
+
+		repaired++
+	}
+
+	return repaired, nil
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// dropStartingInfo deletes the starting info of the delegation along with its
+// reference, as a botched migration would.
+func (f *validatorFixture) dropStartingInfo(t *testing.T, delAddr sdk.AccAddress) {
+	t.Helper()
+
+	info, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+	require.NoError(t, err)
+	require.NoError(t, f.Keeper.DeleteDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr))
+
+	historical, err := f.Keeper.GetValidatorHistoricalRewards(f.Ctx, f.valAddr, info.PreviousPeriod)
+	require.NoError(t, err)
+	historical.ReferenceCount--
+	if historical.ReferenceCount == 0 {
+		require.NoError(t, f.Keeper.DeleteValidatorHistoricalReward(f.Ctx, f.valAddr, info.PreviousPeriod))
+	} else {
+		require.NoError(t, f.Keeper.SetValidatorHistoricalRewards(f.Ctx, f.valAddr, info.PreviousPeriod, historical))
+	}
+}
+
+func TestRepairAllMissingStartingInfos(t *testing.T) {
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+	f.StakingKeeper.EXPECT().GetAllSDKDelegations(gomock.Any()).DoAndReturn(
+		func(context.Context) ([]stakingtypes.Delegation, error) {
+			delegations := make([]stakingtypes.Delegation, len(f.delAddrs))
+			for i, delAddr := range f.delAddrs {
+				delegations[i], _ = f.delegation(delAddr)
+			}
+			return delegations, nil
+		},
+	).AnyTimes()
+
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1000)))
+	broken := f.delAddrs[1]
+	f.dropStartingInfo(t, broken)
+	require.Empty(t, f.Keeper.ReferenceCountMismatches(f.Ctx, nil))
+
+	_, err := f.Keeper.WithdrawDelegationRewards(f.Ctx, broken, f.valAddr)
+	require.Error(t, err)
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	repaired, err := f.Keeper.RepairAllMissingStartingInfos(f.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, repaired)
+	require.Empty(t, f.Keeper.ReferenceCountMismatches(f.Ctx, nil))
+
+	var events []sdk.Event
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeRepairStartingInfo {
+			events = append(events, event)
+		}
+	}
+	require.Len(t, events, 1)
+	delegator, ok := events[0].GetAttribute(disttypes.AttributeKeyDelegator)
+	require.True(t, ok)
+	require.Equal(t, broken.String(), delegator.Value)
+
+	// the rewards accrued before the repair are forfeited
+	require.True(t, f.rewards(t)[1].IsZero())
+
+	// the repaired delegation accrues and withdraws rewards again
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1000)))
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 225)), f.rewards(t)[1])
+	f.withdrawAll(t)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 225)), f.Payouts[broken.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 450)), f.Payouts[f.delAddrs[2].String()])
+	require.Empty(t, f.Keeper.ReferenceCountMismatches(f.Ctx, nil))
+
+	// the forfeited rewards remain outstanding along with the commission
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 200+225)), f.outstanding(t))
+
+	// nothing is left to repair
+	repaired, err = f.Keeper.RepairAllMissingStartingInfos(f.Ctx)
+	require.NoError(t, err)
+	require.Zero(t, repaired)
+
+	err = f.Keeper.RepairDelegationStartingInfo(f.Ctx, f.valAddr, broken)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
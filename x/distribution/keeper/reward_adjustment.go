@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ApplyRewardAdjustment adjusts the rewards of validators which were
+// misallocated between startHeight and endHeight, records the adjustment in
+// the reward adjustment audit log and returns its id.
+//
+// An amount credited to a validator is financed from the community pool and
+// allocated to the validator like block rewards, split between its commission
+// and its delegators. An amount deducted from a validator reverses such an
+// allocation and is credited to the community pool: its commission share is
+// deducted from the accumulated commission of the validator, and the rest from
+// its current rewards, which are not attributed to the delegators yet. The
+// deductions are applied first, so that the community pool only finances the
+// net amount credited, and the module account balance is unchanged.
+func (k Keeper) ApplyRewardAdjustment(
+	ctx context.Context, authority string, adjustments []types.ValidatorRewardAdjustment,
+	justification string, startHeight, endHeight int64,
+) (uint64, error) {
+	validators := make([]stakingtypes.ValidatorI, len(adjustments))
+	for i, adjustment := range adjustments {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(adjustment.ValidatorAddress)
+		if err != nil {
+			return 0, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+
+		validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+		if err != nil {
+			return 0, err
+		}
+
+		if validator == nil {
+			return 0, errorsmod.Wrapf(types.ErrNoValidatorExists, "%s", adjustment.ValidatorAddress)
+		}
+		validators[i] = validator
+	}
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var credited sdk.DecCoins
+	for i, adjustment := range adjustments {
+		if !adjustment.Deduct {
+			credited = credited.Add(adjustment.Amount...)
+			continue
+		}
+
+		if err := k.deductValidatorRewards(ctx, validators[i], adjustment.Amount); err != nil {
+			return 0, err
+		}
+		feePool.CommunityPool = feePool.CommunityPool.Add(adjustment.Amount...)
+	}
+
+	pool, negative := feePool.CommunityPool.SafeSub(credited)
+	if negative {
+		return 0, errorsmod.Wrapf(types.ErrBadDistribution, "community pool %s cannot finance the credited rewards %s", feePool.CommunityPool, credited)
+	}
+	feePool.CommunityPool = pool
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return 0, err
+	}
+
+	for i, adjustment := range adjustments {
+		if adjustment.Deduct {
+			continue
+		}
+
+		if err := k.AllocateTokensToValidator(ctx, validators[i], adjustment.Amount); err != nil {
+			return 0, err
+		}
+	}
+
+	id, err := k.RewardAdjustmentSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record := types.RewardAdjustmentRecord{
+		Id:            id,
+		Height:        sdkCtx.BlockHeight(),
+		Authority:     authority,
+		Justification: justification,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+		Adjustments:   adjustments,
+	}
+	if proposal, ok := govtypes.ExecutingProposalFromContext(ctx); ok {
+		record.ProposalId = proposal.ID
+		record.ProposalTitle = proposal.Title
+	}
+
+	if err := k.RewardAdjustments.Set(ctx, id, record); err != nil {
+		return 0, err
+	}
+
+	for _, adjustment := range adjustments {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardAdjustment,
+				sdk.NewAttribute(types.AttributeKeyAdjustmentID, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(types.AttributeKeyValidator, adjustment.ValidatorAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, adjustment.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDeduct, strconv.FormatBool(adjustment.Deduct)),
+			),
+		)
+	}
+
+	return id, nil
+}
+
+// deductValidatorRewards deducts an amount from the rewards of a validator,
+// split between its accumulated commission and its current rewards according
+// to its commission rate. The deduction fails with ErrRewardAdjustmentOverdraw
+// if it exceeds the outstanding rewards, the accumulated commission or the
+// current rewards of the validator.
+func (k Keeper) deductValidatorRewards(ctx context.Context, val stakingtypes.ValidatorI, amount sdk.DecCoins) error {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	commission := amount.MulDec(val.GetCommission())
+	shared := amount.Sub(commission)
+
+	outstanding, err := k.GetValidatorOutstandingRewards(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	remaining, negative := outstanding.Rewards.SafeSub(amount)
+	if negative {
+		return errorsmod.Wrapf(types.ErrRewardAdjustmentOverdraw, "validator %s outstanding rewards %s are below %s", val.GetOperator(), outstanding.Rewards, amount)
+	}
+	outstanding.Rewards = remaining
+
+	accumulated, err := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	remaining, negative = accumulated.Commission.SafeSub(commission)
+	if negative {
+		return errorsmod.Wrapf(types.ErrRewardAdjustmentOverdraw, "validator %s accumulated commission %s is below %s", val.GetOperator(), accumulated.Commission, commission)
+	}
+	accumulated.Commission = remaining
+
+	current, err := k.GetValidatorCurrentRewards(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	remaining, negative = current.Rewards.SafeSub(shared)
+	if negative {
+		return errorsmod.Wrapf(types.ErrRewardAdjustmentOverdraw, "validator %s current rewards %s are below %s", val.GetOperator(), current.Rewards, shared)
+	}
+	current.Rewards = remaining
+
+	if err := k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding); err != nil {
+		return err
+	}
+
+	if err := k.SetValidatorAccumulatedCommission(ctx, valAddr, accumulated); err != nil {
+		return err
+	}
+
+	return k.SetValidatorCurrentRewards(ctx, valAddr, current)
+}
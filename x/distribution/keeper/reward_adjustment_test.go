@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// applyRewardAdjustment applies a single validator adjustment to the
+// validator of the fixture through the msg server.
+func (f *validatorFixture) applyRewardAdjustment(amount sdk.DecCoins, deduct bool) (uint64, error) {
+	msg := disttypes.NewMsgApplyRewardAdjustment(
+		authtypes.NewModuleAddress("gov").String(),
+		[]disttypes.ValidatorRewardAdjustment{{ValidatorAddress: f.valAddr.String(), Amount: amount, Deduct: deduct}},
+		"rewards misallocated by a bug", 1, f.Ctx.BlockHeight(),
+	)
+	res, err := keeper.NewMsgServerImpl(f.Keeper).ApplyRewardAdjustment(f.Ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+	return res.Id, nil
+}
+
+// rewardAdjustments queries the reward adjustment audit log.
+func rewardAdjustments(t *testing.T, ctx sdk.Context, k keeper.Keeper) []disttypes.RewardAdjustmentRecord {
+	t.Helper()
+
+	res, err := keeper.NewQuerier(k).RewardAdjustments(ctx, &disttypes.QueryRewardAdjustmentsRequest{})
+	require.NoError(t, err)
+	return res.Adjustments
+}
+
+func TestApplyRewardAdjustment(t *testing.T) {
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+	feePool, err := f.Keeper.FeePool.Get(f.Ctx)
+	require.NoError(t, err)
+	feePool.CommunityPool = decCoins(decCoin(sdk.DefaultBondDenom, 10_000))
+	require.NoError(t, f.Keeper.FeePool.Set(f.Ctx, feePool))
+
+	// 10% of the allocation goes to the commission, the rest to the delegators
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1_000)))
+	commission := func() sdk.DecCoins {
+		res, err := f.Keeper.GetValidatorAccumulatedCommission(f.Ctx, f.valAddr)
+		require.NoError(t, err)
+		return res.Commission
+	}
+
+	// a deduction reverses the allocation and refills the community pool
+	id, err := f.applyRewardAdjustment(decCoins(decCoin(sdk.DefaultBondDenom, 500)), true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), id)
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 500)), f.outstanding(t))
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 50)), commission())
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 10_500)), f.communityPool(t))
+	require.Equal(t, []sdk.DecCoins{
+		decCoins(decCoin(sdk.DefaultBondDenom, 225)),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(1125, 1))),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(1125, 1))),
+	}, f.rewards(t))
+
+	// the rewards not owed to the validator cannot be overdrawn
+	_, err = f.applyRewardAdjustment(decCoins(decCoin(sdk.DefaultBondDenom, 501)), true)
+	require.ErrorIs(t, err, disttypes.ErrRewardAdjustmentOverdraw)
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 500)), f.outstanding(t))
+
+	// a credit is financed from the community pool
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	id, err = f.applyRewardAdjustment(decCoins(decCoin(sdk.DefaultBondDenom, 2_000)), false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 2_500)), f.outstanding(t))
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 250)), commission())
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 8_500)), f.communityPool(t))
+
+	var events []sdk.Event
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeRewardAdjustment {
+			events = append(events, event)
+		}
+	}
+	require.Equal(t, []sdk.Event{sdk.NewEvent(
+		disttypes.EventTypeRewardAdjustment,
+		sdk.NewAttribute(disttypes.AttributeKeyAdjustmentID, "1"),
+		sdk.NewAttribute(disttypes.AttributeKeyValidator, f.valAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, decCoins(decCoin(sdk.DefaultBondDenom, 2_000)).String()),
+		sdk.NewAttribute(disttypes.AttributeKeyDeduct, "false"),
+	)}, events)
+
+	// the community pool must cover the credit
+	_, err = f.applyRewardAdjustment(decCoins(decCoin(sdk.DefaultBondDenom, 8_501)), false)
+	require.ErrorIs(t, err, disttypes.ErrBadDistribution)
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 8_500)), f.communityPool(t))
+
+	// only the authority can adjust the rewards
+	msg := disttypes.NewMsgApplyRewardAdjustment(
+		f.delAddrs[1].String(),
+		[]disttypes.ValidatorRewardAdjustment{{ValidatorAddress: f.valAddr.String(), Amount: decCoins(decCoin(sdk.DefaultBondDenom, 1))}},
+		"rewards misallocated by a bug", 1, 2,
+	)
+	_, err = keeper.NewMsgServerImpl(f.Keeper).ApplyRewardAdjustment(f.Ctx, msg)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// the delegators withdraw the adjusted rewards, leaving the commission
+	f.withdrawAll(t)
+	require.Equal(t, commission(), f.outstanding(t))
+
+	// the applied adjustments are recorded, with the proposal executing them
+	f.Ctx = govtypes.WithExecutingProposal(f.Ctx, govtypes.ExecutingProposal{ID: 7, Title: "restore the rewards"})
+	id, err = f.applyRewardAdjustment(decCoins(decCoin(sdk.DefaultBondDenom, 100)), false)
+	require.NoError(t, err)
+
+	records := rewardAdjustments(t, f.Ctx, f.Keeper)
+	require.Len(t, records, 3)
+	require.Equal(t, disttypes.RewardAdjustmentRecord{
+		Id:            id,
+		Height:        f.Ctx.BlockHeight(),
+		Authority:     authtypes.NewModuleAddress("gov").String(),
+		Justification: "rewards misallocated by a bug",
+		StartHeight:   1,
+		EndHeight:     f.Ctx.BlockHeight(),
+		Adjustments: []disttypes.ValidatorRewardAdjustment{{
+			ValidatorAddress: f.valAddr.String(),
+			Amount:           decCoins(decCoin(sdk.DefaultBondDenom, 100)),
+		}},
+		ProposalId:    7,
+		ProposalTitle: "restore the rewards",
+	}, records[2])
+	require.True(t, records[0].Adjustments[0].Deduct)
+	require.Zero(t, records[0].ProposalId)
+}
+
+func TestRewardAdjustmentsGenesis(t *testing.T) {
+	f := newWithholdingFixture(t)
+	record := disttypes.RewardAdjustmentRecord{
+		Id:            3,
+		Height:        10,
+		Authority:     f.Authority,
+		Justification: "rewards misallocated by a bug",
+		StartHeight:   1,
+		EndHeight:     5,
+		Adjustments: []disttypes.ValidatorRewardAdjustment{{
+			ValidatorAddress: f.valAddr.String(),
+			Amount:           decCoins(decCoin(sdk.DefaultBondDenom, 100)),
+			Deduct:           true,
+		}},
+	}
+	require.NoError(t, f.Keeper.RewardAdjustments.Set(f.Ctx, record.Id, record))
+
+	require.NoError(t, f.Keeper.SetPreviousProposerConsAddr(f.Ctx, valConsAddr0))
+	genState := f.Keeper.ExportGenesis(f.Ctx)
+	require.Equal(t, []disttypes.RewardAdjustmentRecord{record}, genState.RewardAdjustments)
+	require.NoError(t, disttypes.ValidateGenesis(genState))
+
+	g := newWithholdingFixture(t)
+	g.AccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), disttypes.ModuleName).Return(distrAcc).AnyTimes()
+	g.AccountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc)
+	g.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.Coins{})
+	g.Keeper.InitGenesis(g.Ctx, *genState)
+	require.Equal(t, genState.RewardAdjustments, rewardAdjustments(t, g.Ctx, g.Keeper))
+
+	// the ids of the imported records are not reused
+	next, err := g.Keeper.RewardAdjustmentSequence.Peek(g.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), next)
+
+	genState.RewardAdjustments = append(genState.RewardAdjustments, record)
+	require.ErrorContains(t, disttypes.ValidateGenesis(genState), "duplicate reward adjustment id 3")
+}
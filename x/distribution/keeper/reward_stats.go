@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetValidatorRewardDistributionStats returns the cumulative reward distribution
+// stats of a validator. Empty stats are returned if nothing was withdrawn yet.
+func (k Keeper) GetValidatorRewardDistributionStats(ctx context.Context, val sdk.ValAddress) (types.ValidatorRewardDistributionStats, error) {
+	stats, err := k.ValidatorRewardDistributionStats.Get(ctx, val)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorRewardDistributionStats{}, err
+	}
+
+	return stats, nil
+}
+
+// ResetValidatorRewardDistributionStats clears the cumulative reward
+// distribution stats of a validator.
+func (k Keeper) ResetValidatorRewardDistributionStats(ctx context.Context, val sdk.ValAddress) error {
+	return k.ValidatorRewardDistributionStats.Remove(ctx, val)
+}
+
+// recordDelegationRewardsWithdrawn attributes withdrawn delegation rewards to
+// either the self-bond of the validator, when the delegator is the validator
+// operator account, or to its external delegators. Nothing is recorded when
+// the reward distribution stats are disabled.
+func (k Keeper) recordDelegationRewardsWithdrawn(ctx context.Context, val sdk.ValAddress, del sdk.AccAddress, rewards sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.RewardDistributionStatsEnabled {
+		return nil
+	}
+
+	stats, err := k.GetValidatorRewardDistributionStats(ctx, val)
+	if err != nil {
+		return err
+	}
+
+	if sdk.AccAddress(val).Equals(del) {
+		stats.SelfBondRewards = stats.SelfBondRewards.Add(rewards...)
+	} else {
+		stats.ExternalRewards = stats.ExternalRewards.Add(rewards...)
+	}
+
+	return k.ValidatorRewardDistributionStats.Set(ctx, val, stats)
+}
+
+// recordCommissionWithdrawn adds withdrawn commission to the cumulative reward
+// distribution stats of a validator. Nothing is recorded when the reward
+// distribution stats are disabled.
+func (k Keeper) recordCommissionWithdrawn(ctx context.Context, val sdk.ValAddress, commission sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.RewardDistributionStatsEnabled {
+		return nil
+	}
+
+	stats, err := k.GetValidatorRewardDistributionStats(ctx, val)
+	if err != nil {
+		return err
+	}
+
+	stats.CommissionWithdrawn = stats.CommissionWithdrawn.Add(commission...)
+	return k.ValidatorRewardDistributionStats.Set(ctx, val, stats)
+}
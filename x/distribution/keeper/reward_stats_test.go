@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type rewardStatsFixture struct {
+	ctx        sdk.Context
+	keeper     keeper.Keeper
+	bankKeeper *distrtestutil.MockBankKeeper
+	authority  string
+	valAddr    sdk.ValAddress
+	selfAddr   sdk.AccAddress
+	extAddr    sdk.AccAddress
+	rewards    sdk.Coins
+	commission sdk.Coins
+}
+
+// newRewardStatsFixture returns a validator with 50% commission, a self-bond
+// and an external delegation of the same stake, and rewards allocated to it,
+// with the reward distribution stats enabled or not.
+func newRewardStatsFixture(t *testing.T, enabled bool) rewardStatsFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	authority := authtypes.NewModuleAddress("gov").String()
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authority,
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	params := disttypes.DefaultParams()
+	params.RewardDistributionStatsEnabled = enabled
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	selfAddr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	selfDel := stakingtypes.NewDelegation(selfAddr.String(), valAddr.String(), val.DelegatorShares)
+
+	// always return the latest validator state, as it is updated by the delegation below
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).DoAndReturn(
+		func(context.Context, sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+			return val, nil
+		},
+	).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), selfAddr, valAddr).Return(selfDel, nil).AnyTimes()
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, selfAddr, valAddr))
+
+	// external delegation with the same stake as the self-bond
+	extAddr := sdk.AccAddress(valConsAddr1)
+	_, extDel, err := distrtestutil.Delegate(ctx, distrKeeper, extAddr, &val, math.NewInt(100), nil, stakingKeeper)
+	require.NoError(t, err)
+
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), extAddr, valAddr).Return(extDel, nil).AnyTimes()
+	require.NoError(t, distrKeeper.Hooks().AfterDelegationModified(ctx, extAddr, valAddr))
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(40)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	return rewardStatsFixture{
+		ctx:        ctx,
+		keeper:     distrKeeper,
+		bankKeeper: bankKeeper,
+		authority:  authority,
+		valAddr:    valAddr,
+		selfAddr:   selfAddr,
+		extAddr:    extAddr,
+		rewards:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10))),
+		commission: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(20))),
+	}
+}
+
+func TestValidatorRewardDistributionStats(t *testing.T) {
+	f := newRewardStatsFixture(t, true)
+
+	// nothing has been withdrawn yet
+	stats, err := f.keeper.GetValidatorRewardDistributionStats(f.ctx, f.valAddr)
+	require.NoError(t, err)
+	require.True(t, stats.SelfBondRewards.IsZero())
+	require.True(t, stats.ExternalRewards.IsZero())
+	require.True(t, stats.CommissionWithdrawn.IsZero())
+
+	// the self-delegation withdrawal is attributed to the self-bond
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, f.selfAddr, f.rewards).Return(nil)
+	_, err = f.keeper.WithdrawDelegationRewards(f.ctx, f.selfAddr, f.valAddr)
+	require.NoError(t, err)
+
+	// the external withdrawal is attributed to the external delegators
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, f.extAddr, f.rewards).Return(nil)
+	_, err = f.keeper.WithdrawDelegationRewards(f.ctx, f.extAddr, f.valAddr)
+	require.NoError(t, err)
+
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, f.selfAddr, f.commission).Return(nil)
+	_, err = f.keeper.WithdrawValidatorCommission(f.ctx, f.valAddr)
+	require.NoError(t, err)
+
+	querier := keeper.NewQuerier(f.keeper)
+	res, err := querier.ValidatorRewardDistributionStats(f.ctx, &disttypes.QueryValidatorRewardDistributionStatsRequest{ValidatorAddress: f.valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, f.rewards, res.Stats.SelfBondRewards)
+	require.Equal(t, f.rewards, res.Stats.ExternalRewards)
+	require.Equal(t, f.commission, res.Stats.CommissionWithdrawn)
+
+	// withdrawing again without new rewards does not change the counters
+	_, err = f.keeper.WithdrawDelegationRewards(f.ctx, f.extAddr, f.valAddr)
+	require.NoError(t, err)
+
+	stats, err = f.keeper.GetValidatorRewardDistributionStats(f.ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, res.Stats, stats)
+
+	// the counters are part of the exported genesis
+	require.NoError(t, f.keeper.SetPreviousProposerConsAddr(f.ctx, valConsAddr0))
+	genState := f.keeper.ExportGenesis(f.ctx)
+	require.Equal(t, []disttypes.ValidatorRewardDistributionStatsRecord{
+		{ValidatorAddress: f.valAddr.String(), Stats: stats},
+	}, genState.ValidatorRewardDistributionStats)
+
+	// only the authority can reset the counters
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	_, err = msgServer.ResetValidatorRewardDistributionStats(f.ctx, &disttypes.MsgResetValidatorRewardDistributionStats{
+		Authority:        f.selfAddr.String(),
+		ValidatorAddress: f.valAddr.String(),
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.ResetValidatorRewardDistributionStats(f.ctx, &disttypes.MsgResetValidatorRewardDistributionStats{
+		Authority:        f.authority,
+		ValidatorAddress: f.valAddr.String(),
+	})
+	require.NoError(t, err)
+
+	stats, err = f.keeper.GetValidatorRewardDistributionStats(f.ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.ValidatorRewardDistributionStats{}, stats)
+}
+
+func TestValidatorRewardDistributionStatsDisabled(t *testing.T) {
+	f := newRewardStatsFixture(t, false)
+
+	// the withdrawals are paid out as usual
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, f.selfAddr, f.rewards).Return(nil)
+	_, err := f.keeper.WithdrawDelegationRewards(f.ctx, f.selfAddr, f.valAddr)
+	require.NoError(t, err)
+
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, f.extAddr, f.rewards).Return(nil)
+	_, err = f.keeper.WithdrawDelegationRewards(f.ctx, f.extAddr, f.valAddr)
+	require.NoError(t, err)
+
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, f.selfAddr, f.commission).Return(nil)
+	_, err = f.keeper.WithdrawValidatorCommission(f.ctx, f.valAddr)
+	require.NoError(t, err)
+
+	// but nothing is written to the stats
+	has, err := f.keeper.ValidatorRewardDistributionStats.Has(f.ctx, f.valAddr)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, f.keeper.SetPreviousProposerConsAddr(f.ctx, valConsAddr0))
+	require.Empty(t, f.keeper.ExportGenesis(f.ctx).ValidatorRewardDistributionStats)
+}
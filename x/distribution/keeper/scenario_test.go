@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+)
+
+func TestRewardScenarioGolden(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_003), sdk.NewInt64Coin("photon", 777))
+	del := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+
+	scenario := distrtestutil.NewRewardScenario().
+		WithValidator(100, math.LegacyNewDecWithPrec(1, 1)).
+		WithValidator(50, math.LegacyNewDecWithPrec(5, 2)).
+		WithValidator(20, math.LegacyZeroDec()).
+		WithDelegation(0, 0, del).
+		WithDelegation(1, 0, del.MulRaw(3)).
+		WithDelegation(1, 1, del).
+		WithDelegation(2, 2, del.MulRaw(2))
+	for block := int64(1); block <= 12; block++ {
+		scenario.AllocateFees(block, fees)
+	}
+	scenario.
+		Allocate(2, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500_000))).
+		Slash(3, 0, math.LegacyNewDecWithPrec(1, 1)).
+		Delegate(5, 0, 0, del).
+		Delegate(5, 3, 1, del.MulRaw(2)).
+		Withdraw(6, 1, 0).
+		Slash(8, 1, math.LegacyNewDecWithPrec(5, 2)).
+		Withdraw(10, 2, 2).
+		Delegate(11, 1, 1, del)
+	result := scenario.Run(t)
+
+	// a delegation only made during a block is also reported
+	require.False(t, result.Delegation(scenario.Delegator(3), scenario.Validator(1)).Accrued.IsZero())
+
+	result.AssertGolden(t, "reward_scenario.golden.json")
+}
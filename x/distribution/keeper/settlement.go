@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// canDeferSettlement reports whether the settlement of the rewards of a
+// delegation being modified can be deferred. It can when the delegation was
+// settled less than MinSettlementInterval blocks ago and its validator neither
+// ended a period nor accrued rewards since then: the delegation is owed nothing
+// for the blocks in between, so that the stake change can be merged into its
+// starting info instead.
+func (k Keeper) canDeferSettlement(ctx context.Context, val sdk.ValAddress, del sdk.AccAddress) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if params.MinSettlementInterval == 0 {
+		return false, nil
+	}
+
+	// the starting info is removed by a settlement until the delegation is
+	// initialized again
+	hasInfo, err := k.HasDelegatorStartingInfo(ctx, val, del)
+	if err != nil || !hasInfo {
+		return false, err
+	}
+
+	startingInfo, err := k.GetDelegatorStartingInfo(ctx, val, del)
+	if err != nil {
+		return false, err
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if height >= startingInfo.Height+params.MinSettlementInterval {
+		return false, nil
+	}
+
+	current, err := k.GetValidatorCurrentRewards(ctx, val)
+	if err != nil {
+		return false, err
+	}
+
+	// the rewards accrued in the current period would otherwise be shared with
+	// the stake added
+	return current.Period == startingInfo.PreviousPeriod+1 && current.Rewards.IsZero(), nil
+}
+
+// mergeDelegationStake records the stake of a delegation whose settlement was
+// deferred in its starting info, keeping its period and height:
+//
+//	new recorded stake = old recorded stake + newly added tokens
+//
+// The added tokens are negative when the delegation is decreased. As the
+// validator did not end a period since the delegation was settled, the stake is
+// computed from the shares of the delegation, exactly as a settlement would have
+// recorded it. The reference count of the period is left unchanged.
+func (k Keeper) mergeDelegationStake(ctx context.Context, val sdk.ValAddress, del sdk.AccAddress) error {
+	startingInfo, err := k.GetDelegatorStartingInfo(ctx, val, del)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.Validator(ctx, val)
+	if err != nil {
+		return err
+	}
+
+	delegation, err := k.stakingKeeper.Delegation(ctx, del, val)
+	if err != nil {
+		return err
+	}
+
+	// truncated as in initializeDelegation
+	startingInfo.Stake = validator.TokensFromSharesTruncated(delegation.GetShares())
+	return k.SetDelegatorStartingInfo(ctx, val, del, startingInfo)
+}
@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func settlementParams(interval uint64) disttypes.Params {
+	params := disttypes.DefaultParams()
+	params.MinSettlementInterval = interval
+	return params
+}
+
+// undelegate removes the shares from a delegation, calling the hooks in the
+// order of the staking keeper.
+func (f *validatorFixture) undelegate(t *testing.T, delAddr sdk.AccAddress, shares math.LegacyDec) {
+	t.Helper()
+
+	hooks := f.Keeper.Hooks()
+	require.NoError(t, hooks.BeforeDelegationSharesModified(f.Ctx, delAddr, f.valAddr))
+
+	key := distrtestutil.DelegationKey(delAddr, f.valAddr)
+	del := f.Delegations[key]
+	del.Shares = del.Shares.Sub(shares)
+	if del.Shares.IsZero() {
+		require.NoError(t, hooks.BeforeDelegationRemoved(f.Ctx, delAddr, f.valAddr))
+		delete(f.Delegations, key)
+	} else {
+		f.Delegations[key] = del
+		require.NoError(t, hooks.AfterDelegationModified(f.Ctx, delAddr, f.valAddr))
+	}
+
+	*f.val, _ = f.val.RemoveDelShares(shares)
+}
+
+// deferred reports whether the last settlement of the delegation happened
+// before the current block.
+func (f *validatorFixture) deferred(t *testing.T, delAddr sdk.AccAddress) bool {
+	t.Helper()
+
+	info, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+	require.NoError(t, err)
+	return info.Height < uint64(f.Ctx.BlockHeight())
+}
+
+// runSmallDelegations applies a randomized sequence of small delegation
+// changes, some of them in blocks in which the validator is allocated rewards
+// or slashed, and returns the number of deferred settlements.
+func (f *validatorFixture) runSmallDelegations(t *testing.T, seed int64, blocks int) int {
+	t.Helper()
+
+	deferred := 0
+	r := rand.New(rand.NewSource(seed))
+	for i := 0; i < blocks; i++ {
+		f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+
+		if r.Intn(3) == 0 {
+			tokens := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(r.Int63n(1_000_000_000)+1, 3)))
+			require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, f.val, tokens))
+		}
+
+		if r.Intn(8) == 0 {
+			distrtestutil.SlashValidator(f.Ctx, valConsAddr0, f.Ctx.BlockHeight(), 100,
+				math.LegacyNewDecWithPrec(1, 1), f.val, &f.Keeper, f.StakingKeeper)
+		}
+
+		for n := r.Intn(4); n > 0; n-- {
+			delAddr := f.delAddrs[r.Intn(len(f.delAddrs))]
+			del, ok := f.delegation(delAddr)
+			switch {
+			case !ok:
+				f.delegate(t, delAddr, math.NewInt(r.Int63n(1_000_000)+1))
+			case delAddr.Equals(f.delAddrs[0]) || r.Intn(4) != 0:
+				f.delegate(t, delAddr, math.NewInt(r.Int63n(1_000)+1))
+			case r.Intn(2) == 0:
+				f.undelegate(t, delAddr, del.Shares.QuoInt64(r.Int63n(5)+2))
+			default:
+				f.undelegate(t, delAddr, del.Shares)
+				continue
+			}
+
+			if f.deferred(t, delAddr) {
+				deferred++
+			}
+		}
+
+		require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+	}
+
+	return deferred
+}
+
+// owed returns the rewards paid out to and pending for each delegator.
+func (f *validatorFixture) owed(t *testing.T) map[string]sdk.DecCoins {
+	t.Helper()
+
+	owed := make(map[string]sdk.DecCoins)
+	for i, rewards := range f.rewards(t) {
+		owed[f.delAddrs[i].String()] = rewards
+	}
+	for delAddr, payouts := range f.Payouts {
+		owed[delAddr] = owed[delAddr].Add(sdk.NewDecCoinsFromCoins(payouts...)...)
+	}
+
+	return owed
+}
+
+func TestDeferredSettlementDifferential(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		for _, interval := range []uint64{3, 10} {
+			unthrottled := newValidatorFixture(t, disttypes.DefaultParams())
+			require.Zero(t, unthrottled.runSmallDelegations(t, seed, 60))
+
+			throttled := newValidatorFixture(t, settlementParams(interval))
+			require.Positive(t, throttled.runSmallDelegations(t, seed, 60), "seed %d: no settlement was deferred", seed)
+
+			// the removed delegations were settled, releasing their periods
+			for _, f := range []*validatorFixture{unthrottled, throttled} {
+				_, broken := keeper.ReferenceCountInvariant(f.Keeper)(f.Ctx)
+				require.False(t, broken, "seed %d, interval %d", seed, interval)
+
+				for _, delAddr := range f.delAddrs {
+					if _, ok := f.delegation(delAddr); !ok {
+						hasInfo, err := f.Keeper.HasDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+						require.NoError(t, err)
+						require.False(t, hasInfo)
+						f.delegate(t, delAddr, math.NewInt(1))
+					}
+				}
+			}
+			require.Equal(t, unthrottled.Keeper.GetValidatorHistoricalReferenceCount(unthrottled.Ctx),
+				throttled.Keeper.GetValidatorHistoricalReferenceCount(throttled.Ctx), "seed %d, interval %d", seed, interval)
+
+			// the rewards owed to the delegators are unchanged
+			require.Equal(t, unthrottled.owed(t), throttled.owed(t), "seed %d, interval %d", seed, interval)
+
+			// and so are the amounts eventually paid out
+			unthrottled.withdrawAll(t)
+			throttled.withdrawAll(t)
+			require.Equal(t, unthrottled.Payouts, throttled.Payouts, "seed %d, interval %d", seed, interval)
+			require.Equal(t, unthrottled.outstanding(t), throttled.outstanding(t), "seed %d, interval %d", seed, interval)
+			require.Equal(t, unthrottled.communityPool(t), throttled.communityPool(t), "seed %d, interval %d", seed, interval)
+		}
+	}
+}
+
+func TestDeferredSettlementFallback(t *testing.T) {
+	f := newValidatorFixture(t, settlementParams(5))
+	// the last delegation of the fixture, created in the current period
+	delAddr := f.delAddrs[2]
+	startingHeight := uint64(f.Ctx.BlockHeight())
+	startingInfo, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+	require.NoError(t, err)
+	historical, err := f.Keeper.GetValidatorHistoricalRewards(f.Ctx, f.valAddr, startingInfo.PreviousPeriod)
+	require.NoError(t, err)
+
+	// the stake is merged into the starting info, which keeps its period and
+	// the reference to it
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+	f.delegate(t, delAddr, math.NewInt(1_000))
+	info, err := f.Keeper.GetDelegatorStartingInfo(f.Ctx, f.valAddr, delAddr)
+	require.NoError(t, err)
+	require.Equal(t, startingHeight, info.Height)
+	require.Equal(t, startingInfo.PreviousPeriod, info.PreviousPeriod)
+	require.Equal(t, sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction).AddRaw(1_000), info.Stake.TruncateInt())
+	merged, err := f.Keeper.GetValidatorHistoricalRewards(f.Ctx, f.valAddr, info.PreviousPeriod)
+	require.NoError(t, err)
+	require.Equal(t, historical.ReferenceCount, merged.ReferenceCount)
+
+	// the delegation is settled once the validator accrued rewards
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1_000)))
+	f.delegate(t, delAddr, math.NewInt(1_000))
+	require.False(t, f.deferred(t, delAddr))
+	require.Len(t, f.withdrawEvents(), 1)
+
+	// or ended a period
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+	distrtestutil.SlashValidator(f.Ctx, valConsAddr0, f.Ctx.BlockHeight(), 100,
+		math.LegacyNewDecWithPrec(1, 1), f.val, &f.Keeper, f.StakingKeeper)
+	f.delegate(t, delAddr, math.NewInt(1_000))
+	require.False(t, f.deferred(t, delAddr))
+
+	// or once the interval elapsed
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 4)
+	f.delegate(t, delAddr, math.NewInt(1_000))
+	require.True(t, f.deferred(t, delAddr))
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+	f.delegate(t, delAddr, math.NewInt(1_000))
+	require.False(t, f.deferred(t, delAddr))
+}
+
+// storeWrites returns the number of writes and deletes made to the store of the
+// keeper by fn.
+func (f *validatorFixture) storeWrites(fn func()) uint64 {
+	ctx := f.Ctx
+	f.Ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithKVGasConfig(storetypes.GasConfig{WriteCostFlat: 1, DeleteCost: 1})
+	fn()
+
+	writes := f.Ctx.GasMeter().GasConsumed()
+	f.Ctx = f.Ctx.WithGasMeter(ctx.GasMeter()).WithKVGasConfig(ctx.KVGasConfig())
+	return writes
+}
+
+func TestDeferredSettlementStoreWrites(t *testing.T) {
+	deferredFixture := newValidatorFixture(t, settlementParams(5))
+	settledFixture := newValidatorFixture(t, disttypes.DefaultParams())
+
+	writes := make([]uint64, 2)
+	for i, f := range []*validatorFixture{deferredFixture, settledFixture} {
+		f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+		writes[i] = f.storeWrites(func() {
+			f.delegate(t, f.delAddrs[2], math.NewInt(1_000))
+		})
+	}
+	require.True(t, deferredFixture.deferred(t, deferredFixture.delAddrs[2]))
+	require.False(t, settledFixture.deferred(t, settledFixture.delAddrs[2]))
+
+	// a deferred settlement only writes the starting info of the delegation,
+	// while a settlement ends the period of the validator and moves the
+	// delegation to the next one
+	require.Equal(t, uint64(1), writes[0])
+	require.Greater(t, writes[1], writes[0])
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// recordCommunityPoolSpend appends an executed community pool spend to the
+// spend history, attributing it to the executing governance proposal when the
+// spend is executed by x/gov. Nothing is recorded when the history is disabled.
+func (k Keeper) recordCommunityPoolSpend(ctx context.Context, authority, recipient string, amount sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.CommunityPoolSpendHistoryRetention == 0 {
+		return nil
+	}
+
+	id, err := k.CommunityPoolSpendSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	record := types.CommunityPoolSpendRecord{
+		Id:        id,
+		Height:    height,
+		Authority: authority,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+	if proposal, ok := govtypes.ExecutingProposalFromContext(ctx); ok {
+		record.ProposalId = proposal.ID
+		record.ProposalTitle = proposal.Title
+	}
+
+	return k.CommunityPoolSpendHistory.Set(ctx, collections.Join(height, id), record)
+}
+
+// PruneCommunityPoolSpendHistory removes the community pool spends executed
+// CommunityPoolSpendHistoryRetention or more blocks ago. The history is
+// ordered by height, so only the pruned entries are visited. Nothing is pruned
+// while the history is disabled.
+func (k Keeper) PruneCommunityPoolSpendHistory(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// keep the spends of the heights within (height - retention, height]
+	retention := params.CommunityPoolSpendHistoryRetention
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if retention == 0 || retention > uint64(height) {
+		return nil
+	}
+	end := height - int64(retention) + 1
+
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).EndExclusive(collections.Join(end, uint64(0)))
+	return k.CommunityPoolSpendHistory.Clear(ctx, rng)
+}
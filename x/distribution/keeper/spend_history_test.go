@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var spendHistoryAuthority = authtypes.NewModuleAddress("gov").String()
+
+// newSpendHistoryKeeper returns a keeper with a funded community pool and the
+// given community pool spend history retention.
+func newSpendHistoryKeeper(t *testing.T, retention uint64) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixConsAddr)).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		spendHistoryAuthority,
+	)
+
+	params := disttypes.DefaultParams()
+	params.CommunityPoolSpendHistoryRetention = retention
+	require.NoError(t, k.Params.Set(ctx, params))
+	require.NoError(t, k.FeePool.Set(ctx, disttypes.FeePool{
+		CommunityPool: sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1_000_000_000)),
+	}))
+
+	return ctx, k
+}
+
+// spend executes a community pool spend of amount to the recipient with the
+// given index.
+func spend(t *testing.T, ctx sdk.Context, k keeper.Keeper, recipient int, amount int64) {
+	t.Helper()
+
+	_, err := keeper.NewMsgServerImpl(k).CommunityPoolSpend(ctx, &disttypes.MsgCommunityPoolSpend{
+		Authority: spendHistoryAuthority,
+		Recipient: sdk.AccAddress(fmt.Sprintf("recipient-%d", recipient)).String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+	})
+	require.NoError(t, err)
+}
+
+func spendHistory(t *testing.T, ctx sdk.Context, k keeper.Keeper) []disttypes.CommunityPoolSpendRecord {
+	t.Helper()
+
+	res, err := keeper.NewQuerier(k).CommunityPoolSpendHistory(ctx, &disttypes.QueryCommunityPoolSpendHistoryRequest{})
+	require.NoError(t, err)
+	return res.Spends
+}
+
+func TestCommunityPoolSpendHistoryAttribution(t *testing.T) {
+	ctx, k := newSpendHistoryKeeper(t, 100)
+
+	// direct execution by the authority
+	spend(t, ctx, k, 0, 10)
+
+	// execution by a governance proposal
+	govCtx := govtypes.WithExecutingProposal(ctx.WithBlockHeight(2), govtypes.ExecutingProposal{ID: 7, Title: "fund the docs"})
+	spend(t, govCtx, k, 1, 20)
+
+	history := spendHistory(t, ctx, k)
+	require.Equal(t, []disttypes.CommunityPoolSpendRecord{
+		{
+			Id:        0,
+			Height:    1,
+			Authority: spendHistoryAuthority,
+			Recipient: sdk.AccAddress("recipient-0").String(),
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		},
+		{
+			Id:            1,
+			Height:        2,
+			Authority:     spendHistoryAuthority,
+			Recipient:     sdk.AccAddress("recipient-1").String(),
+			Amount:        sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)),
+			ProposalId:    7,
+			ProposalTitle: "fund the docs",
+		},
+	}, history)
+}
+
+func TestCommunityPoolSpendHistoryDisabled(t *testing.T) {
+	ctx, k := newSpendHistoryKeeper(t, 0)
+	spend(t, ctx, k, 0, 10)
+
+	require.Empty(t, spendHistory(t, ctx, k))
+
+	feePool, err := k.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1_000_000_000-10)), feePool.CommunityPool)
+}
+
+func TestCommunityPoolSpendHistoryPruning(t *testing.T) {
+	ctx, k := newSpendHistoryKeeper(t, 5)
+
+	for height := int64(1); height <= 10; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		spend(t, ctx, k, int(height), height)
+		spend(t, ctx, k, int(height), height)
+		require.NoError(t, k.EndBlocker(ctx))
+
+		history := spendHistory(t, ctx, k)
+		minHeight := max(1, height-4)
+		require.Len(t, history, 2*int(height-minHeight+1))
+		require.Equal(t, minHeight, history[0].Height)
+		require.Equal(t, height, history[len(history)-1].Height)
+	}
+
+	// lowering the retention prunes the history on the next block
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CommunityPoolSpendHistoryRetention = 1
+	require.NoError(t, k.Params.Set(ctx, params))
+	require.NoError(t, k.EndBlocker(ctx.WithBlockHeight(11)))
+	require.Empty(t, spendHistory(t, ctx, k))
+}
+
+func TestCommunityPoolSpendHistoryPruningDisabled(t *testing.T) {
+	ctx, k := newSpendHistoryKeeper(t, 5)
+	spend(t, ctx, k, 0, 10)
+
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CommunityPoolSpendHistoryRetention = 0
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	// disabling the history stops the pruning, which only reads the params
+	ctx = ctx.WithBlockHeight(100)
+	paramsCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = k.Params.Get(paramsCtx)
+	require.NoError(t, err)
+
+	pruneCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.NoError(t, k.PruneCommunityPoolSpendHistory(pruneCtx))
+	require.Equal(t, paramsCtx.GasMeter().GasConsumed(), pruneCtx.GasMeter().GasConsumed())
+	require.Len(t, spendHistory(t, ctx, k), 1)
+}
+
+func TestCommunityPoolSpendHistoryPagination(t *testing.T) {
+	ctx, k := newSpendHistoryKeeper(t, 1000)
+
+	// 300 spends over 100 blocks
+	for i := range 300 {
+		spend(t, ctx.WithBlockHeight(int64(1+i/3)), k, i, int64(i+1))
+	}
+
+	querier := keeper.NewQuerier(k)
+	collect := func(minHeight, maxHeight int64, limit uint64) []disttypes.CommunityPoolSpendRecord {
+		var (
+			spends  []disttypes.CommunityPoolSpendRecord
+			nextKey []byte
+		)
+		for {
+			res, err := querier.CommunityPoolSpendHistory(ctx, &disttypes.QueryCommunityPoolSpendHistoryRequest{
+				MinHeight:  minHeight,
+				MaxHeight:  maxHeight,
+				Pagination: &query.PageRequest{Key: nextKey, Limit: limit},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(res.Spends)), limit)
+			spends = append(spends, res.Spends...)
+			if len(res.Pagination.NextKey) == 0 {
+				return spends
+			}
+			nextKey = res.Pagination.NextKey
+		}
+	}
+
+	all := collect(0, 0, 7)
+	require.Len(t, all, 300)
+	for i, record := range all {
+		require.Equal(t, uint64(i), record.Id)
+		require.Equal(t, int64(1+i/3), record.Height)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(i+1))), record.Amount)
+	}
+
+	filtered := collect(20, 29, 4)
+	require.Equal(t, all[57:87], filtered)
+
+	require.Equal(t, all[270:], collect(91, 0, 50))
+
+	res, err := querier.CommunityPoolSpendHistory(ctx, &disttypes.QueryCommunityPoolSpendHistoryRequest{
+		Pagination: &query.PageRequest{Offset: 290, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, all[290:], res.Spends)
+	require.Equal(t, uint64(300), res.Pagination.Total)
+
+	_, err = querier.CommunityPoolSpendHistory(ctx, &disttypes.QueryCommunityPoolSpendHistoryRequest{MinHeight: 5, MaxHeight: 4})
+	require.ErrorContains(t, err, "min height greater than max height")
+}
+
+func TestCommunityPoolSpendHistoryGenesis(t *testing.T) {
+	ctx, k := newSpendHistoryKeeper(t, 100)
+	for height := int64(1); height <= 5; height++ {
+		spend(t, ctx.WithBlockHeight(height), k, int(height), height)
+	}
+	require.NoError(t, k.SetPreviousProposerConsAddr(ctx, valConsAddr0))
+
+	genState := k.ExportGenesis(ctx)
+	require.Len(t, genState.CommunityPoolSpendHistory, 5)
+	require.NoError(t, disttypes.ValidateGenesis(genState))
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	newCtx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 6})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), disttypes.ModuleName).Return(distrAcc)
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixConsAddr)).AnyTimes()
+	holdings, _ := genState.FeePool.CommunityPool.TruncateDecimal()
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(holdings)
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	newKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		spendHistoryAuthority,
+	)
+	newKeeper.InitGenesis(newCtx, *genState)
+	require.Equal(t, genState.CommunityPoolSpendHistory, newKeeper.ExportGenesis(newCtx).CommunityPoolSpendHistory)
+
+	// the ids of new spends continue after the imported ones
+	spend(t, newCtx, newKeeper, 6, 6)
+	history := spendHistory(t, newCtx, newKeeper)
+	require.Len(t, history, 6)
+	require.Equal(t, uint64(5), history[5].Id)
+	require.Equal(t, int64(6), history[5].Height)
+
+	// duplicate ids are rejected
+	genState.CommunityPoolSpendHistory[1].Id = genState.CommunityPoolSpendHistory[0].Id
+	require.ErrorContains(t, disttypes.ValidateGenesis(genState), "duplicate community pool spend id 0")
+}
@@ -6,21 +6,47 @@ import (
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // GetDelegatorWithdrawAddr get the delegator withdraw address, defaulting to the delegator address
 func (k Keeper) GetDelegatorWithdrawAddr(ctx context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, error) {
+	withdrawAddr, _, err := k.getDelegatorWithdrawAddr(ctx, delAddr)
+	return withdrawAddr, err
+}
+
+// getDelegatorWithdrawAddr returns the withdraw address of a delegator and its
+// source, in order of precedence: the first withdraw address provider answering
+// for the delegator, the withdraw address set by the delegator, or the delegator
+// address itself. A blocked address answered by a provider is rejected.
+func (k Keeper) getDelegatorWithdrawAddr(ctx context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, string, error) {
+	for _, provider := range k.withdrawAddressProviders {
+		withdrawAddr, ok, err := provider.GetWithdrawAddress(ctx, delAddr)
+		if err != nil {
+			return nil, "", err
+		}
+		if !ok {
+			continue
+		}
+
+		if k.bankKeeper.BlockedAddr(withdrawAddr) {
+			return nil, "", errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+		}
+		return withdrawAddr, types.AttributeValueWithdrawAddressProvider, nil
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
 	b, err := store.Get(types.GetDelegatorWithdrawAddrKey(delAddr))
 	if b == nil {
-		return delAddr, err
+		return delAddr, types.AttributeValueWithdrawAddressDelegator, err
 	}
-	return b, nil
+	return b, types.AttributeValueWithdrawAddressStored, nil
 }
 
 // SetDelegatorWithdrawAddr sets the delegator withdraw address
@@ -86,8 +112,14 @@ func (k Keeper) GetDelegatorStartingInfo(ctx context.Context, val sdk.ValAddress
 		return period, err
 	}
 
-	err = k.cdc.Unmarshal(b, &period)
-	return period, err
+	if b == nil {
+		return period, errorsmod.Wrapf(types.ErrNoDelegatorStartingInfo, "validator %s, delegator %s", val, del)
+	}
+
+	if err := k.cdc.Unmarshal(b, &period); err != nil {
+		return period, errorsmod.Wrapf(types.ErrInvalidRecord, "starting info of validator %s, delegator %s: %s", val, del, err)
+	}
+	return period, nil
 }
 
 // SetDelegatorStartingInfo sets the starting info associated with a delegator
@@ -136,8 +168,14 @@ func (k Keeper) GetValidatorHistoricalRewards(ctx context.Context, val sdk.ValAd
 		return rewards, err
 	}
 
-	err = k.cdc.Unmarshal(b, &rewards)
-	return rewards, err
+	if b == nil {
+		return rewards, errorsmod.Wrapf(types.ErrNoHistoricalRewards, "validator %s, period %d", val, period)
+	}
+
+	if err := k.cdc.Unmarshal(b, &rewards); err != nil {
+		return rewards, errorsmod.Wrapf(types.ErrInvalidRecord, "historical rewards of validator %s, period %d: %s", val, period, err)
+	}
+	return rewards, nil
 }
 
 // SetValidatorHistoricalRewards sets historical rewards for a particular period
@@ -213,8 +251,10 @@ func (k Keeper) GetValidatorCurrentRewards(ctx context.Context, val sdk.ValAddre
 		return rewards, err
 	}
 
-	err = k.cdc.Unmarshal(b, &rewards)
-	return rewards, err
+	if err := k.cdc.Unmarshal(b, &rewards); err != nil {
+		return rewards, errorsmod.Wrapf(types.ErrInvalidRecord, "current rewards of validator %s: %s", val, err)
+	}
+	return rewards, nil
 }
 
 // SetValidatorCurrentRewards sets current rewards for a validator
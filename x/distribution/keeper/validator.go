@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -81,6 +82,11 @@ func (k Keeper) IncrementValidatorPeriod(ctx context.Context, val stakingtypes.V
 			return 0, err
 		}
 
+		err = k.recordRewardsForfeiture(ctx, val, valBz, rewards.Rewards)
+		if err != nil {
+			return 0, err
+		}
+
 		current = sdk.DecCoins{}
 	} else {
 		// note: necessary to truncate so we don't allow withdrawing more rewards than owed
@@ -123,10 +129,9 @@ func (k Keeper) incrementReferenceCount(ctx context.Context, valAddr sdk.ValAddr
 		return err
 	}
 
+	// a period ended by a slash may be referenced by the slash event and by
+	// any number of delegations checkpointed at it, so there is no upper bound
 	historical.ReferenceCount++
-	if historical.ReferenceCount > 2 {
-		panic("reference count should never exceed 2")
-	}
 
 	return k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
 }
@@ -139,7 +144,7 @@ func (k Keeper) decrementReferenceCount(ctx context.Context, valAddr sdk.ValAddr
 	}
 
 	if historical.ReferenceCount == 0 {
-		panic("cannot set negative reference count")
+		return errorsmod.Wrapf(types.ErrInvalidRecord, "historical rewards of validator %s, period %d: cannot set negative reference count", valAddr, period)
 	}
 	historical.ReferenceCount--
 	if historical.ReferenceCount == 0 {
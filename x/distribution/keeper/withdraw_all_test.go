@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	f := distrtestutil.NewKeeperFixture(t, disttypes.DefaultParams())
+	ctx, distrKeeper := f.Ctx, f.Keeper
+
+	// create two validators with 10% commission, delegated to by the same delegator
+	delAddr := sdk.AccAddress(valConsAddr2)
+	var valAddrs []sdk.ValAddress
+	for i, pk := range PKS[:2] {
+		valAddr := sdk.ValAddress(pk.Address())
+		f.CreateValidator(t, pk, sdk.TokensFromConsensusPower(int64(100*(i+1)), sdk.DefaultPowerReduction), math.LegacyNewDecWithPrec(1, 1))
+		f.Delegate(t, delAddr, valAddr, sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction))
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	// a delegation without starting info is skipped
+	unknownValAddr := sdk.ValAddress(valConsAddr2)
+	f.StakingKeeper.EXPECT().IterateDelegations(gomock.Any(), delAddr, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) error {
+			for i, valAddr := range append([]sdk.ValAddress{unknownValAddr}, valAddrs...) {
+				del := stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), math.LegacyOneDec())
+				if fn(int64(i), del) {
+					break
+				}
+			}
+			return nil
+		},
+	).AnyTimes()
+
+	// allocate rewards to both validators
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for i, valAddr := range valAddrs {
+		tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(int64(1000*(i+1))))}
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, f.Validators[valAddr.String()], tokens))
+	}
+
+	// withdraw the rewards of each delegation separately on a branch of the state
+	cacheCtx, _ := ctx.CacheContext()
+	expected := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		rewards, err := distrKeeper.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+		require.NoError(t, err)
+		require.False(t, rewards.IsZero())
+		expected = expected.Add(rewards...)
+	}
+	delete(f.Payouts, delAddr.String())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	res, err := msgServer.WithdrawAllDelegatorRewards(ctx, disttypes.NewMsgWithdrawAllDelegatorRewards(delAddr.String()))
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Amount)
+	require.Equal(t, expected, f.Payouts[delAddr.String()])
+
+	// one event per withdrawn delegation, followed by the aggregate event
+	var withdrawEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeWithdrawRewards {
+			withdrawEvents = append(withdrawEvents, event)
+		}
+	}
+	require.Len(t, withdrawEvents, len(valAddrs)+1)
+	aggregate := withdrawEvents[len(withdrawEvents)-1]
+	amount, ok := aggregate.GetAttribute(sdk.AttributeKeyAmount)
+	require.True(t, ok)
+	require.Equal(t, expected.String(), amount.Value)
+	_, ok = aggregate.GetAttribute(disttypes.AttributeKeyValidator)
+	require.False(t, ok)
+
+	// nothing is left to withdraw
+	res, err = msgServer.WithdrawAllDelegatorRewards(ctx, disttypes.NewMsgWithdrawAllDelegatorRewards(delAddr.String()))
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+
+	_, err = msgServer.WithdrawAllDelegatorRewards(ctx, disttypes.NewMsgWithdrawAllDelegatorRewards("invalid"))
+	require.Error(t, err)
+
+	// the delegations whose rewards are below the minimum withdrawal are skipped
+	params := disttypes.DefaultParams()
+	params.MinWithdrawalAmount = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 330))
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for i, valAddr := range valAddrs {
+		tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(int64(1000*(i+1))))}
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, f.Validators[valAddr.String()], tokens))
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err = msgServer.WithdrawAllDelegatorRewards(ctx, disttypes.NewMsgWithdrawAllDelegatorRewards(delAddr.String()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 360)), res.Amount)
+	withdrawEvents = nil
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeWithdrawRewards {
+			withdrawEvents = append(withdrawEvents, event)
+		}
+	}
+	require.Len(t, withdrawEvents, 2)
+
+	// and the withdrawal is rejected if all of them are
+	_, err = msgServer.WithdrawAllDelegatorRewards(ctx, disttypes.NewMsgWithdrawAllDelegatorRewards(delAddr.String()))
+	require.ErrorIs(t, err, disttypes.ErrBelowMinWithdrawal)
+
+	// the keeper withdraws them regardless, on behalf of other modules
+	rewards, err := distrKeeper.WithdrawAllDelegationRewards(ctx, delAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), rewards)
+}
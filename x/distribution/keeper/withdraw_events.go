@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// aggregateImplicitWithdrawal adds the rewards withdrawn by the implicit
+// settlement of a delegation to the pending implicit withdrawals of the
+// delegator, if the implicit withdraw events are aggregated. It returns whether
+// the withdrawal was aggregated, in which case no event must be emitted for it.
+func (k Keeper) aggregateImplicitWithdrawal(ctx context.Context, delAddr sdk.AccAddress, amount sdk.Coins) (bool, error) {
+	if k.pendingImplicitWithdrawals == nil {
+		return false, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if !params.AggregateImplicitWithdrawEvents {
+		return false, nil
+	}
+
+	pending, err := k.pendingImplicitWithdrawals.Get(ctx, delAddr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		pending.Sequence, err = k.implicitWithdrawalsSequence.Next(ctx)
+		if err != nil {
+			return false, err
+		}
+	case err != nil:
+		return false, err
+	}
+
+	pending.ValidatorCount++
+	pending.Amount = pending.Amount.Add(amount...)
+	return true, k.pendingImplicitWithdrawals.Set(ctx, delAddr, pending)
+}
+
+// FlushImplicitWithdrawEvents emits a withdraw_rewards event for each delegator
+// whose implicit withdrawals are pending, in the order of their first
+// settlement, with the number of settled delegations and the sum of the
+// withdrawn rewards. It is called at the end of every transaction by the
+// ImplicitWithdrawEventsDecorator and at the end of the block for the
+// withdrawals happening outside of transactions.
+func (k Keeper) FlushImplicitWithdrawEvents(ctx context.Context) error {
+	if k.pendingImplicitWithdrawals == nil {
+		return nil
+	}
+
+	iter, err := k.pendingImplicitWithdrawals.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(kvs, func(a, b collections.KeyValue[sdk.AccAddress, types.ImplicitWithdrawals]) int {
+		return cmp.Compare(a.Value.Sequence, b.Value.Sequence)
+	})
+
+	events := make(sdk.Events, 0, len(kvs))
+	for _, kv := range kvs {
+		delAddr, err := k.authKeeper.AddressCodec().BytesToString(kv.Key)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawnAmount(kv.Value.Amount).String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr),
+			sdk.NewAttribute(types.AttributeKeyValidatorCount, strconv.FormatUint(uint64(kv.Value.ValidatorCount), 10)),
+		))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(events)
+	return k.pendingImplicitWithdrawals.Clear(ctx, nil)
+}
+
+// ImplicitWithdrawEventsDecorator is a post decorator emitting the aggregated
+// withdraw_rewards events of the implicit settlements of a successful
+// transaction. It must be part of the post handler chain of the apps enabling
+// the aggregate_implicit_withdraw_events param, otherwise the aggregated events
+// are only emitted at the end of the block.
+type ImplicitWithdrawEventsDecorator struct {
+	k Keeper
+}
+
+// NewImplicitWithdrawEventsDecorator returns a post decorator emitting the
+// aggregated implicit withdraw events of the keeper.
+func NewImplicitWithdrawEventsDecorator(k Keeper) ImplicitWithdrawEventsDecorator {
+	return ImplicitWithdrawEventsDecorator{k: k}
+}
+
+// PostHandle implements sdk.PostDecorator. The implicit withdrawals of a failed
+// transaction are discarded along with its state.
+func (d ImplicitWithdrawEventsDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if success {
+		if err := d.k.FlushImplicitWithdrawEvents(ctx); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const implicitSettlements = 100
+
+type implicitWithdrawalsFixture struct {
+	*distrtestutil.KeeperFixture
+	delAddr  sdk.AccAddress
+	vals     []*stakingtypes.Validator
+	valAddrs []sdk.ValAddress
+}
+
+// newImplicitWithdrawalsFixture returns a fixture in which a delegator is
+// delegating to 100 validators without commission, each delegation holding
+// half of the tokens of its validator.
+func newImplicitWithdrawalsFixture(t *testing.T, aggregate bool) *implicitWithdrawalsFixture {
+	t.Helper()
+
+	params := disttypes.DefaultParams()
+	params.AggregateImplicitWithdrawEvents = aggregate
+	f := &implicitWithdrawalsFixture{
+		KeeperFixture: distrtestutil.NewKeeperFixture(t, params, keeper.WithTransientStoreService),
+		delAddr:       sdk.AccAddress("implicit_delegator__"),
+	}
+
+	for _, consPk := range simtestutil.CreateTestPubKeys(implicitSettlements) {
+		f.vals = append(f.vals, f.CreateValidator(t, consPk, math.NewInt(100), math.LegacyZeroDec()))
+		f.valAddrs = append(f.valAddrs, sdk.ValAddress(consPk.Address()))
+		f.delegate(t, len(f.vals)-1)
+	}
+
+	return f
+}
+
+// delegate delegates 100stake of the delegator to the i-th validator, settling
+// the rewards of the existing delegation.
+func (f *implicitWithdrawalsFixture) delegate(t *testing.T, i int) {
+	t.Helper()
+	f.Delegate(t, f.delAddr, f.valAddrs[i], math.NewInt(100))
+}
+
+// allocate allocates 2(i+1)stake to the i-th validator in a new block, of which
+// (i+1)stake accrue to the delegator.
+func (f *implicitWithdrawalsFixture) allocate(t *testing.T) {
+	t.Helper()
+
+	f.Ctx = f.Ctx.WithBlockHeight(f.Ctx.BlockHeight() + 1)
+	for i, val := range f.vals {
+		tokens := sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, int64(2*(i+1))))
+		require.NoError(t, f.Keeper.AllocateTokensToValidator(f.Ctx, *val, tokens))
+	}
+}
+
+// postHandle runs the post decorator of the keeper at the end of a transaction.
+func (f *implicitWithdrawalsFixture) postHandle(t *testing.T, success bool) {
+	t.Helper()
+
+	decorator := keeper.NewImplicitWithdrawEventsDecorator(f.Keeper)
+	_, err := decorator.PostHandle(f.Ctx, nil, false, success, func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.NoError(t, err)
+}
+
+// withdrawEvents returns the withdraw_rewards events of the delegator with
+// their amount, as an attribute map.
+func (f *implicitWithdrawalsFixture) withdrawEvents(t *testing.T) []map[string]string {
+	t.Helper()
+
+	var events []map[string]string
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type != disttypes.EventTypeWithdrawRewards {
+			continue
+		}
+
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		if attrs[disttypes.AttributeKeyDelegator] == f.delAddr.String() {
+			events = append(events, attrs)
+		}
+	}
+
+	return events
+}
+
+func sumWithdrawEvents(t *testing.T, events []map[string]string) sdk.Coins {
+	t.Helper()
+
+	total := sdk.NewCoins()
+	for _, attrs := range events {
+		amount, err := sdk.ParseCoinsNormalized(attrs[sdk.AttributeKeyAmount])
+		require.NoError(t, err)
+		total = total.Add(amount...)
+	}
+
+	return total
+}
+
+func TestAggregateImplicitWithdrawEvents(t *testing.T) {
+	// the delegator is owed 1+2+...+100 = 5050stake
+	expTotal := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5050))
+
+	// a transaction redelegating to every validator settles every delegation
+	settleAll := func(t *testing.T, aggregate bool) *implicitWithdrawalsFixture {
+		t.Helper()
+
+		f := newImplicitWithdrawalsFixture(t, aggregate)
+		f.allocate(t)
+
+		f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+		for i := range f.vals {
+			f.delegate(t, i)
+		}
+		f.postHandle(t, true)
+		return f
+	}
+
+	unaggregated := settleAll(t, false)
+	events := unaggregated.withdrawEvents(t)
+	require.Len(t, events, implicitSettlements)
+	for i, attrs := range events {
+		require.Equal(t, unaggregated.valAddrs[i].String(), attrs[disttypes.AttributeKeyValidator])
+	}
+	require.Equal(t, expTotal, sumWithdrawEvents(t, events))
+
+	aggregated := settleAll(t, true)
+	events = aggregated.withdrawEvents(t)
+	require.Len(t, events, 1)
+	require.Equal(t, map[string]string{
+		sdk.AttributeKeyAmount:               expTotal.String(),
+		disttypes.AttributeKeyDelegator:      aggregated.delAddr.String(),
+		disttypes.AttributeKeyValidatorCount: strconv.Itoa(implicitSettlements),
+	}, events[0])
+
+	// nothing is left pending for the end of the block
+	aggregated.Ctx = aggregated.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, aggregated.Keeper.EndBlocker(aggregated.Ctx))
+	require.Empty(t, aggregated.withdrawEvents(t))
+}
+
+func TestAggregateImplicitWithdrawEventsExplicitWithdrawal(t *testing.T) {
+	f := newImplicitWithdrawalsFixture(t, true)
+	f.allocate(t)
+
+	// explicit withdrawals keep their event per delegation
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	for _, valAddr := range f.valAddrs[:2] {
+		_, err := f.Keeper.WithdrawDelegationRewards(f.Ctx, f.delAddr, valAddr)
+		require.NoError(t, err)
+	}
+	f.postHandle(t, true)
+
+	events := f.withdrawEvents(t)
+	require.Len(t, events, 2)
+	for i, attrs := range events {
+		require.Equal(t, f.valAddrs[i].String(), attrs[disttypes.AttributeKeyValidator])
+		require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(i+1)).String(), attrs[sdk.AttributeKeyAmount])
+	}
+}
+
+func TestAggregateImplicitWithdrawEventsOutsideTx(t *testing.T) {
+	f := newImplicitWithdrawalsFixture(t, true)
+	f.allocate(t)
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	blockCtx := f.Ctx
+
+	// the settlements of a failed transaction are discarded along with its state
+	f.Ctx, _ = blockCtx.CacheContext()
+	f.delegate(t, 0)
+	f.postHandle(t, false)
+	require.Empty(t, f.withdrawEvents(t))
+
+	// the settlements happening outside of transactions are reported at the
+	// end of the block
+	f.Ctx = blockCtx
+	f.delegate(t, 1)
+	require.Empty(t, f.withdrawEvents(t))
+	require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+
+	events := f.withdrawEvents(t)
+	require.Len(t, events, 1)
+	require.Equal(t, "1", events[0][disttypes.AttributeKeyValidatorCount])
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2).String(), events[0][sdk.AttributeKeyAmount])
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetWithdrawOperator returns the withdraw operator registered by a delegator,
+// or nil if the delegator has not registered one.
+func (k Keeper) GetWithdrawOperator(ctx context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, error) {
+	operator, err := k.WithdrawOperators.Get(ctx, delAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	return operator, nil
+}
+
+// RegisterWithdrawOperator registers the operator allowed to withdraw the
+// delegation rewards of a delegator on its behalf, replacing the operator
+// registered before. An empty operator revokes the registered operator.
+func (k Keeper) RegisterWithdrawOperator(ctx context.Context, delAddr, operator sdk.AccAddress) error {
+	if delAddr.Equals(operator) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "delegator cannot be its own withdraw operator")
+	}
+
+	if operator.Empty() {
+		has, err := k.WithdrawOperators.Has(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return errorsmod.Wrapf(types.ErrNoWithdrawOperator, "delegator %s", delAddr)
+		}
+
+		if err := k.WithdrawOperators.Remove(ctx, delAddr); err != nil {
+			return err
+		}
+	} else if err := k.WithdrawOperators.Set(ctx, delAddr, operator); err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	operatorStr, err := k.authKeeper.AddressCodec().BytesToString(operator)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterWithdrawOperator,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator),
+			sdk.NewAttribute(types.AttributeKeyOperator, operatorStr),
+		),
+	)
+
+	return nil
+}
+
+// withdrawDelegationRewardsOnBehalf withdraws the rewards of a delegation as
+// withdrawDelegationRewardsAboveMinimum on behalf of the delegator, which must
+// have registered the operator. The rewards are sent to the withdraw address of
+// the delegator, never to the operator.
+func (k Keeper) withdrawDelegationRewardsOnBehalf(
+	ctx context.Context, operator, delAddr sdk.AccAddress, valAddr sdk.ValAddress, minimum sdk.Coins,
+) (sdk.Coins, error) {
+	registered, err := k.GetWithdrawOperator(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !registered.Equals(operator) || operator.Empty() {
+		return nil, errorsmod.Wrapf(types.ErrNotWithdrawOperator, "%s for delegator %s", operator, delAddr)
+	}
+
+	amount, err := k.withdrawDelegationRewardsAboveMinimum(ctx, delAddr, valAddr, minimum)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorStr, err := k.authKeeper.AddressCodec().BytesToString(operator)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawOnBehalf,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator),
+			sdk.NewAttribute(types.AttributeKeyOperator, operatorStr),
+		),
+	)
+
+	return amount, nil
+}
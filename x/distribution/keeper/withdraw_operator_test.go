@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var (
+	operatorAddr         = sdk.AccAddress("withdraw_operator___")
+	otherOperatorAddr    = sdk.AccAddress("other_operator______")
+	coldWithdrawAddr     = sdk.AccAddress("cold_withdraw_addr__")
+	operatorWithdrawAddr = sdk.AccAddress("operator_withdraw___")
+)
+
+// withdrawOperator queries the withdraw operator registered by a delegator.
+func withdrawOperator(t *testing.T, ctx sdk.Context, k keeper.Keeper, delAddr sdk.AccAddress) string {
+	t.Helper()
+
+	res, err := keeper.NewQuerier(k).WithdrawOperator(ctx, &disttypes.QueryWithdrawOperatorRequest{
+		DelegatorAddress: delAddr.String(),
+	})
+	require.NoError(t, err)
+	return res.OperatorAddress
+}
+
+func TestRegisterWithdrawOperator(t *testing.T) {
+	f := newWithholdingFixture(t)
+	delAddr := sdk.AccAddress(f.valAddr)
+	register := func(operator string) error {
+		_, err := f.msgServer.RegisterWithdrawOperator(f.Ctx, disttypes.NewMsgRegisterWithdrawOperator(delAddr.String(), operator))
+		return err
+	}
+
+	require.Empty(t, withdrawOperator(t, f.Ctx, f.Keeper, delAddr))
+
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, register(operatorAddr.String()))
+	require.Equal(t, operatorAddr.String(), withdrawOperator(t, f.Ctx, f.Keeper, delAddr))
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		disttypes.EventTypeRegisterWithdrawOperator,
+		sdk.NewAttribute(disttypes.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(disttypes.AttributeKeyOperator, operatorAddr.String()),
+	)}, f.Ctx.EventManager().Events())
+
+	// one operator at a time, the new one replaces the registered one
+	require.NoError(t, register(otherOperatorAddr.String()))
+	require.Equal(t, otherOperatorAddr.String(), withdrawOperator(t, f.Ctx, f.Keeper, delAddr))
+
+	require.ErrorIs(t, register(delAddr.String()), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, register("invalid"), sdkerrors.ErrInvalidAddress)
+	require.Equal(t, otherOperatorAddr.String(), withdrawOperator(t, f.Ctx, f.Keeper, delAddr))
+
+	// an empty operator revokes the registered one
+	require.NoError(t, register(""))
+	require.Empty(t, withdrawOperator(t, f.Ctx, f.Keeper, delAddr))
+	require.ErrorIs(t, register(""), disttypes.ErrNoWithdrawOperator)
+}
+
+func TestWithdrawDelegatorRewardOnBehalf(t *testing.T) {
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(f.Keeper)
+	delAddr := f.delAddrs[1]
+	withdraw := func(signer sdk.AccAddress) (sdk.Coins, error) {
+		res, err := msgServer.WithdrawDelegatorReward(f.Ctx,
+			disttypes.NewMsgWithdrawDelegatorRewardOnBehalf(signer.String(), f.valAddr.String(), delAddr.String()))
+		if err != nil {
+			return nil, err
+		}
+		return res.Amount, nil
+	}
+	register := func(operator string) {
+		_, err := msgServer.RegisterWithdrawOperator(f.Ctx, disttypes.NewMsgRegisterWithdrawOperator(delAddr.String(), operator))
+		require.NoError(t, err)
+	}
+
+	// the operator has its own withdraw address, which must not receive the
+	// rewards of the delegator
+	require.NoError(t, f.Keeper.SetDelegatorWithdrawAddr(f.Ctx, delAddr, coldWithdrawAddr))
+	require.NoError(t, f.Keeper.SetDelegatorWithdrawAddr(f.Ctx, operatorAddr, operatorWithdrawAddr))
+
+	// the operator must be registered by the delegator
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1_000)))
+	_, err := withdraw(operatorAddr)
+	require.ErrorIs(t, err, disttypes.ErrNotWithdrawOperator)
+	require.Empty(t, f.withdrawEvents())
+
+	register(operatorAddr.String())
+	f.Ctx = f.Ctx.WithEventManager(sdk.NewEventManager())
+	amount, err := withdraw(operatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 225)), amount)
+
+	// the rewards go to the withdraw address of the delegator only
+	require.Equal(t, map[string]sdk.Coins{coldWithdrawAddr.String(): amount}, f.Payouts)
+	require.Empty(t, f.rewards(t)[1])
+
+	// the withdrawal records both parties
+	require.Len(t, f.withdrawEvents(), 1)
+	var onBehalf []sdk.Event
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeWithdrawOnBehalf {
+			onBehalf = append(onBehalf, event)
+		}
+	}
+	require.Equal(t, []sdk.Event{sdk.NewEvent(
+		disttypes.EventTypeWithdrawOnBehalf,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(disttypes.AttributeKeyValidator, f.valAddr.String()),
+		sdk.NewAttribute(disttypes.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(disttypes.AttributeKeyOperator, operatorAddr.String()),
+	)}, onBehalf)
+
+	// other accounts, including other delegators, are not authorized
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1_000)))
+	_, err = withdraw(otherOperatorAddr)
+	require.ErrorIs(t, err, disttypes.ErrNotWithdrawOperator)
+	_, err = withdraw(f.delAddrs[2])
+	require.ErrorIs(t, err, disttypes.ErrNotWithdrawOperator)
+
+	// the revocation takes effect immediately, within the same block
+	register("")
+	_, err = withdraw(operatorAddr)
+	require.ErrorIs(t, err, disttypes.ErrNotWithdrawOperator)
+	require.Empty(t, f.withdrawEvents())
+
+	// while the delegator can still withdraw on its own
+	res, err := msgServer.WithdrawDelegatorReward(f.Ctx, disttypes.NewMsgWithdrawDelegatorReward(delAddr.String(), f.valAddr.String()))
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdk.Coins{coldWithdrawAddr.String(): amount.Add(res.Amount...)}, f.Payouts)
+}
+
+func TestWithdrawOperatorsGenesis(t *testing.T) {
+	f := newWithholdingFixture(t)
+	delAddr := sdk.AccAddress(f.valAddr)
+	require.NoError(t, f.Keeper.RegisterWithdrawOperator(f.Ctx, delAddr, operatorAddr))
+	require.NoError(t, f.Keeper.RegisterWithdrawOperator(f.Ctx, operatorAddr, otherOperatorAddr))
+
+	require.NoError(t, f.Keeper.SetPreviousProposerConsAddr(f.Ctx, valConsAddr0))
+	genState := f.Keeper.ExportGenesis(f.Ctx)
+	require.Len(t, genState.WithdrawOperators, 2)
+	require.NoError(t, disttypes.ValidateGenesis(genState))
+
+	g := newWithholdingFixture(t)
+	g.AccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), disttypes.ModuleName).Return(distrAcc).AnyTimes()
+	g.AccountKeeper.EXPECT().SetModuleAccount(gomock.Any(), distrAcc)
+	g.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(sdk.Coins{})
+	g.Keeper.InitGenesis(g.Ctx, *genState)
+	require.Equal(t, genState.WithdrawOperators, g.Keeper.ExportGenesis(g.Ctx).WithdrawOperators)
+	require.Equal(t, operatorAddr.String(), withdrawOperator(t, g.Ctx, g.Keeper, delAddr))
+	require.Equal(t, otherOperatorAddr.String(), withdrawOperator(t, g.Ctx, g.Keeper, operatorAddr))
+
+	// a delegator has at most one operator, which is not itself
+	genState.WithdrawOperators = append(genState.WithdrawOperators, genState.WithdrawOperators[0])
+	require.ErrorContains(t, disttypes.ValidateGenesis(genState), "duplicate withdraw operator")
+	genState.WithdrawOperators = []disttypes.WithdrawOperatorRecord{{
+		DelegatorAddress: delAddr.String(),
+		OperatorAddress:  delAddr.String(),
+	}}
+	require.ErrorContains(t, disttypes.ValidateGenesis(genState), "invalid withdraw operator")
+}
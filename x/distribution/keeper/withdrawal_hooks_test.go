@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var errHook = errors.New("hook failure")
+
+func TestDelegationRewardWithdrawnHook(t *testing.T) {
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+	// the staking hooks are a copy of the keeper made before the hooks are set
+	stakingHooks := f.Keeper.Hooks()
+	hooks := distrtestutil.NewMockDistributionHooks(gomock.NewController(t))
+	f.Keeper.SetHooks(hooks)
+	require.PanicsWithValue(t, "cannot set distribution hooks twice", func() { f.Keeper.SetHooks(hooks) })
+
+	delAddr := f.delAddrs[1]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 225))
+
+	// the hook fires once the rewards are paid out and the outstanding rewards
+	// decremented
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1_000)))
+	hooks.EXPECT().AfterDelegationRewardWithdrawn(gomock.Any(), delAddr, f.valAddr, rewards).DoAndReturn(
+		func(ctx context.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Coins) error {
+			require.Equal(t, rewards, f.Payouts[delAddr.String()])
+			outstanding, err := f.Keeper.GetValidatorOutstandingRewardsCoins(ctx, f.valAddr)
+			require.NoError(t, err)
+			require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 775)), outstanding)
+			return nil
+		},
+	)
+	res, err := f.Keeper.WithdrawDelegationRewards(f.Ctx, delAddr, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, rewards, res)
+
+	// nothing to withdraw, nothing to observe
+	_, err = f.Keeper.WithdrawDelegationRewards(f.Ctx, delAddr, f.valAddr)
+	require.NoError(t, err)
+
+	// a failing hook aborts the withdrawal
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1_000)))
+	outstanding := f.outstanding(t)
+	hooks.EXPECT().AfterDelegationRewardWithdrawn(gomock.Any(), delAddr, f.valAddr, rewards).Return(errHook)
+	_, err = f.Keeper.WithdrawDelegationRewards(f.Ctx, delAddr, f.valAddr)
+	require.ErrorIs(t, err, errHook)
+	require.Equal(t, outstanding, f.outstanding(t))
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 225)), f.rewards(t)[1])
+
+	// the withdrawals triggered by the staking hooks are observed as well
+	f.Payouts = make(map[string]sdk.Coins)
+	hooks.EXPECT().AfterDelegationRewardWithdrawn(gomock.Any(), delAddr, f.valAddr, rewards)
+	require.NoError(t, stakingHooks.BeforeDelegationSharesModified(f.Ctx, delAddr, f.valAddr))
+	require.Equal(t, rewards, f.Payouts[delAddr.String()])
+}
+
+func TestValidatorCommissionWithdrawnHook(t *testing.T) {
+	f := newValidatorFixture(t, disttypes.DefaultParams())
+	hooks := distrtestutil.NewMockDistributionHooks(gomock.NewController(t))
+	f.Keeper.SetHooks(disttypes.NewMultiDistributionHooks(hooks))
+
+	commission := func(ctx context.Context) sdk.DecCoins {
+		res, err := f.Keeper.GetValidatorAccumulatedCommission(ctx, f.valAddr)
+		require.NoError(t, err)
+		return res.Commission
+	}
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	operator := sdk.AccAddress(f.valAddr).String()
+
+	// a failing hook fails the message, whose transaction is discarded
+	f.allocateBlock(t, decCoins(decCoin(sdk.DefaultBondDenom, 1_000)))
+	hooks.EXPECT().AfterValidatorCommissionWithdrawn(gomock.Any(), f.valAddr, amount).Return(errHook)
+	txCtx, _ := f.Ctx.CacheContext()
+	_, err := keeper.NewMsgServerImpl(f.Keeper).WithdrawValidatorCommission(txCtx,
+		disttypes.NewMsgWithdrawValidatorCommission(f.valAddr.String()))
+	require.ErrorIs(t, err, errHook)
+	require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 100)), commission(f.Ctx))
+
+	// the hook fires once the commission is paid out and deducted
+	f.Payouts = make(map[string]sdk.Coins)
+	hooks.EXPECT().AfterValidatorCommissionWithdrawn(gomock.Any(), f.valAddr, amount).DoAndReturn(
+		func(ctx context.Context, _ sdk.ValAddress, _ sdk.Coins) error {
+			require.Equal(t, amount, f.Payouts[operator])
+			require.True(t, commission(ctx).IsZero())
+			outstanding, err := f.Keeper.GetValidatorOutstandingRewardsCoins(ctx, f.valAddr)
+			require.NoError(t, err)
+			require.Equal(t, decCoins(decCoin(sdk.DefaultBondDenom, 900)), outstanding)
+			return nil
+		},
+	)
+	res, err := f.Keeper.WithdrawValidatorCommission(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, amount, res)
+	require.True(t, commission(f.Ctx).IsZero())
+}
+
+func TestMultiDistributionHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	first := distrtestutil.NewMockDistributionHooks(ctrl)
+	second := distrtestutil.NewMockDistributionHooks(ctrl)
+	hooks := disttypes.NewMultiDistributionHooks(first, second)
+
+	delAddr, valAddr := sdk.AccAddress("delegator"), sdk.ValAddress("validator")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+
+	// the hooks run in sequence
+	gomock.InOrder(
+		first.EXPECT().AfterDelegationRewardWithdrawn(gomock.Any(), delAddr, valAddr, amount),
+		second.EXPECT().AfterDelegationRewardWithdrawn(gomock.Any(), delAddr, valAddr, amount),
+	)
+	require.NoError(t, hooks.AfterDelegationRewardWithdrawn(context.Background(), delAddr, valAddr, amount))
+
+	// until one of them fails
+	first.EXPECT().AfterValidatorCommissionWithdrawn(gomock.Any(), valAddr, amount).Return(errHook)
+	require.ErrorIs(t, hooks.AfterValidatorCommissionWithdrawn(context.Background(), valAddr, amount), errHook)
+}
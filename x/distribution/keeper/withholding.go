@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetValidatorCommissionWithholding returns the commission withholding of a
+// validator. An empty withholding is returned if the commission of the
+// validator was never withheld.
+func (k Keeper) GetValidatorCommissionWithholding(ctx context.Context, val sdk.ValAddress) (types.ValidatorCommissionWithholding, error) {
+	withholding, err := k.ValidatorCommissionWithholdings.Get(ctx, val)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.ValidatorCommissionWithholding{}, err
+	}
+
+	return withholding, nil
+}
+
+// SetCommissionWithholding withholds the commission withdrawals of a validator,
+// or lifts the withholding. Lifting the withholding does not pay out the
+// commission withheld so far, which must be resolved separately.
+func (k Keeper) SetCommissionWithholding(ctx context.Context, val sdk.ValAddress, withheld bool) error {
+	withholding, err := k.GetValidatorCommissionWithholding(ctx, val)
+	if err != nil {
+		return err
+	}
+
+	withholding.Withheld = withheld
+	if err := k.setValidatorCommissionWithholding(ctx, val, withholding); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionWithholding,
+			sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+			sdk.NewAttribute(types.AttributeKeyWithheld, strconv.FormatBool(withheld)),
+		),
+	)
+
+	return nil
+}
+
+// ResolveCommissionWithholding pays out the withheld commission of a validator
+// to either the withdraw address of the validator operator or the community
+// pool, and returns the amount paid out.
+func (k Keeper) ResolveCommissionWithholding(
+	ctx context.Context, val sdk.ValAddress, destination types.CommissionWithholdingDestination,
+) (sdk.Coins, error) {
+	withholding, err := k.GetValidatorCommissionWithholding(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := withholding.Amount
+	if amount.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNoWithheldCommission, "validator %s", val)
+	}
+
+	switch destination {
+	case types.CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_OPERATOR:
+		withdrawAddr, _, err := k.getDelegatorWithdrawAddr(ctx, sdk.AccAddress(val))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.recordCommissionWithdrawn(ctx, val, amount); err != nil {
+			return nil, err
+		}
+
+	case types.CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_COMMUNITY_POOL:
+		// the withheld commission is already held by the module account
+		feePool, err := k.FeePool.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+		if err := k.FeePool.Set(ctx, feePool); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid commission withholding destination %s", destination)
+	}
+
+	withholding.Amount = nil
+	if err := k.setValidatorCommissionWithholding(ctx, val, withholding); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResolveCommissionWithholding,
+			sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, destination.String()),
+		),
+	)
+
+	return amount, nil
+}
+
+// withholdCommission adds withdrawn commission to the withheld commission of a
+// validator. The commission stays in the module account until the withholding
+// is resolved.
+func (k Keeper) withholdCommission(ctx context.Context, val sdk.ValAddress, withholding types.ValidatorCommissionWithholding, commission sdk.Coins) error {
+	withholding.Amount = withholding.Amount.Add(commission...)
+	if err := k.ValidatorCommissionWithholdings.Set(ctx, val, withholding); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithholdCommission,
+			sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+		),
+	)
+
+	return nil
+}
+
+// setValidatorCommissionWithholding stores the commission withholding of a
+// validator, removing it once it is lifted and nothing is withheld anymore.
+func (k Keeper) setValidatorCommissionWithholding(ctx context.Context, val sdk.ValAddress, withholding types.ValidatorCommissionWithholding) error {
+	if !withholding.Withheld && withholding.Amount.IsZero() {
+		return k.ValidatorCommissionWithholdings.Remove(ctx, val)
+	}
+
+	return k.ValidatorCommissionWithholdings.Set(ctx, val, withholding)
+}
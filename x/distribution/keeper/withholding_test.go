@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+type withholdingFixture struct {
+	*distrtestutil.KeeperFixture
+	msgServer disttypes.MsgServer
+	valAddr   sdk.ValAddress
+}
+
+func newWithholdingFixture(t *testing.T) *withholdingFixture {
+	t.Helper()
+
+	params := disttypes.DefaultParams()
+	params.RewardDistributionStatsEnabled = true
+	f := &withholdingFixture{
+		KeeperFixture: distrtestutil.NewKeeperFixture(t, params),
+		valAddr:       sdk.ValAddress(valConsPk0.Address()),
+	}
+	f.msgServer = keeper.NewMsgServerImpl(f.Keeper)
+	f.AddValidator(t, valConsPk0, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction), math.LegacyZeroDec())
+
+	return f
+}
+
+// accrueCommission adds commission to the validator, as the allocation of
+// rewards would.
+func (f *withholdingFixture) accrueCommission(t *testing.T, commission sdk.DecCoins) {
+	t.Helper()
+
+	outstanding, err := f.Keeper.GetValidatorOutstandingRewards(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	outstanding.Rewards = outstanding.Rewards.Add(commission...)
+	require.NoError(t, f.Keeper.SetValidatorOutstandingRewards(f.Ctx, f.valAddr, outstanding))
+
+	accumulated, err := f.Keeper.GetValidatorAccumulatedCommission(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	accumulated.Commission = accumulated.Commission.Add(commission...)
+	require.NoError(t, f.Keeper.SetValidatorAccumulatedCommission(f.Ctx, f.valAddr, accumulated))
+}
+
+func (f *withholdingFixture) withholding(t *testing.T) disttypes.ValidatorCommissionWithholding {
+	t.Helper()
+
+	res, err := keeper.NewQuerier(f.Keeper).ValidatorCommissionWithholding(f.Ctx, &disttypes.QueryValidatorCommissionWithholdingRequest{
+		ValidatorAddress: f.valAddr.String(),
+	})
+	require.NoError(t, err)
+	return res.Withholding
+}
+
+func TestCommissionWithholdingAccrue(t *testing.T) {
+	f := newWithholdingFixture(t)
+	operator := sdk.AccAddress(f.valAddr).String()
+
+	// only the authority can withhold commission, of existing validators
+	_, err := f.msgServer.SetCommissionWithholding(f.Ctx, &disttypes.MsgSetCommissionWithholding{
+		Authority:        operator,
+		ValidatorAddress: f.valAddr.String(),
+		Withheld:         true,
+	})
+	require.ErrorContains(t, err, "invalid authority")
+	_, err = f.msgServer.SetCommissionWithholding(f.Ctx, &disttypes.MsgSetCommissionWithholding{
+		Authority:        f.Authority,
+		ValidatorAddress: sdk.ValAddress(valConsPk1.Address()).String(),
+		Withheld:         true,
+	})
+	require.ErrorIs(t, err, disttypes.ErrNoValidatorExists)
+
+	_, err = f.msgServer.SetCommissionWithholding(f.Ctx, &disttypes.MsgSetCommissionWithholding{
+		Authority:        f.Authority,
+		ValidatorAddress: f.valAddr.String(),
+		Withheld:         true,
+	})
+	require.NoError(t, err)
+	require.True(t, f.withholding(t).Withheld)
+
+	// nothing was withheld yet
+	_, err = f.msgServer.ResolveCommissionWithholding(f.Ctx, &disttypes.MsgResolveCommissionWithholding{
+		Authority:        f.Authority,
+		ValidatorAddress: f.valAddr.String(),
+		Destination:      disttypes.CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_OPERATOR,
+	})
+	require.ErrorIs(t, err, disttypes.ErrNoWithheldCommission)
+
+	// the withdrawn commission accrues while withheld, the remainder stays with the validator
+	f.accrueCommission(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(105, 1))})
+	res, err := f.msgServer.WithdrawValidatorCommission(f.Ctx, &disttypes.MsgWithdrawValidatorCommission{ValidatorAddress: f.valAddr.String()})
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+
+	f.accrueCommission(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(5))})
+	_, err = f.msgServer.WithdrawValidatorCommission(f.Ctx, &disttypes.MsgWithdrawValidatorCommission{ValidatorAddress: f.valAddr.String()})
+	require.NoError(t, err)
+
+	require.Empty(t, f.Payouts)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)), f.withholding(t).Amount)
+
+	outstanding, err := f.Keeper.GetValidatorOutstandingRewardsCoins(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(5, 1))}, outstanding)
+
+	// the withheld commission is not part of the withdrawn commission
+	stats, err := f.Keeper.GetValidatorRewardDistributionStats(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+	require.True(t, stats.CommissionWithdrawn.IsZero())
+
+	var withheldEvents int
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type == disttypes.EventTypeWithholdCommission {
+			withheldEvents++
+		}
+	}
+	require.Equal(t, 2, withheldEvents)
+
+	// lifting the withholding pays out the commission again, but keeps the withheld commission
+	_, err = f.msgServer.SetCommissionWithholding(f.Ctx, &disttypes.MsgSetCommissionWithholding{
+		Authority:        f.Authority,
+		ValidatorAddress: f.valAddr.String(),
+		Withheld:         false,
+	})
+	require.NoError(t, err)
+
+	f.accrueCommission(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(5))})
+	res, err = f.msgServer.WithdrawValidatorCommission(f.Ctx, &disttypes.MsgWithdrawValidatorCommission{ValidatorAddress: f.valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), res.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), f.Payouts[operator])
+
+	withholding := f.withholding(t)
+	require.False(t, withholding.Withheld)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)), withholding.Amount)
+}
+
+func TestResolveCommissionWithholding(t *testing.T) {
+	withheld := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	testCases := []struct {
+		name             string
+		destination      disttypes.CommissionWithholdingDestination
+		lift             bool
+		expErr           string
+		expOperator      sdk.Coins
+		expCommunityPool sdk.DecCoins
+	}{
+		{
+			name:        "operator",
+			destination: disttypes.CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_OPERATOR,
+			expOperator: withheld,
+		},
+		{
+			name:             "community pool",
+			destination:      disttypes.CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_COMMUNITY_POOL,
+			expCommunityPool: sdk.NewDecCoinsFromCoins(withheld...),
+		},
+		{
+			name:        "operator after lifting the withholding",
+			destination: disttypes.CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_OPERATOR,
+			lift:        true,
+			expOperator: withheld,
+		},
+		{
+			name:        "unspecified destination",
+			destination: disttypes.CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_UNSPECIFIED,
+			expErr:      "invalid commission withholding destination",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newWithholdingFixture(t)
+			operator := sdk.AccAddress(f.valAddr).String()
+
+			_, err := f.msgServer.SetCommissionWithholding(f.Ctx, &disttypes.MsgSetCommissionWithholding{
+				Authority:        f.Authority,
+				ValidatorAddress: f.valAddr.String(),
+				Withheld:         true,
+			})
+			require.NoError(t, err)
+
+			f.accrueCommission(t, sdk.NewDecCoinsFromCoins(withheld...))
+			_, err = f.Keeper.WithdrawValidatorCommission(f.Ctx, f.valAddr)
+			require.NoError(t, err)
+
+			if tc.lift {
+				_, err = f.msgServer.SetCommissionWithholding(f.Ctx, &disttypes.MsgSetCommissionWithholding{
+					Authority:        f.Authority,
+					ValidatorAddress: f.valAddr.String(),
+					Withheld:         false,
+				})
+				require.NoError(t, err)
+			}
+
+			res, err := f.msgServer.ResolveCommissionWithholding(f.Ctx, &disttypes.MsgResolveCommissionWithholding{
+				Authority:        f.Authority,
+				ValidatorAddress: f.valAddr.String(),
+				Destination:      tc.destination,
+			})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				require.Equal(t, withheld, f.withholding(t).Amount)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, withheld, res.Amount)
+
+			require.Equal(t, tc.expOperator, f.Payouts[operator])
+			feePool, err := f.Keeper.FeePool.Get(f.Ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expCommunityPool, feePool.CommunityPool)
+
+			// the withheld commission is cleared, the record is removed once lifted
+			withholding := f.withholding(t)
+			require.True(t, withholding.Amount.IsZero())
+			require.Equal(t, !tc.lift, withholding.Withheld)
+			has, err := f.Keeper.ValidatorCommissionWithholdings.Has(f.Ctx, f.valAddr)
+			require.NoError(t, err)
+			require.Equal(t, !tc.lift, has)
+
+			_, err = f.msgServer.ResolveCommissionWithholding(f.Ctx, &disttypes.MsgResolveCommissionWithholding{
+				Authority:        f.Authority,
+				ValidatorAddress: f.valAddr.String(),
+				Destination:      tc.destination,
+			})
+			require.ErrorIs(t, err, disttypes.ErrNoWithheldCommission)
+		})
+	}
+}
+
+func TestCommissionWithholdingGenesis(t *testing.T) {
+	f := newWithholdingFixture(t)
+
+	_, err := f.msgServer.SetCommissionWithholding(f.Ctx, &disttypes.MsgSetCommissionWithholding{
+		Authority:        f.Authority,
+		ValidatorAddress: f.valAddr.String(),
+		Withheld:         true,
+	})
+	require.NoError(t, err)
+	f.accrueCommission(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(10))})
+	_, err = f.Keeper.WithdrawValidatorCommission(f.Ctx, f.valAddr)
+	require.NoError(t, err)
+
+	require.NoError(t, f.Keeper.SetPreviousProposerConsAddr(f.Ctx, valConsAddr0))
+	genState := f.Keeper.ExportGenesis(f.Ctx)
+	require.Len(t, genState.ValidatorCommissionWithholdings, 1)
+	require.NoError(t, disttypes.ValidateGenesis(genState))
+
+	g := newWithholdingFixture(t)
+	g.AccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), disttypes.ModuleName).Return(distrAcc).AnyTimes()
+
+	// the withheld commission is part of the module holdings
+	withheld := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	g.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(withheld)
+	g.Keeper.InitGenesis(g.Ctx, *genState)
+	require.Equal(t, genState.ValidatorCommissionWithholdings, g.Keeper.ExportGenesis(g.Ctx).ValidatorCommissionWithholdings)
+	require.Equal(t, disttypes.ValidatorCommissionWithholding{Withheld: true, Amount: withheld}, g.withholding(t))
+
+	h := newWithholdingFixture(t)
+	h.AccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), disttypes.ModuleName).Return(distrAcc).AnyTimes()
+	h.BankKeeper.EXPECT().GetAllBalances(gomock.Any(), distrAcc.GetAddress()).Return(withheld.Add(withheld...))
+	require.PanicsWithValue(t,
+		"distribution module balance does not match the module holdings: 20stake <-> 10stake",
+		func() { h.Keeper.InitGenesis(h.Ctx, *genState) },
+	)
+
+	// invalid withheld amounts are rejected
+	genState.ValidatorCommissionWithholdings[0].Withholding.Amount = sdk.Coins{{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)}}
+	require.ErrorContains(t, disttypes.ValidateGenesis(genState), "invalid withheld commission")
+}
@@ -42,6 +42,9 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"community_pool_remainder_accrued": [],
+	"community_pool_spend_history": [],
+	"delegator_accrued_rewards": [],
 	"delegator_starting_infos": [],
 	"delegator_withdraw_infos": [],
 	"fee_pool": {
@@ -49,16 +52,34 @@ func TestMigrateJSON(t *testing.T) {
 	},
 	"outstanding_rewards": [],
 	"params": {
+		"aggregate_implicit_withdraw_events": false,
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
+		"community_pool_allowed_denoms": [],
+		"community_pool_spend_history_retention": "0",
 		"community_tax": "0.020000000000000000",
+		"community_tax_overrides": [],
+		"historical_rewards_compactions_per_block": "0",
+		"max_outstanding_reward_denoms": 0,
+		"min_settlement_interval": "0",
+		"min_withdrawal_amount": [],
+		"outstanding_rewards_denom_policy": "OUTSTANDING_REWARDS_DENOM_POLICY_UNSPECIFIED",
+		"reward_checkpoint_interval": "0",
+		"reward_checkpoints_per_block": "0",
+		"reward_distribution_stats_enabled": false,
 		"withdraw_addr_enabled": true
 	},
 	"previous_proposer": "",
+	"reward_adjustments": [],
 	"validator_accumulated_commissions": [],
+	"validator_commission_withholdings": [],
 	"validator_current_rewards": [],
+	"validator_dust_stats": [],
+	"validator_forfeited_rewards": [],
 	"validator_historical_rewards": [],
-	"validator_slash_events": []
+	"validator_reward_distribution_stats": [],
+	"validator_slash_events": [],
+	"withdraw_operators": []
 }`
 
 	require.Equal(t, expected, string(indentedBz))
@@ -0,0 +1,62 @@
+package v4
+
+import (
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const (
+	ModuleName = "distribution"
+)
+
+var ParamsKey = []byte{0x09}
+
+// MigrateStore migrates the x/distribution module state from the consensus version 3 to
+// version 4. Specifically, it initializes every parameter introduced in version 4
+// explicitly, with the values keeping the behavior of version 3:
+//
+//   - no community tax overrides, every fee denom is taxed at the global community tax rate
+//   - no reward checkpoints, community pool spend history or historical rewards compaction
+//   - no cap on the outstanding reward denoms, diverting the new denoms once one is set
+//   - no minimum withdrawal amount and no deferred settlements
+//   - any denom allowed to fund the community pool
+//   - one withdraw_rewards event per implicit withdrawal and no reward distribution stats
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	bz, err := store.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var currParams types.Params
+	if err := cdc.Unmarshal(bz, &currParams); err != nil {
+		return err
+	}
+
+	currParams.CommunityTaxOverrides = []types.CommunityTaxOverride{}
+	currParams.RewardCheckpointInterval = 0
+	currParams.RewardCheckpointsPerBlock = 0
+	currParams.CommunityPoolSpendHistoryRetention = 0
+	currParams.HistoricalRewardsCompactionsPerBlock = 0
+	currParams.MaxOutstandingRewardDenoms = 0
+	currParams.OutstandingRewardsDenomPolicy = types.OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_DIVERT_NEW
+	currParams.MinWithdrawalAmount = sdk.Coins{}
+	currParams.MinSettlementInterval = 0
+	currParams.CommunityPoolAllowedDenoms = []string{}
+	currParams.AggregateImplicitWithdrawEvents = false
+	currParams.RewardDistributionStatsEnabled = false
+
+	if err := currParams.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err = cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ParamsKey, bz)
+}
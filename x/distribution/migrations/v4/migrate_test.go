@@ -0,0 +1,83 @@
+package v4_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v4.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	oldParams := types.Params{
+		CommunityTax:        sdkmath.LegacyNewDecWithPrec(5, 2),
+		BaseProposerReward:  sdkmath.LegacyZeroDec(),
+		BonusProposerReward: sdkmath.LegacyZeroDec(),
+		WithdrawAddrEnabled: false,
+	}
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+	store.Set(v4.ParamsKey, bz)
+
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v4.ParamsKey), &res))
+	require.Equal(t, oldParams.CommunityTax, res.CommunityTax)
+	require.Equal(t, oldParams.WithdrawAddrEnabled, res.WithdrawAddrEnabled)
+	require.Empty(t, res.CommunityTaxOverrides)
+	require.Equal(t, oldParams.CommunityTax, res.CommunityTaxForDenom("uatom"))
+}
+
+func TestMigrateVersion3Params(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v4.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// the params of version 3 with a 10% community tax and withdraw addresses
+	// enabled, which only have the fields 1 to 4
+	bz, err := hex.DecodeString("0a123130303030303030303030303030303030301201301a01302001")
+	require.NoError(t, err)
+	store.Set(v4.ParamsKey, bz)
+
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v4.ParamsKey), &res))
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(1, 1).String(), res.CommunityTax.String())
+	require.True(t, res.BaseProposerReward.IsZero())
+	require.True(t, res.BonusProposerReward.IsZero())
+	require.True(t, res.WithdrawAddrEnabled)
+	require.Empty(t, res.CommunityTaxOverrides)
+	require.Zero(t, res.RewardCheckpointInterval)
+	require.Zero(t, res.RewardCheckpointsPerBlock)
+	require.Zero(t, res.CommunityPoolSpendHistoryRetention)
+	require.Zero(t, res.HistoricalRewardsCompactionsPerBlock)
+	require.Zero(t, res.MaxOutstandingRewardDenoms)
+	require.Equal(t, types.OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_DIVERT_NEW, res.OutstandingRewardsDenomPolicy)
+	require.Empty(t, res.MinWithdrawalAmount)
+	require.Zero(t, res.MinSettlementInterval)
+	require.Empty(t, res.CommunityPoolAllowedDenoms)
+	require.False(t, res.AggregateImplicitWithdrawEvents)
+	require.False(t, res.RewardDistributionStatsEnabled)
+	require.NoError(t, res.ValidateBasic())
+}
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -63,6 +64,66 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorRewardDistributionStatsPrefix):
+			var statsA, statsB types.ValidatorRewardDistributionStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorDustStatsPrefix):
+			var statsA, statsB types.ValidatorDustStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorAccruedRewardsPrefix):
+			var rewardsA, rewardsB types.DelegatorAccruedRewards
+			cdc.MustUnmarshal(kvA.Value, &rewardsA)
+			cdc.MustUnmarshal(kvB.Value, &rewardsB)
+			return fmt.Sprintf("%v\n%v", rewardsA, rewardsB)
+
+		case bytes.Equal(kvA.Key[:1], types.CommunityPoolRemainderAccruedKey):
+			var remainderA, remainderB types.CommunityPoolRemainderAccrued
+			cdc.MustUnmarshal(kvA.Value, &remainderA)
+			cdc.MustUnmarshal(kvB.Value, &remainderB)
+			return fmt.Sprintf("%v\n%v", remainderA, remainderB)
+
+		case bytes.Equal(kvA.Key[:1], types.RewardCheckpointCursorKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.CommunityPoolSpendHistoryPrefix):
+			var spendA, spendB types.CommunityPoolSpendRecord
+			cdc.MustUnmarshal(kvA.Value, &spendA)
+			cdc.MustUnmarshal(kvB.Value, &spendB)
+			return fmt.Sprintf("%v\n%v", spendA, spendB)
+
+		case bytes.Equal(kvA.Key[:1], types.CommunityPoolSpendSequenceKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionWithholdingPrefix):
+			var withholdingA, withholdingB types.ValidatorCommissionWithholding
+			cdc.MustUnmarshal(kvA.Value, &withholdingA)
+			cdc.MustUnmarshal(kvB.Value, &withholdingB)
+			return fmt.Sprintf("%v\n%v", withholdingA, withholdingB)
+
+		case bytes.Equal(kvA.Key[:1], types.WithdrawOperatorsPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorForfeitedRewardsPrefix):
+			var forfeitedA, forfeitedB types.ValidatorForfeitedRewards
+			cdc.MustUnmarshal(kvA.Value, &forfeitedA)
+			cdc.MustUnmarshal(kvB.Value, &forfeitedB)
+			return fmt.Sprintf("%v\n%v", forfeitedA, forfeitedB)
+
+		case bytes.Equal(kvA.Key[:1], types.RewardAdjustmentsPrefix):
+			var adjustmentA, adjustmentB types.RewardAdjustmentRecord
+			cdc.MustUnmarshal(kvA.Value, &adjustmentA)
+			cdc.MustUnmarshal(kvB.Value, &adjustmentB)
+			return fmt.Sprintf("%v\n%v", adjustmentA, adjustmentB)
+
+		case bytes.Equal(kvA.Key[:1], types.RewardAdjustmentSequenceKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
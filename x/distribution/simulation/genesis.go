@@ -5,14 +5,17 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // Simulation parameter constants
 const (
-	CommunityTax    = "community_tax"
-	WithdrawEnabled = "withdraw_enabled"
+	CommunityTax          = "community_tax"
+	WithdrawEnabled       = "withdraw_enabled"
+	MinWithdrawalAmount   = "min_withdrawal_amount"
+	MinSettlementInterval = "min_settlement_interval"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -25,6 +28,24 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenMinWithdrawalAmount returns a randomized MinWithdrawalAmount parameter.
+func GenMinWithdrawalAmount(r *rand.Rand) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return sdk.Coins{} // 50% chance of the minimum being disabled
+	}
+
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1000)+1))
+}
+
+// GenMinSettlementInterval returns a randomized MinSettlementInterval parameter.
+func GenMinSettlementInterval(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0 // 50% chance of the settlements never being deferred
+	}
+
+	return uint64(r.Intn(10) + 1)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax math.LegacyDec
@@ -33,11 +54,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var withdrawEnabled bool
 	simState.AppParams.GetOrGenerate(WithdrawEnabled, &withdrawEnabled, simState.Rand, func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) })
 
+	var minWithdrawalAmount sdk.Coins
+	simState.AppParams.GetOrGenerate(MinWithdrawalAmount, &minWithdrawalAmount, simState.Rand, func(r *rand.Rand) { minWithdrawalAmount = GenMinWithdrawalAmount(r) })
+
+	var minSettlementInterval uint64
+	simState.AppParams.GetOrGenerate(MinSettlementInterval, &minSettlementInterval, simState.Rand, func(r *rand.Rand) { minSettlementInterval = GenMinSettlementInterval(r) })
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:          communityTax,
+			WithdrawAddrEnabled:   withdrawEnabled,
+			MinWithdrawalAmount:   minWithdrawalAmount,
+			MinSettlementInterval: minSettlementInterval,
 		},
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&distrGenesis)
@@ -44,6 +44,8 @@ func TestRandomizedGenState(t *testing.T) {
 
 	require.Equal(t, dec1, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Empty(t, distrGenesis.Params.MinWithdrawalAmount)
+	require.Equal(t, uint64(0), distrGenesis.Params.MinSettlementInterval)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
@@ -8,6 +8,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/testutil/simsx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -75,11 +76,83 @@ func MsgWithdrawDelegatorRewardFactory(k keeper.Keeper, sk types.StakingKeeper)
 			}
 		}
 
+		switch below, err := belowMinWithdrawal(ctx, k, delegator.AddressBech32, valOper); {
+		case err != nil:
+			reporter.Skipf("get delegation rewards: %v", err)
+			return nil, nil
+		case below:
+			reporter.Skip("rewards below the minimum withdrawal amount")
+			return nil, nil
+		}
+
 		msg := types.NewMsgWithdrawDelegatorReward(delegator.AddressBech32, valOper)
 		return []simsx.SimAccount{delegator}, msg
 	}
 }
 
+// belowMinWithdrawal reports whether the withdrawal of the rewards of a
+// delegation is rejected by the minimum withdrawal amount. The rewards are
+// calculated in a branch of the state, as it increments the validator period.
+func belowMinWithdrawal(ctx context.Context, k keeper.Keeper, delAddr, valOper string) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil || params.MinWithdrawalAmount.IsZero() {
+		return false, err
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	res, err := keeper.NewQuerier(k).DelegationRewards(cacheCtx, &types.QueryDelegationRewardsRequest{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valOper,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	rewards, _ := res.Rewards.TruncateDecimal()
+	return !rewards.IsAnyGTE(params.MinWithdrawalAmount), nil
+}
+
+func MsgWithdrawAllDelegatorRewardsFactory(k keeper.Keeper, sk types.StakingKeeper) simsx.SimMsgFactoryFn[*types.MsgWithdrawAllDelegatorRewards] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *types.MsgWithdrawAllDelegatorRewards) {
+		delegator := testData.AnyAccount(reporter)
+
+		delegations, err := sk.GetAllDelegatorDelegations(ctx, delegator.Address)
+		switch {
+		case err != nil:
+			reporter.Skipf("error getting delegations: %v", err)
+			return nil, nil
+		case len(delegations) == 0:
+			reporter.Skip("no delegations found")
+			return nil, nil
+		}
+
+		// get outstanding rewards so we can first check if the withdrawable coins are sendable
+		for _, delegation := range delegations {
+			valAddr, err := sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+			if err != nil {
+				reporter.Skip(err.Error())
+				return nil, nil
+			}
+
+			outstanding, err := k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+			if err != nil {
+				reporter.Skipf("get outstanding rewards: %v", err)
+				return nil, nil
+			}
+
+			for _, v := range outstanding {
+				if !testData.IsSendEnabledDenom(v.Denom) {
+					reporter.Skipf("denom send not enabled: " + v.Denom)
+					return nil, nil
+				}
+			}
+		}
+
+		msg := types.NewMsgWithdrawAllDelegatorRewards(delegator.AddressBech32)
+		return []simsx.SimAccount{delegator}, msg
+	}
+}
+
 func MsgWithdrawValidatorCommissionFactory(k keeper.Keeper, sk types.StakingKeeper) simsx.SimMsgFactoryFn[*types.MsgWithdrawValidatorCommission] {
 	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *types.MsgWithdrawValidatorCommission) {
 		allVals, err := sk.GetAllValidators(ctx)
@@ -116,6 +189,14 @@ func MsgUpdateParamsFactory() simsx.SimMsgFactoryFn[*types.MsgUpdateParams] {
 		params := types.DefaultParams()
 		params.CommunityTax = r.DecN(sdkmath.LegacyNewDec(1))
 		params.WithdrawAddrEnabled = r.Intn(2) == 0
+		for _, denom := range []string{sdk.DefaultBondDenom, "foreign"} {
+			if r.Intn(2) == 0 {
+				params.CommunityTaxOverrides = append(params.CommunityTaxOverrides, types.CommunityTaxOverride{
+					Denom: denom,
+					Rate:  r.DecN(sdkmath.LegacyNewDec(1)),
+				})
+			}
+		}
 
 		return nil, &types.MsgUpdateParams{
 			Authority: testData.ModuleAccountAddress(reporter, "gov"),
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	address "cosmossdk.io/core/address"
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "go.uber.org/mock/gomock"
@@ -149,6 +150,20 @@ func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -440,3 +455,134 @@ func (mr *MockStakingHooksMockRecorder) AfterValidatorCreated(ctx, valAddr any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorCreated", reflect.TypeOf((*MockStakingHooks)(nil).AfterValidatorCreated), ctx, valAddr)
 }
+
+// MockWithdrawAddressProvider is a mock of WithdrawAddressProvider interface.
+type MockWithdrawAddressProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockWithdrawAddressProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockWithdrawAddressProviderMockRecorder is the mock recorder for MockWithdrawAddressProvider.
+type MockWithdrawAddressProviderMockRecorder struct {
+	mock *MockWithdrawAddressProvider
+}
+
+// NewMockWithdrawAddressProvider creates a new mock instance.
+func NewMockWithdrawAddressProvider(ctrl *gomock.Controller) *MockWithdrawAddressProvider {
+	mock := &MockWithdrawAddressProvider{ctrl: ctrl}
+	mock.recorder = &MockWithdrawAddressProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWithdrawAddressProvider) EXPECT() *MockWithdrawAddressProviderMockRecorder {
+	return m.recorder
+}
+
+// GetWithdrawAddress mocks base method.
+func (m *MockWithdrawAddressProvider) GetWithdrawAddress(ctx context.Context, delAddr types.AccAddress) (types.AccAddress, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawAddress", ctx, delAddr)
+	ret0, _ := ret[0].(types.AccAddress)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWithdrawAddress indicates an expected call of GetWithdrawAddress.
+func (mr *MockWithdrawAddressProviderMockRecorder) GetWithdrawAddress(ctx, delAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawAddress", reflect.TypeOf((*MockWithdrawAddressProvider)(nil).GetWithdrawAddress), ctx, delAddr)
+}
+
+// MockPriceSource is a mock of PriceSource interface.
+type MockPriceSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockPriceSourceMockRecorder is the mock recorder for MockPriceSource.
+type MockPriceSourceMockRecorder struct {
+	mock *MockPriceSource
+}
+
+// NewMockPriceSource creates a new mock instance.
+func NewMockPriceSource(ctrl *gomock.Controller) *MockPriceSource {
+	mock := &MockPriceSource{ctrl: ctrl}
+	mock.recorder = &MockPriceSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceSource) EXPECT() *MockPriceSourceMockRecorder {
+	return m.recorder
+}
+
+// Price mocks base method.
+func (m *MockPriceSource) Price(ctx context.Context, baseDenom, quoteDenom string) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", ctx, baseDenom, quoteDenom)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockPriceSourceMockRecorder) Price(ctx, baseDenom, quoteDenom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockPriceSource)(nil).Price), ctx, baseDenom, quoteDenom)
+}
+
+// MockDistributionHooks is a mock of DistributionHooks interface.
+type MockDistributionHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionHooksMockRecorder
+	isgomock struct{}
+}
+
+// MockDistributionHooksMockRecorder is the mock recorder for MockDistributionHooks.
+type MockDistributionHooksMockRecorder struct {
+	mock *MockDistributionHooks
+}
+
+// NewMockDistributionHooks creates a new mock instance.
+func NewMockDistributionHooks(ctrl *gomock.Controller) *MockDistributionHooks {
+	mock := &MockDistributionHooks{ctrl: ctrl}
+	mock.recorder = &MockDistributionHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionHooks) EXPECT() *MockDistributionHooksMockRecorder {
+	return m.recorder
+}
+
+// AfterDelegationRewardWithdrawn mocks base method.
+func (m *MockDistributionHooks) AfterDelegationRewardWithdrawn(ctx context.Context, delAddr types.AccAddress, valAddr types.ValAddress, rewards types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterDelegationRewardWithdrawn", ctx, delAddr, valAddr, rewards)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterDelegationRewardWithdrawn indicates an expected call of AfterDelegationRewardWithdrawn.
+func (mr *MockDistributionHooksMockRecorder) AfterDelegationRewardWithdrawn(ctx, delAddr, valAddr, rewards any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterDelegationRewardWithdrawn", reflect.TypeOf((*MockDistributionHooks)(nil).AfterDelegationRewardWithdrawn), ctx, delAddr, valAddr, rewards)
+}
+
+// AfterValidatorCommissionWithdrawn mocks base method.
+func (m *MockDistributionHooks) AfterValidatorCommissionWithdrawn(ctx context.Context, valAddr types.ValAddress, commission types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterValidatorCommissionWithdrawn", ctx, valAddr, commission)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterValidatorCommissionWithdrawn indicates an expected call of AfterValidatorCommissionWithdrawn.
+func (mr *MockDistributionHooksMockRecorder) AfterValidatorCommissionWithdrawn(ctx, valAddr, commission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorCommissionWithdrawn", reflect.TypeOf((*MockDistributionHooks)(nil).AfterValidatorCommissionWithdrawn), ctx, valAddr, commission)
+}
@@ -0,0 +1,183 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// KeeperFixture is a distribution keeper on a new store, with mocks of the
+// account, bank and staking keepers.
+//
+// The mocks expect the calls made by any keeper operation: the address codecs,
+// the blocked addresses and the payouts of the module, which are recorded in
+// Payouts. The staking keeper returns the validators and delegations of the
+// fixture as of the time of the call, so that the changes made to them by a
+// test are seen by the keeper. Other calls are expected by the tests on the
+// mocks directly.
+//
+// Unlike RewardScenario, it runs the distribution keeper alone, so that the
+// tests can drive its hooks and stores directly.
+type KeeperFixture struct {
+	Ctx           sdk.Context
+	Keeper        keeper.Keeper
+	AccountKeeper *MockAccountKeeper
+	BankKeeper    *MockBankKeeper
+	StakingKeeper *MockStakingKeeper
+	Authority     string
+
+	// Validators are the validators returned by the staking keeper, by
+	// operator address.
+	Validators map[string]*stakingtypes.Validator
+	// Delegations are the delegations returned by the staking keeper, by
+	// DelegationKey.
+	Delegations map[string]stakingtypes.Delegation
+	// Payouts are the coins sent by the module to each account.
+	Payouts map[string]sdk.Coins
+}
+
+// KeeperFixtureOption returns an option of the keeper of a KeeperFixture,
+// given the transient store mounted in its context. WithTransientStoreService
+// is one.
+type KeeperFixtureOption func(transientService store.TransientStoreService) keeper.InitOption
+
+// NewKeeperFixture returns a keeper fixture at height 1, with the given params
+// and an empty fee pool.
+func NewKeeperFixture(t testing.TB, params types.Params, opts ...KeeperFixtureOption) *KeeperFixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, tkey)
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+
+	f := &KeeperFixture{
+		Ctx:           testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1}),
+		AccountKeeper: NewMockAccountKeeper(ctrl),
+		BankKeeper:    NewMockBankKeeper(ctrl),
+		StakingKeeper: NewMockStakingKeeper(ctrl),
+		Authority:     authtypes.NewModuleAddress("gov").String(),
+		Validators:    make(map[string]*stakingtypes.Validator),
+		Delegations:   make(map[string]stakingtypes.Delegation),
+		Payouts:       make(map[string]sdk.Coins),
+	}
+
+	f.AccountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	f.AccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	f.StakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	f.StakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixConsAddr)).AnyTimes()
+	f.BankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+	f.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, addr sdk.AccAddress, amt sdk.Coins) error {
+			f.Payouts[addr.String()] = f.Payouts[addr.String()].Add(amt...)
+			return nil
+		},
+	).AnyTimes()
+
+	f.StakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, valAddr sdk.ValAddress) (stakingtypes.ValidatorI, error) {
+			val, ok := f.Validators[valAddr.String()]
+			if !ok {
+				return nil, nil
+			}
+			return *val, nil
+		},
+	).AnyTimes()
+	f.StakingKeeper.EXPECT().Delegation(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.DelegationI, error) {
+			return f.Delegations[DelegationKey(delAddr, valAddr)], nil
+		},
+	).AnyTimes()
+
+	transientService := runtime.NewTransientStoreService(tkey)
+	keeperOpts := make([]keeper.InitOption, len(opts))
+	for i, opt := range opts {
+		keeperOpts[i] = opt(transientService)
+	}
+
+	f.Keeper = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		f.AccountKeeper,
+		f.BankKeeper,
+		f.StakingKeeper,
+		authtypes.FeeCollectorName,
+		f.Authority,
+		keeperOpts...,
+	)
+
+	require.NoError(t, f.Keeper.FeePool.Set(f.Ctx, types.InitialFeePool()))
+	require.NoError(t, f.Keeper.Params.Set(f.Ctx, params))
+
+	return f
+}
+
+// DelegationKey returns the key of a delegation in the Delegations of a
+// KeeperFixture.
+func DelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) string {
+	return delAddr.String() + valAddr.String()
+}
+
+// AddValidator adds a validator with the given tokens and commission rate to
+// the staking keeper, without calling the hooks of its creation.
+func (f *KeeperFixture) AddValidator(t testing.TB, pk cryptotypes.PubKey, tokens math.Int, commission math.LegacyDec) *stakingtypes.Validator {
+	t.Helper()
+
+	val, err := CreateValidator(pk, tokens)
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(commission, commission, math.LegacyZeroDec())
+	f.Validators[val.GetOperator()] = &val
+
+	return &val
+}
+
+// CreateValidator adds a validator with the given tokens and commission rate,
+// all self-delegated, calling the hooks of its creation.
+func (f *KeeperFixture) CreateValidator(t testing.TB, pk cryptotypes.PubKey, tokens math.Int, commission math.LegacyDec) *stakingtypes.Validator {
+	t.Helper()
+
+	val := f.AddValidator(t, pk, tokens, commission)
+	valAddr := sdk.ValAddress(pk.Address())
+	selfAddr := sdk.AccAddress(valAddr)
+	f.Delegations[DelegationKey(selfAddr, valAddr)] = stakingtypes.NewDelegation(selfAddr.String(), valAddr.String(), val.DelegatorShares)
+	require.NoError(t, CallCreateValidatorHooks(f.Ctx, f.Keeper, selfAddr, valAddr))
+
+	return val
+}
+
+// Delegate delegates the tokens to a validator of the fixture, creating the
+// delegation or adding to it, and calls the hooks of the staking keeper.
+func (f *KeeperFixture) Delegate(t testing.TB, delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens math.Int) {
+	t.Helper()
+
+	key := DelegationKey(delAddr, valAddr)
+	var existing *stakingtypes.Delegation
+	if del, ok := f.Delegations[key]; ok {
+		existing = &del
+	}
+
+	_, del, err := Delegate(f.Ctx, f.Keeper, delAddr, f.Validators[valAddr.String()], tokens, existing, f.StakingKeeper)
+	require.NoError(t, err)
+	f.Delegations[key] = del
+	require.NoError(t, f.Keeper.Hooks().AfterDelegationModified(f.Ctx, delAddr, valAddr))
+}
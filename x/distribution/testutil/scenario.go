@@ -0,0 +1,445 @@
+package testutil
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RewardScenario describes a reward distribution scenario: a set of
+// validators with their powers and commissions, the initial delegations to
+// them, and a schedule of events (reward allocations, slashes, delegations and
+// withdrawals) happening in the blocks following the setup.
+//
+// Validators and delegators are referred to by their index. Their addresses
+// are deterministic and returned by Validator and Delegator, so that the
+// results, and their golden files, are stable across runs.
+//
+// A scenario is run with Run against an application wiring the real auth,
+// bank, staking and distribution modules.
+type RewardScenario struct {
+	validators  []scenarioValidator
+	delegations []scenarioDelegation
+	events      []scenarioEvent
+	blocks      int64
+}
+
+type scenarioValidator struct {
+	power      int64
+	commission math.LegacyDec
+}
+
+type scenarioDelegation struct {
+	delegator int
+	validator int
+	tokens    math.Int
+}
+
+type scenarioEvent struct {
+	block int64
+	apply func(*scenarioRun) error
+}
+
+// NewRewardScenario returns an empty reward scenario.
+func NewRewardScenario() *RewardScenario {
+	return &RewardScenario{}
+}
+
+// WithValidator adds a validator self-bonding the tokens of the given
+// consensus power, with the given commission rate. Its index is the number of
+// validators added before it.
+func (s *RewardScenario) WithValidator(power int64, commission math.LegacyDec) *RewardScenario {
+	s.validators = append(s.validators, scenarioValidator{power: power, commission: commission})
+	return s
+}
+
+// WithDelegation adds a delegation of the given tokens of the bond denom,
+// made during the setup, before the first block.
+func (s *RewardScenario) WithDelegation(delegator, validator int, tokens math.Int) *RewardScenario {
+	s.delegations = append(s.delegations, scenarioDelegation{delegator: delegator, validator: validator, tokens: tokens})
+	return s
+}
+
+// WithBlocks runs the scenario for at least the given number of blocks, by
+// default it ends with the last block with an event.
+func (s *RewardScenario) WithBlocks(blocks int64) *RewardScenario {
+	s.blocks = max(s.blocks, blocks)
+	return s
+}
+
+// Allocate allocates the rewards to a validator at the given block, as the
+// distribution module does for its share of the fees, the commission included.
+func (s *RewardScenario) Allocate(block int64, validator int, rewards sdk.Coins) *RewardScenario {
+	return s.at(block, func(r *scenarioRun) error {
+		if err := banktestutil.FundModuleAccount(r.ctx, r.bankKeeper, types.ModuleName, rewards); err != nil {
+			return err
+		}
+
+		val, err := r.stakingKeeper.GetValidator(r.ctx, r.valAddrs[validator])
+		if err != nil {
+			return err
+		}
+
+		return r.distrKeeper.AllocateTokensToValidator(r.ctx, val, sdk.NewDecCoinsFromCoins(rewards...))
+	})
+}
+
+// AllocateFees collects the fees and allocates them at the given block, as the
+// distribution module does in BeginBlock, to the bonded validators of the
+// scenario in proportion to their power, after the community tax.
+func (s *RewardScenario) AllocateFees(block int64, fees sdk.Coins) *RewardScenario {
+	return s.at(block, func(r *scenarioRun) error {
+		if err := banktestutil.FundModuleAccount(r.ctx, r.bankKeeper, authtypes.FeeCollectorName, fees); err != nil {
+			return err
+		}
+
+		var (
+			totalPower int64
+			votes      []abci.VoteInfo
+		)
+		for _, valAddr := range r.valAddrs {
+			val, err := r.stakingKeeper.GetValidator(r.ctx, valAddr)
+			if err != nil {
+				return err
+			}
+
+			if !val.IsBonded() {
+				continue
+			}
+
+			consAddr, err := val.GetConsAddr()
+			if err != nil {
+				return err
+			}
+
+			power := val.GetConsensusPower(r.stakingKeeper.PowerReduction(r.ctx))
+			totalPower += power
+			votes = append(votes, abci.VoteInfo{
+				Validator:   abci.Validator{Address: consAddr, Power: power},
+				BlockIdFlag: cmtproto.BlockIDFlagCommit,
+			})
+		}
+
+		return r.distrKeeper.AllocateTokens(r.ctx, totalPower, votes)
+	})
+}
+
+// Slash slashes the given fraction of the stake of a validator at the given
+// block, for an infraction committed at that block.
+func (s *RewardScenario) Slash(block int64, validator int, fraction math.LegacyDec) *RewardScenario {
+	return s.at(block, func(r *scenarioRun) error {
+		val, err := r.stakingKeeper.GetValidator(r.ctx, r.valAddrs[validator])
+		if err != nil {
+			return err
+		}
+
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+
+		power := val.GetConsensusPower(r.stakingKeeper.PowerReduction(r.ctx))
+		_, err = r.stakingKeeper.Slash(r.ctx, consAddr, r.ctx.BlockHeight(), power, fraction)
+		return err
+	})
+}
+
+// Delegate delegates the given tokens of the bond denom to a validator at the
+// given block, withdrawing the rewards of an existing delegation.
+func (s *RewardScenario) Delegate(block int64, delegator, validator int, tokens math.Int) *RewardScenario {
+	return s.at(block, func(r *scenarioRun) error {
+		return r.delegate(s.Delegator(delegator), r.valAddrs[validator], tokens)
+	})
+}
+
+// Withdraw withdraws the rewards of a delegation at the given block.
+func (s *RewardScenario) Withdraw(block int64, delegator, validator int) *RewardScenario {
+	return s.at(block, func(r *scenarioRun) error {
+		_, err := r.distrKeeper.WithdrawDelegationRewards(r.ctx, s.Delegator(delegator), r.valAddrs[validator])
+		return err
+	})
+}
+
+// Validator returns the operator address of the validator with the given index.
+func (s *RewardScenario) Validator(validator int) sdk.ValAddress {
+	return sdk.ValAddress(s.consPubKeys()[validator].Address())
+}
+
+// Delegator returns the address of the delegator with the given index.
+func (s *RewardScenario) Delegator(delegator int) sdk.AccAddress {
+	return simtestutil.CreateIncrementalAccounts(delegator + 1)[delegator]
+}
+
+func (s *RewardScenario) at(block int64, apply func(*scenarioRun) error) *RewardScenario {
+	s.events = append(s.events, scenarioEvent{block: block, apply: apply})
+	s.blocks = max(s.blocks, block)
+	return s
+}
+
+func (s *RewardScenario) consPubKeys() []cryptotypes.PubKey {
+	return simtestutil.CreateTestPubKeys(len(s.validators))
+}
+
+// RewardScenarioResult is the outcome of a reward scenario.
+type RewardScenarioResult struct {
+	// Delegations are the delegations of the scenario, the self-delegations of
+	// the validators first, in the order they were made.
+	Delegations []DelegationResult `json:"delegations"`
+	// Commissions are the commissions accumulated by the validators and not
+	// withdrawn, in the order of the validators.
+	Commissions []ValidatorCommission `json:"commissions"`
+	// CommunityPool is the community pool at the end of every block.
+	CommunityPool []sdk.DecCoins `json:"community_pool"`
+}
+
+// DelegationResult are the rewards of a delegation.
+type DelegationResult struct {
+	Delegator string `json:"delegator"`
+	Validator string `json:"validator"`
+	// Accrued are the rewards of the delegation which are not withdrawn at the
+	// end of the scenario.
+	Accrued sdk.DecCoins `json:"accrued"`
+	// Withdrawn are the rewards paid out to the delegator during the scenario,
+	// on withdrawals and on the changes of the delegation.
+	Withdrawn sdk.Coins `json:"withdrawn"`
+}
+
+// ValidatorCommission is the commission accumulated by a validator.
+type ValidatorCommission struct {
+	Validator  string       `json:"validator"`
+	Commission sdk.DecCoins `json:"commission"`
+}
+
+// Delegation returns the result of a delegation, or the zero result if the
+// delegator never delegated to the validator.
+func (r RewardScenarioResult) Delegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress) DelegationResult {
+	for _, del := range r.Delegations {
+		if del.Delegator == delAddr.String() && del.Validator == valAddr.String() {
+			return del
+		}
+	}
+
+	return DelegationResult{}
+}
+
+// Commission returns the commission accumulated by a validator.
+func (r RewardScenarioResult) Commission(valAddr sdk.ValAddress) sdk.DecCoins {
+	for _, commission := range r.Commissions {
+		if commission.Validator == valAddr.String() {
+			return commission.Commission
+		}
+	}
+
+	return nil
+}
+
+// AssertGolden compares the result, as indented JSON, to the golden file with
+// the given name in the testdata directory of the calling package. The golden
+// file is written instead when the tests are run with the -update flag.
+func (r RewardScenarioResult) AssertGolden(t testing.TB, filename string) {
+	t.Helper()
+
+	bz, err := json.MarshalIndent(r, "", "  ")
+	require.NoError(t, err)
+	golden.Assert(t, string(bz)+"\n", filename)
+}
+
+// scenarioRun is the state of a running reward scenario.
+type scenarioRun struct {
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.Keeper
+	stakingKeeper *stakingkeeper.Keeper
+	distrKeeper   keeper.Keeper
+
+	valAddrs []sdk.ValAddress
+	// delegations are the delegations made, in order
+	delegations []DelegationResult
+}
+
+// Run runs the scenario against a new application, failing the test on any
+// error, and returns its result.
+func (s *RewardScenario) Run(t testing.TB) RewardScenarioResult {
+	t.Helper()
+
+	r := &scenarioRun{}
+	app, err := simtestutil.Setup(
+		depinject.Configs(AppConfig, depinject.Supply(log.NewNopLogger())),
+		&r.accountKeeper, &r.bankKeeper, &r.stakingKeeper, &r.distrKeeper,
+	)
+	require.NoError(t, err)
+	r.ctx = app.NewContext(false)
+
+	bondDenom, err := r.stakingKeeper.BondDenom(r.ctx)
+	require.NoError(t, err)
+	powerReduction := r.stakingKeeper.PowerReduction(r.ctx)
+	msgServer := stakingkeeper.NewMsgServerImpl(r.stakingKeeper)
+
+	for i, val := range s.validators {
+		valAddr := s.Validator(i)
+		tokens := sdk.TokensFromConsensusPower(val.power, powerReduction)
+		require.NoError(t, banktestutil.FundAccount(r.ctx, r.bankKeeper, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))))
+
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr.String(),
+			s.consPubKeys()[i],
+			sdk.NewCoin(bondDenom, tokens),
+			stakingtypes.Description{Moniker: valAddr.String()},
+			stakingtypes.NewCommissionRates(val.commission, math.LegacyOneDec(), math.LegacyZeroDec()),
+			math.OneInt(),
+		)
+		require.NoError(t, err)
+		_, err = msgServer.CreateValidator(r.ctx, msg)
+		require.NoError(t, err, "validator %d", i)
+
+		r.valAddrs = append(r.valAddrs, valAddr)
+		r.track(sdk.AccAddress(valAddr), valAddr)
+	}
+
+	for _, del := range s.delegations {
+		require.Less(t, del.validator, len(r.valAddrs), "delegation to an unknown validator")
+		require.NoError(t, r.delegate(s.Delegator(del.delegator), r.valAddrs[del.validator], del.tokens))
+	}
+
+	// bond the validators
+	_, err = r.stakingKeeper.EndBlocker(r.ctx)
+	require.NoError(t, err)
+
+	events := slices.Clone(s.events)
+	slices.SortStableFunc(events, func(a, b scenarioEvent) int {
+		return int(a.block - b.block)
+	})
+
+	var communityPool []sdk.DecCoins
+	height := r.ctx.BlockHeight()
+	for block := int64(1); block <= s.blocks; block++ {
+		r.ctx = r.ctx.WithBlockHeight(height + block).WithEventManager(sdk.NewEventManager())
+
+		for len(events) > 0 && events[0].block == block {
+			require.NoError(t, events[0].apply(r), "block %d", block)
+			events = events[1:]
+		}
+
+		_, err = r.stakingKeeper.EndBlocker(r.ctx)
+		require.NoError(t, err, "block %d", block)
+		require.NoError(t, r.distrKeeper.EndBlocker(r.ctx), "block %d", block)
+
+		r.collectWithdrawals(t)
+
+		feePool, err := r.distrKeeper.FeePool.Get(r.ctx)
+		require.NoError(t, err)
+		communityPool = append(communityPool, feePool.CommunityPool)
+	}
+
+	querier := keeper.NewQuerier(r.distrKeeper)
+	for i, del := range r.delegations {
+		res, err := querier.DelegationRewards(r.ctx, &types.QueryDelegationRewardsRequest{
+			DelegatorAddress: del.Delegator,
+			ValidatorAddress: del.Validator,
+		})
+		require.NoError(t, err, "delegation of %s to %s", del.Delegator, del.Validator)
+		r.delegations[i].Accrued = res.Rewards
+	}
+
+	var commissions []ValidatorCommission
+	for _, valAddr := range r.valAddrs {
+		commission, err := r.distrKeeper.GetValidatorAccumulatedCommission(r.ctx, valAddr)
+		require.NoError(t, err)
+		commissions = append(commissions, ValidatorCommission{Validator: valAddr.String(), Commission: commission.Commission})
+	}
+
+	return RewardScenarioResult{
+		Delegations:   r.delegations,
+		Commissions:   commissions,
+		CommunityPool: communityPool,
+	}
+}
+
+// delegate funds the delegator and delegates the tokens to the validator.
+func (r *scenarioRun) delegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens math.Int) error {
+	bondDenom, err := r.stakingKeeper.BondDenom(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	amount := sdk.NewCoin(bondDenom, tokens)
+	if err := banktestutil.FundAccount(r.ctx, r.bankKeeper, delAddr, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	msgServer := stakingkeeper.NewMsgServerImpl(r.stakingKeeper)
+	if _, err := msgServer.Delegate(r.ctx, stakingtypes.NewMsgDelegate(delAddr.String(), valAddr.String(), amount)); err != nil {
+		return err
+	}
+
+	r.track(delAddr, valAddr)
+	return nil
+}
+
+// track records a delegation the first time it is made.
+func (r *scenarioRun) track(delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	for _, del := range r.delegations {
+		if del.Delegator == delAddr.String() && del.Validator == valAddr.String() {
+			return
+		}
+	}
+
+	r.delegations = append(r.delegations, DelegationResult{
+		Delegator: delAddr.String(),
+		Validator: valAddr.String(),
+		Withdrawn: sdk.NewCoins(),
+	})
+}
+
+// collectWithdrawals adds the rewards paid out during the block to the
+// withdrawn rewards of the delegations.
+func (r *scenarioRun) collectWithdrawals(t testing.TB) {
+	t.Helper()
+
+	for _, event := range r.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeWithdrawRewards {
+			continue
+		}
+
+		var delegator, validator, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeKeyDelegator:
+				delegator = attr.Value
+			case types.AttributeKeyValidator:
+				validator = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		require.NoError(t, err)
+		for i, del := range r.delegations {
+			if del.Delegator == delegator && del.Validator == validator {
+				r.delegations[i].Withdrawn = del.Withdrawn.Add(coins...)
+			}
+		}
+	}
+}
@@ -20,6 +20,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/distr/MsgCommunityPoolSpend")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgResetValidatorRewardDistributionStats{}, "cosmos-sdk/distr/MsgResetValRewardStats")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/distr/MsgWithdrawAllRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCommissionWithholding{}, "cosmos-sdk/distr/MsgSetCommWithholding")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveCommissionWithholding{}, "cosmos-sdk/distr/MsgResolveWithholding")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterWithdrawOperator{}, "cosmos-sdk/distr/MsgRegWithdrawOperator")
+	legacy.RegisterAminoMsg(cdc, &MsgApplyRewardAdjustment{}, "cosmos-sdk/distr/MsgApplyRewardAdjust")
+	legacy.RegisterAminoMsg(cdc, &MsgSweepCommunityPoolDenoms{}, "cosmos-sdk/distr/MsgSweepPoolDenoms")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params", nil)
 }
@@ -34,6 +41,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgCommunityPoolSpend{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgResetValidatorRewardDistributionStats{},
+		&MsgWithdrawAllDelegatorRewards{},
+		&MsgSetCommissionWithholding{},
+		&MsgResolveCommissionWithholding{},
+		&MsgRegisterWithdrawOperator{},
+		&MsgApplyRewardAdjustment{},
+		&MsgSweepCommunityPoolDenoms{},
 	)
 
 	registry.RegisterImplementations(
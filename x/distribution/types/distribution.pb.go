@@ -28,6 +28,78 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OutstandingRewardsDenomPolicy defines which rewards are diverted to the
+// community pool when an allocation would add a denom to the outstanding
+// rewards of a validator which already track max_outstanding_reward_denoms
+// denoms.
+type OutstandingRewardsDenomPolicy int32
+
+const (
+	// UNSPECIFIED defaults to DIVERT_NEW.
+	OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_UNSPECIFIED OutstandingRewardsDenomPolicy = 0
+	// DIVERT_NEW diverts the rewards allocated in the new denom.
+	OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_DIVERT_NEW OutstandingRewardsDenomPolicy = 1
+	// EVICT_SMALLEST diverts the smallest amount among the outstanding rewards
+	// in each tracked denom and the rewards allocated in the new denom, the
+	// lowest denom first on ties. An evicted denom is removed from all the
+	// rewards records of the validator.
+	OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_EVICT_SMALLEST OutstandingRewardsDenomPolicy = 2
+)
+
+var OutstandingRewardsDenomPolicy_name = map[int32]string{
+	0: "OUTSTANDING_REWARDS_DENOM_POLICY_UNSPECIFIED",
+	1: "OUTSTANDING_REWARDS_DENOM_POLICY_DIVERT_NEW",
+	2: "OUTSTANDING_REWARDS_DENOM_POLICY_EVICT_SMALLEST",
+}
+
+var OutstandingRewardsDenomPolicy_value = map[string]int32{
+	"OUTSTANDING_REWARDS_DENOM_POLICY_UNSPECIFIED":    0,
+	"OUTSTANDING_REWARDS_DENOM_POLICY_DIVERT_NEW":     1,
+	"OUTSTANDING_REWARDS_DENOM_POLICY_EVICT_SMALLEST": 2,
+}
+
+func (x OutstandingRewardsDenomPolicy) String() string {
+	return proto.EnumName(OutstandingRewardsDenomPolicy_name, int32(x))
+}
+
+func (OutstandingRewardsDenomPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{0}
+}
+
+// CommissionWithholdingDestination defines where the withheld commission of a
+// validator is paid out to when the withholding is resolved.
+type CommissionWithholdingDestination int32
+
+const (
+	// UNSPECIFIED defines an invalid destination.
+	CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_UNSPECIFIED CommissionWithholdingDestination = 0
+	// OPERATOR pays the withheld commission to the withdraw address of the
+	// validator operator.
+	CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_OPERATOR CommissionWithholdingDestination = 1
+	// COMMUNITY_POOL pays the withheld commission to the community pool.
+	CommissionWithholdingDestination_COMMISSION_WITHHOLDING_DESTINATION_COMMUNITY_POOL CommissionWithholdingDestination = 2
+)
+
+var CommissionWithholdingDestination_name = map[int32]string{
+	0: "COMMISSION_WITHHOLDING_DESTINATION_UNSPECIFIED",
+	1: "COMMISSION_WITHHOLDING_DESTINATION_OPERATOR",
+	2: "COMMISSION_WITHHOLDING_DESTINATION_COMMUNITY_POOL",
+}
+
+var CommissionWithholdingDestination_value = map[string]int32{
+	"COMMISSION_WITHHOLDING_DESTINATION_UNSPECIFIED":    0,
+	"COMMISSION_WITHHOLDING_DESTINATION_OPERATOR":       1,
+	"COMMISSION_WITHHOLDING_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x CommissionWithholdingDestination) String() string {
+	return proto.EnumName(CommissionWithholdingDestination_name, int32(x))
+}
+
+func (CommissionWithholdingDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+
 // Params defines the set of params for the distribution module.
 type Params struct {
 	CommunityTax cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax"`
@@ -38,6 +110,60 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// community_tax_overrides defines per fee denom community tax rates which
+	// take precedence over community_tax. Denoms without an override are taxed
+	// at the community_tax rate.
+	CommunityTaxOverrides []CommunityTaxOverride `protobuf:"bytes,5,rep,name=community_tax_overrides,json=communityTaxOverrides,proto3" json:"community_tax_overrides"`
+	// reward_checkpoint_interval defines the number of validator periods a
+	// delegation can span since its last settlement before its rewards are
+	// checkpointed. Zero disables reward checkpointing.
+	RewardCheckpointInterval uint64 `protobuf:"varint,6,opt,name=reward_checkpoint_interval,json=rewardCheckpointInterval,proto3" json:"reward_checkpoint_interval,omitempty"`
+	// reward_checkpoints_per_block defines the maximum number of delegations
+	// inspected for reward checkpointing at the end of each block.
+	RewardCheckpointsPerBlock uint64 `protobuf:"varint,7,opt,name=reward_checkpoints_per_block,json=rewardCheckpointsPerBlock,proto3" json:"reward_checkpoints_per_block,omitempty"`
+	// community_pool_spend_history_retention defines the number of blocks the
+	// executed community pool spends are kept in the spend history. Zero
+	// disables the spend history.
+	CommunityPoolSpendHistoryRetention uint64 `protobuf:"varint,8,opt,name=community_pool_spend_history_retention,json=communityPoolSpendHistoryRetention,proto3" json:"community_pool_spend_history_retention,omitempty"`
+	// historical_rewards_compactions_per_block defines the maximum number of
+	// validator historical rewards records inspected for compaction at the end
+	// of each block. Zero disables historical rewards compaction.
+	HistoricalRewardsCompactionsPerBlock uint64 `protobuf:"varint,9,opt,name=historical_rewards_compactions_per_block,json=historicalRewardsCompactionsPerBlock,proto3" json:"historical_rewards_compactions_per_block,omitempty"`
+	// max_outstanding_reward_denoms defines the maximum number of denoms
+	// tracked in the outstanding rewards of a validator. The rewards which would
+	// exceed it are diverted to the community pool according to
+	// outstanding_rewards_denom_policy. Zero disables the cap.
+	MaxOutstandingRewardDenoms uint32 `protobuf:"varint,10,opt,name=max_outstanding_reward_denoms,json=maxOutstandingRewardDenoms,proto3" json:"max_outstanding_reward_denoms,omitempty"`
+	// outstanding_rewards_denom_policy defines which rewards are diverted to the
+	// community pool when an allocation exceeds max_outstanding_reward_denoms.
+	OutstandingRewardsDenomPolicy OutstandingRewardsDenomPolicy `protobuf:"varint,11,opt,name=outstanding_rewards_denom_policy,json=outstandingRewardsDenomPolicy,proto3,enum=cosmos.distribution.v1beta1.OutstandingRewardsDenomPolicy" json:"outstanding_rewards_denom_policy,omitempty"`
+	// min_withdrawal_amount defines the rewards a delegator must have accrued
+	// in at least one of its denoms to withdraw the rewards of a delegation.
+	// The minimum of a denom it does not list is zero, so that any rewards in
+	// such a denom can be withdrawn. MsgWithdrawAllDelegatorRewards applies it
+	// to every delegation, skipping the ones below it, and fails only if all of
+	// them are. The withdrawals triggered by delegation changes are not subject
+	// to it. Empty disables the minimum.
+	MinWithdrawalAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=min_withdrawal_amount,json=minWithdrawalAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_withdrawal_amount"`
+	// min_settlement_interval defines the number of blocks since the last
+	// settlement of a delegation during which its modifications do not settle
+	// its rewards, provided that its validator accrued no rewards since then.
+	// Zero disables the deferral.
+	MinSettlementInterval uint64 `protobuf:"varint,13,opt,name=min_settlement_interval,json=minSettlementInterval,proto3" json:"min_settlement_interval,omitempty"`
+	// community_pool_allowed_denoms defines the denoms the community pool can
+	// be funded with through FundCommunityPool. The remainders flowing into the
+	// community pool from allocations and withdrawals are not subject to it.
+	// Empty allows any denom.
+	CommunityPoolAllowedDenoms []string `protobuf:"bytes,14,rep,name=community_pool_allowed_denoms,json=communityPoolAllowedDenoms,proto3" json:"community_pool_allowed_denoms,omitempty"`
+	// aggregate_implicit_withdraw_events defines whether the rewards withdrawn
+	// by the settlements triggered by delegation changes are reported by a
+	// single withdraw_rewards event per delegator and transaction instead of
+	// one per delegation. Explicit withdrawals are not affected.
+	AggregateImplicitWithdrawEvents bool `protobuf:"varint,15,opt,name=aggregate_implicit_withdraw_events,json=aggregateImplicitWithdrawEvents,proto3" json:"aggregate_implicit_withdraw_events,omitempty"`
+	// reward_distribution_stats_enabled defines whether the withdrawn delegation
+	// rewards and commission are accumulated in the reward distribution stats of
+	// their validator. The accumulated stats are kept while it is disabled.
+	RewardDistributionStatsEnabled bool `protobuf:"varint,16,opt,name=reward_distribution_stats_enabled,json=rewardDistributionStatsEnabled,proto3" json:"reward_distribution_stats_enabled,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +206,139 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetCommunityTaxOverrides() []CommunityTaxOverride {
+	if m != nil {
+		return m.CommunityTaxOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetRewardCheckpointInterval() uint64 {
+	if m != nil {
+		return m.RewardCheckpointInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRewardCheckpointsPerBlock() uint64 {
+	if m != nil {
+		return m.RewardCheckpointsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetCommunityPoolSpendHistoryRetention() uint64 {
+	if m != nil {
+		return m.CommunityPoolSpendHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetHistoricalRewardsCompactionsPerBlock() uint64 {
+	if m != nil {
+		return m.HistoricalRewardsCompactionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOutstandingRewardDenoms() uint32 {
+	if m != nil {
+		return m.MaxOutstandingRewardDenoms
+	}
+	return 0
+}
+
+func (m *Params) GetOutstandingRewardsDenomPolicy() OutstandingRewardsDenomPolicy {
+	if m != nil {
+		return m.OutstandingRewardsDenomPolicy
+	}
+	return OutstandingRewardsDenomPolicy_OUTSTANDING_REWARDS_DENOM_POLICY_UNSPECIFIED
+}
+
+func (m *Params) GetMinWithdrawalAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinWithdrawalAmount
+	}
+	return nil
+}
+
+func (m *Params) GetMinSettlementInterval() uint64 {
+	if m != nil {
+		return m.MinSettlementInterval
+	}
+	return 0
+}
+
+func (m *Params) GetCommunityPoolAllowedDenoms() []string {
+	if m != nil {
+		return m.CommunityPoolAllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetAggregateImplicitWithdrawEvents() bool {
+	if m != nil {
+		return m.AggregateImplicitWithdrawEvents
+	}
+	return false
+}
+
+func (m *Params) GetRewardDistributionStatsEnabled() bool {
+	if m != nil {
+		return m.RewardDistributionStatsEnabled
+	}
+	return false
+}
+
+// CommunityTaxOverride defines the community tax rate applied to the fees
+// collected in a specific denom.
+type CommunityTaxOverride struct {
+	// denom is the fee denom the rate applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the community tax rate applied to the fees collected in denom.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *CommunityTaxOverride) Reset()         { *m = CommunityTaxOverride{} }
+func (m *CommunityTaxOverride) String() string { return proto.CompactTextString(m) }
+func (*CommunityTaxOverride) ProtoMessage()    {}
+func (*CommunityTaxOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+func (m *CommunityTaxOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityTaxOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityTaxOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityTaxOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityTaxOverride.Merge(m, src)
+}
+func (m *CommunityTaxOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityTaxOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityTaxOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityTaxOverride proto.InternalMessageInfo
+
+func (m *CommunityTaxOverride) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -102,7 +361,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{1}
+	return fileDescriptor_cd78a31ea281a992, []int{2}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +416,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{3}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +469,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +515,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +564,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +609,7 @@ func (m *ValidatorSlashEvents) Reset()         { *m = ValidatorSlashEvents{} }
 func (m *ValidatorSlashEvents) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvents) ProtoMessage()    {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +654,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +711,7 @@ func (m *CommunityPoolSpendProposal) Reset()         { *m = CommunityPoolSpendPr
 func (m *CommunityPoolSpendProposal) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposal) ProtoMessage()    {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,7 +756,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,25 +799,26 @@ func (m *DelegatorStartingInfo) GetHeight() uint64 {
 	return 0
 }
 
-// DelegationDelegatorReward represents the properties
-// of a delegator's delegation reward.
-type DelegationDelegatorReward struct {
-	ValidatorAddress string                                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	Reward           github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
+// DelegatorAccruedRewards represents the rewards of a delegation settled by
+// reward checkpoints and not withdrawn yet. They are still part of the
+// outstanding rewards of the validator and are paid out together with the
+// rewards of the periods since the last checkpoint on the next withdrawal.
+type DelegatorAccruedRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
 }
 
-func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorReward{} }
-func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
-func (*DelegationDelegatorReward) ProtoMessage()    {}
-func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+func (m *DelegatorAccruedRewards) Reset()         { *m = DelegatorAccruedRewards{} }
+func (m *DelegatorAccruedRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegatorAccruedRewards) ProtoMessage()    {}
+func (*DelegatorAccruedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
-func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
+func (m *DelegatorAccruedRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationDelegatorReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegatorAccruedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationDelegatorReward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegatorAccruedRewards.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -568,40 +828,58 @@ func (m *DelegationDelegatorReward) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *DelegationDelegatorReward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationDelegatorReward.Merge(m, src)
+func (m *DelegatorAccruedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorAccruedRewards.Merge(m, src)
 }
-func (m *DelegationDelegatorReward) XXX_Size() int {
+func (m *DelegatorAccruedRewards) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationDelegatorReward) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationDelegatorReward.DiscardUnknown(m)
+func (m *DelegatorAccruedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorAccruedRewards.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
+var xxx_messageInfo_DelegatorAccruedRewards proto.InternalMessageInfo
 
-// CommunityPoolSpendProposalWithDeposit defines a CommunityPoolSpendProposal
-// with a deposit
-type CommunityPoolSpendProposalWithDeposit struct {
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Recipient   string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Deposit     string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+func (m *DelegatorAccruedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = CommunityPoolSpendProposalWithDeposit{} }
-func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
-func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
-func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
-}
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+// CommunityPoolSpendRecord represents a community pool spend executed by the
+// module authority, as recorded in the community pool spend history.
+type CommunityPoolSpendRecord struct {
+	// id is the sequence number of the spend.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the block height the spend was executed at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// authority is the address which executed the spend.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// recipient is the address which received the spent funds.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount spent from the community pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// proposal_id is the id of the governance proposal which executed the
+	// spend, or zero when the spend was not executed by a proposal.
+	ProposalId uint64 `protobuf:"varint,6,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// proposal_title is the title of the governance proposal which executed
+	// the spend, if any.
+	ProposalTitle string `protobuf:"bytes,7,opt,name=proposal_title,json=proposalTitle,proto3" json:"proposal_title,omitempty"`
+}
+
+func (m *CommunityPoolSpendRecord) Reset()         { *m = CommunityPoolSpendRecord{} }
+func (m *CommunityPoolSpendRecord) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolSpendRecord) ProtoMessage()    {}
+func (*CommunityPoolSpendRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *CommunityPoolSpendRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommunityPoolSpendRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommunityPoolSpendRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -611,415 +889,989 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.Merge(m, src)
+func (m *CommunityPoolSpendRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSpendRecord.Merge(m, src)
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_Size() int {
+func (m *CommunityPoolSpendRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.DiscardUnknown(m)
+func (m *CommunityPoolSpendRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSpendRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
+var xxx_messageInfo_CommunityPoolSpendRecord proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
-	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
-	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
-	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
-	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewards")
-	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
-	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
-	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
-	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
-	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
-	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
-	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+func (m *CommunityPoolSpendRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("cosmos/distribution/v1beta1/distribution.proto", fileDescriptor_cd78a31ea281a992)
+func (m *CommunityPoolSpendRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1012 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x9c, 0xd4, 0x71, 0x8b, 0x6d, 0x56, 0xaa,
-	0x30, 0x81, 0xd8, 0xa4, 0x48, 0x08, 0xe5, 0xd6, 0xd8, 0xad, 0x40, 0x2a, 0x34, 0xda, 0x20, 0x90,
-	0xe0, 0xb0, 0x1a, 0xef, 0x4e, 0xec, 0x21, 0xbb, 0x33, 0xcb, 0xcc, 0xd8, 0x49, 0x0e, 0xdc, 0x03,
-	0x07, 0xe0, 0x06, 0xea, 0xa9, 0x82, 0x4b, 0xc5, 0x29, 0x87, 0xfc, 0x88, 0x8a, 0x53, 0x55, 0x10,
-	0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0x7e, 0x05, 0x9a, 0x9d, 0xf1, 0xae, 0x13, 0x42, 0x81, 0x22,
-	0x8b, 0x4b, 0x94, 0x79, 0x6f, 0xf6, 0x7d, 0xdf, 0xf7, 0xe6, 0x9b, 0x37, 0x86, 0x75, 0x9f, 0xcb,
-	0x88, 0xcb, 0x46, 0x40, 0xa5, 0x12, 0xb4, 0xdd, 0x53, 0x94, 0xb3, 0x46, 0x7f, 0xb9, 0x4d, 0x14,
-	0x5e, 0x3e, 0x11, 0xac, 0xc7, 0x82, 0x2b, 0x8e, 0xae, 0x9a, 0xfd, 0xf5, 0x13, 0x29, 0xbb, 0xbf,
-	0x54, 0xe8, 0xf0, 0x0e, 0x4f, 0xf6, 0x35, 0xf4, 0x7f, 0xe6, 0x93, 0x52, 0xd9, 0x42, 0xb4, 0xb1,
-	0x24, 0x69, 0x69, 0x9f, 0x53, 0x5b, 0xb2, 0xb4, 0x60, 0xf2, 0x9e, 0xf9, 0xd0, 0xd6, 0x37, 0xa9,
-	0xcb, 0x38, 0xa2, 0x8c, 0x37, 0x92, 0xbf, 0x26, 0xe4, 0xdc, 0x1b, 0x83, 0xf9, 0x35, 0x2c, 0x70,
-	0x24, 0xd1, 0xfb, 0xf0, 0x92, 0xcf, 0xa3, 0xa8, 0xc7, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e, 0x82,
-	0x2a, 0xa8, 0x4d, 0xad, 0xbe, 0xfa, 0xf0, 0xa0, 0x92, 0xfb, 0xe9, 0xa0, 0x62, 0xa9, 0xca, 0x60,
-	0xb3, 0x4e, 0x79, 0x23, 0xc2, 0xaa, 0x5b, 0xbf, 0x43, 0x3a, 0xd8, 0xdf, 0x69, 0x11, 0xff, 0xf1,
-	0xfe, 0x12, 0xb4, 0x48, 0x2d, 0xe2, 0x3f, 0x38, 0xde, 0x5b, 0x04, 0xee, 0xc5, 0xb4, 0xd8, 0xdb,
-	0x78, 0x1b, 0x7d, 0x00, 0x0b, 0x9a, 0xb0, 0x66, 0x15, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x16, 0x16,
-	0x41, 0xf1, 0x5c, 0x82, 0xf1, 0xda, 0xd3, 0x61, 0x14, 0x81, 0x8b, 0x74, 0xd5, 0x35, 0x5b, 0xd4,
-	0x4d, 0x6a, 0xa2, 0x10, 0xce, 0xb5, 0x39, 0xeb, 0xc9, 0x3f, 0x81, 0x8d, 0xfd, 0x47, 0xb0, 0xd9,
-	0xa4, 0xec, 0x29, 0xb4, 0x1b, 0x70, 0x6e, 0x8b, 0xaa, 0x6e, 0x20, 0xf0, 0x96, 0x87, 0x83, 0x40,
-	0x78, 0x84, 0xe1, 0x76, 0x48, 0x82, 0xe2, 0x78, 0x15, 0xd4, 0xce, 0xbb, 0xb3, 0x83, 0xe4, 0xcd,
-	0x20, 0x10, 0xb7, 0x4c, 0x6a, 0xe5, 0xfa, 0x27, 0xc7, 0x7b, 0x8b, 0x55, 0x03, 0xb0, 0x24, 0x83,
-	0xcd, 0xc6, 0xf6, 0x49, 0xc7, 0x98, 0x13, 0x71, 0x7e, 0x00, 0xb0, 0xf4, 0x0e, 0x0e, 0x69, 0x80,
-	0x15, 0x17, 0xaf, 0x53, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb0, 0x44, 0x9f, 0x02, 0x78, 0xc5,
-	0xef, 0x45, 0xbd, 0x10, 0x2b, 0xda, 0x27, 0x56, 0xa4, 0x27, 0xb0, 0xa2, 0xbc, 0x08, 0xaa, 0x63,
-	0xb5, 0x0b, 0x37, 0xae, 0x59, 0x3f, 0xd6, 0x75, 0x97, 0x06, 0xbe, 0xd2, 0x8a, 0x9a, 0x9c, 0x32,
-	0xd3, 0x88, 0x6f, 0x7e, 0xae, 0xbc, 0xd8, 0xa1, 0xaa, 0xdb, 0x6b, 0xd7, 0x7d, 0x1e, 0x59, 0xbf,
-	0x34, 0x86, 0xa8, 0xa9, 0x9d, 0x98, 0xc8, 0xc1, 0x37, 0xd2, 0x9c, 0xed, 0x5c, 0x06, 0x6b, 0xc8,
-	0xb8, 0x1a, 0x14, 0x3d, 0x0f, 0x67, 0x04, 0xd9, 0x20, 0x82, 0x30, 0x9f, 0x78, 0x3e, 0xef, 0x31,
-	0x95, 0x9c, 0xef, 0x25, 0x77, 0x3a, 0x0d, 0x37, 0x75, 0xd4, 0xf9, 0x1a, 0xc0, 0x2b, 0xa9, 0xb0,
-	0x66, 0x4f, 0x08, 0xc2, 0xd4, 0x40, 0x55, 0x0c, 0x27, 0x8d, 0x12, 0x39, 0x62, 0x11, 0x03, 0x18,
-	0x34, 0x0f, 0xf3, 0x31, 0x11, 0x94, 0x1b, 0x37, 0x8e, 0xbb, 0x76, 0xe5, 0x7c, 0x09, 0x60, 0x39,
-	0x65, 0x79, 0xd3, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44, 0xa5, 0xa4, 0x9c, 0xa1, 0x3e, 0x84,
-	0x7e, 0xba, 0x1a, 0x31, 0xdf, 0x21, 0x24, 0xe7, 0x33, 0x00, 0xaf, 0xa6, 0xd4, 0xee, 0xf6, 0x94,
-	0x54, 0x98, 0x05, 0x94, 0x75, 0xfe, 0xb7, 0x26, 0x6a, 0x46, 0xb3, 0x29, 0xa3, 0xf5, 0x10, 0xcb,
-	0xee, 0xad, 0x3e, 0x61, 0x0a, 0xbd, 0x00, 0x9f, 0xe9, 0x0f, 0xc2, 0x9e, 0x6d, 0x33, 0x48, 0xda,
-	0x3c, 0x93, 0xc6, 0xd7, 0x92, 0x30, 0x7a, 0x13, 0x9e, 0xdf, 0x10, 0xd8, 0xd7, 0x37, 0xc0, 0xce,
-	0x85, 0xe5, 0x7f, 0x7d, 0x55, 0xdd, 0xb4, 0x84, 0xf3, 0x31, 0x80, 0x85, 0x33, 0x18, 0x49, 0xf4,
-	0x21, 0x9c, 0xcf, 0x28, 0x49, 0x9d, 0xf0, 0x48, 0x92, 0xb1, 0xbd, 0x7a, 0xb9, 0xfe, 0x84, 0xa9,
-	0x5c, 0x3f, 0xa3, 0xe4, 0xea, 0x94, 0xe6, 0x69, 0x1a, 0x52, 0xe8, 0x9f, 0x01, 0xe9, 0xec, 0x02,
-	0x38, 0x79, 0x9b, 0x90, 0x35, 0xce, 0x43, 0xf4, 0x11, 0x9c, 0xce, 0xe6, 0x6c, 0xcc, 0x79, 0x38,
-	0xe2, 0x23, 0xca, 0xa6, 0xba, 0x86, 0x77, 0xbe, 0x38, 0x07, 0x4b, 0xcd, 0xe1, 0xc8, 0x7a, 0x4c,
-	0x58, 0x60, 0x86, 0x1a, 0x0e, 0x51, 0x01, 0x4e, 0x28, 0xaa, 0x42, 0x62, 0xa6, 0xbf, 0x6b, 0x16,
-	0xa8, 0x0a, 0x2f, 0x04, 0x44, 0xfa, 0x82, 0xc6, 0xd9, 0xe9, 0xb8, 0xc3, 0x21, 0x74, 0x0d, 0x4e,
-	0x09, 0xe2, 0xd3, 0x98, 0x12, 0xa6, 0xcc, 0xa0, 0x75, 0xb3, 0x00, 0xda, 0x81, 0x79, 0x1c, 0x25,
-	0x03, 0x61, 0x3c, 0xd1, 0xba, 0x70, 0xa6, 0xd6, 0x44, 0xe8, 0x6d, 0x2b, 0xb4, 0xf6, 0x0f, 0x84,
-	0x26, 0x2a, 0xef, 0x1d, 0xef, 0x2d, 0x5e, 0x0c, 0x13, 0x3b, 0x78, 0x7e, 0x26, 0xdb, 0x02, 0xae,
-	0xd4, 0x76, 0xef, 0x57, 0x72, 0xbf, 0xdd, 0xaf, 0xe4, 0xbe, 0xdd, 0x5f, 0x2a, 0x59, 0xd4, 0x0e,
-	0xef, 0x0f, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x7b, 0x00, 0xe7, 0x5a, 0x44, 0x57, 0xd2, 0xa7,
-	0xa7, 0xb0, 0x50, 0x94, 0x75, 0xde, 0x60, 0x1b, 0xc9, 0x60, 0x8b, 0x05, 0xe9, 0x53, 0xae, 0x1f,
-	0x95, 0x61, 0x0f, 0x4f, 0x0f, 0xc2, 0xd6, 0xc2, 0x77, 0xe0, 0x84, 0x54, 0x78, 0x93, 0x58, 0xff,
-	0x3e, 0xed, 0xdb, 0x69, 0x8a, 0xa0, 0x16, 0xcc, 0x77, 0x09, 0xed, 0x74, 0x4d, 0x43, 0xc7, 0x57,
-	0x5f, 0xfa, 0xfd, 0xa0, 0x32, 0xe3, 0x0b, 0xa2, 0x87, 0x2d, 0xf3, 0x4c, 0xea, 0xab, 0xe3, 0xbd,
-	0xc5, 0xd3, 0x31, 0xdb, 0x00, 0xb3, 0x70, 0x7e, 0x05, 0x70, 0xc1, 0xca, 0xa2, 0x9c, 0xa5, 0x02,
-	0xed, 0xf3, 0xf5, 0x16, 0xbc, 0x9c, 0x5d, 0x06, 0xfd, 0x7e, 0x11, 0x29, 0xed, 0xcb, 0xff, 0xdc,
-	0xe3, 0xfd, 0xa5, 0x67, 0x2d, 0xb5, 0x6c, 0x0e, 0x9a, 0x2d, 0xeb, 0x4a, 0xe8, 0x71, 0x93, 0xdd,
-	0x6d, 0x1b, 0x47, 0x0c, 0xe6, 0xd3, 0xa7, 0x7d, 0x94, 0xae, 0xb6, 0x28, 0x2b, 0xe3, 0xfa, 0x78,
-	0x9d, 0xef, 0x00, 0xbc, 0xfe, 0xd7, 0xa6, 0x7e, 0x97, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35,
-	0x22, 0x7f, 0xcf, 0x0f, 0xf9, 0x5b, 0xa7, 0xec, 0x0a, 0x15, 0xe1, 0x64, 0x60, 0x80, 0x8b, 0x13,
-	0x49, 0x62, 0xb0, 0x5c, 0x71, 0x76, 0xff, 0xd6, 0x92, 0xab, 0x77, 0x1f, 0x1c, 0x96, 0xc1, 0xc3,
-	0xc3, 0x32, 0x78, 0x74, 0x58, 0x06, 0xbf, 0x1c, 0x96, 0xc1, 0xe7, 0x47, 0xe5, 0xdc, 0xa3, 0xa3,
-	0x72, 0xee, 0xc7, 0xa3, 0x72, 0xee, 0xbd, 0xe5, 0x27, 0xf6, 0xec, 0xd4, 0x2f, 0x8a, 0xa4, 0x85,
-	0xed, 0x7c, 0xf2, 0xa3, 0xef, 0x95, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x78, 0xae, 0xbc, 0xbc,
-	0xa7, 0x0a, 0x00, 0x00,
+func (m *CommunityPoolSpendRecord) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CommunityPoolSpendRecord) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CommunityTax.Equal(that1.CommunityTax) {
-		return false
-	}
-	if !this.BaseProposerReward.Equal(that1.BaseProposerReward) {
-		return false
-	}
-	if !this.BonusProposerReward.Equal(that1.BonusProposerReward) {
-		return false
-	}
-	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
-		return false
+func (m *CommunityPoolSpendRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return true
+	return nil
 }
-func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ValidatorHistoricalRewards)
-	if !ok {
-		that2, ok := that.(ValidatorHistoricalRewards)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *CommunityPoolSpendRecord) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
-		return false
+	return 0
+}
+
+func (m *CommunityPoolSpendRecord) GetProposalTitle() string {
+	if m != nil {
+		return m.ProposalTitle
 	}
-	for i := range this.CumulativeRewardRatio {
-		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
-			return false
+	return ""
+}
+
+// ValidatorRewardDistributionStats represents the cumulative amounts withdrawn
+// from a validator, split between its own self-bond, external delegators and
+// the validator commission. The counters are never pruned and can only be reset
+// by governance.
+type ValidatorRewardDistributionStats struct {
+	// self_bond_rewards defines the rewards withdrawn by the validator operator
+	// for its own self-delegation.
+	SelfBondRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=self_bond_rewards,json=selfBondRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"self_bond_rewards"`
+	// external_rewards defines the rewards withdrawn by all other delegators.
+	ExternalRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=external_rewards,json=externalRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"external_rewards"`
+	// commission_withdrawn defines the commission withdrawn by the validator.
+	CommissionWithdrawn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=commission_withdrawn,json=commissionWithdrawn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission_withdrawn"`
+}
+
+func (m *ValidatorRewardDistributionStats) Reset()         { *m = ValidatorRewardDistributionStats{} }
+func (m *ValidatorRewardDistributionStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardDistributionStats) ProtoMessage()    {}
+func (*ValidatorRewardDistributionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *ValidatorRewardDistributionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardDistributionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardDistributionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.ReferenceCount != that1.ReferenceCount {
-		return false
-	}
-	return true
 }
-func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ValidatorRewardDistributionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardDistributionStats.Merge(m, src)
+}
+func (m *ValidatorRewardDistributionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardDistributionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardDistributionStats.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ValidatorCurrentRewards)
-	if !ok {
-		that2, ok := that.(ValidatorCurrentRewards)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_ValidatorRewardDistributionStats proto.InternalMessageInfo
+
+func (m *ValidatorRewardDistributionStats) GetSelfBondRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SelfBondRewards
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *ValidatorRewardDistributionStats) GetExternalRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExternalRewards
 	}
-	if len(this.Rewards) != len(that1.Rewards) {
-		return false
+	return nil
+}
+
+func (m *ValidatorRewardDistributionStats) GetCommissionWithdrawn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommissionWithdrawn
 	}
-	for i := range this.Rewards {
-		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
-			return false
+	return nil
+}
+
+// ValidatorDustStats represents the cumulative truncation remainders of the
+// rewards and commission withdrawn from a validator which were sent to the
+// community pool.
+type ValidatorDustStats struct {
+	Dust github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=dust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"dust"`
+}
+
+func (m *ValidatorDustStats) Reset()         { *m = ValidatorDustStats{} }
+func (m *ValidatorDustStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDustStats) ProtoMessage()    {}
+func (*ValidatorDustStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{14}
+}
+func (m *ValidatorDustStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDustStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDustStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Period != that1.Period {
-		return false
-	}
-	return true
 }
-func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidatorDustStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDustStats.Merge(m, src)
+}
+func (m *ValidatorDustStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDustStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDustStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDustStats proto.InternalMessageInfo
+
+func (m *ValidatorDustStats) GetDust() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Dust
 	}
+	return nil
+}
 
-	that1, ok := that.(*ValidatorAccumulatedCommission)
-	if !ok {
-		that2, ok := that.(ValidatorAccumulatedCommission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorCommissionWithholding represents the governance controlled
+// withholding of the commission of a validator. While withheld, the commission
+// withdrawn from the validator accumulates in amount until the withholding is
+// resolved by governance.
+type ValidatorCommissionWithholding struct {
+	// withheld defines whether the commission withdrawals of the validator are
+	// currently withheld.
+	Withheld bool `protobuf:"varint,1,opt,name=withheld,proto3" json:"withheld,omitempty"`
+	// amount defines the withheld commission which has not been resolved yet.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ValidatorCommissionWithholding) Reset()         { *m = ValidatorCommissionWithholding{} }
+func (m *ValidatorCommissionWithholding) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionWithholding) ProtoMessage()    {}
+func (*ValidatorCommissionWithholding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{15}
+}
+func (m *ValidatorCommissionWithholding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionWithholding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionWithholding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ValidatorCommissionWithholding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionWithholding.Merge(m, src)
+}
+func (m *ValidatorCommissionWithholding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionWithholding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionWithholding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionWithholding proto.InternalMessageInfo
+
+func (m *ValidatorCommissionWithholding) GetWithheld() bool {
+	if m != nil {
+		return m.Withheld
 	}
-	if len(this.Commission) != len(that1.Commission) {
-		return false
+	return false
+}
+
+func (m *ValidatorCommissionWithholding) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	for i := range this.Commission {
-		if !this.Commission[i].Equal(&that1.Commission[i]) {
-			return false
+	return nil
+}
+
+// ValidatorRewardAdjustment represents an adjustment of the rewards of a
+// validator, either credited to them from the community pool or deducted from
+// them to the community pool.
+type ValidatorRewardAdjustment struct {
+	// validator_address is the address of the adjusted validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the amount credited to, or deducted from, the rewards of the
+	// validator. It is split between the validator commission and its delegators
+	// according to the commission rate of the validator.
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+	// deduct defines whether the amount is deducted from the rewards of the
+	// validator rather than credited to them.
+	Deduct bool `protobuf:"varint,3,opt,name=deduct,proto3" json:"deduct,omitempty"`
+}
+
+func (m *ValidatorRewardAdjustment) Reset()         { *m = ValidatorRewardAdjustment{} }
+func (m *ValidatorRewardAdjustment) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardAdjustment) ProtoMessage()    {}
+func (*ValidatorRewardAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{16}
+}
+func (m *ValidatorRewardAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ValidatorRewardAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardAdjustment.Merge(m, src)
+}
+func (m *ValidatorRewardAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardAdjustment.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ValidatorOutstandingRewards)
-	if !ok {
-		that2, ok := that.(ValidatorOutstandingRewards)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_ValidatorRewardAdjustment proto.InternalMessageInfo
+
+func (m *ValidatorRewardAdjustment) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *ValidatorRewardAdjustment) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
 	}
-	if len(this.Rewards) != len(that1.Rewards) {
-		return false
+	return nil
+}
+
+func (m *ValidatorRewardAdjustment) GetDeduct() bool {
+	if m != nil {
+		return m.Deduct
 	}
-	for i := range this.Rewards {
-		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
-			return false
+	return false
+}
+
+// RewardAdjustmentRecord represents a reward adjustment applied by the module
+// authority, as recorded in the reward adjustment audit log.
+type RewardAdjustmentRecord struct {
+	// id is the sequence number of the adjustment.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the block height the adjustment was applied at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// authority is the address which applied the adjustment.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// justification describes why the rewards were adjusted.
+	Justification string `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	// start_height is the first height of the misallocated rewards.
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the misallocated rewards.
+	EndHeight int64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// adjustments are the adjustments of the rewards of the validators.
+	Adjustments []ValidatorRewardAdjustment `protobuf:"bytes,7,rep,name=adjustments,proto3" json:"adjustments"`
+	// proposal_id is the id of the governance proposal which applied the
+	// adjustment, or zero when it was not applied by a proposal.
+	ProposalId uint64 `protobuf:"varint,8,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// proposal_title is the title of the governance proposal which applied the
+	// adjustment, if any.
+	ProposalTitle string `protobuf:"bytes,9,opt,name=proposal_title,json=proposalTitle,proto3" json:"proposal_title,omitempty"`
+}
+
+func (m *RewardAdjustmentRecord) Reset()         { *m = RewardAdjustmentRecord{} }
+func (m *RewardAdjustmentRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardAdjustmentRecord) ProtoMessage()    {}
+func (*RewardAdjustmentRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{17}
+}
+func (m *RewardAdjustmentRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardAdjustmentRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardAdjustmentRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ValidatorSlashEvent) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RewardAdjustmentRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardAdjustmentRecord.Merge(m, src)
+}
+func (m *RewardAdjustmentRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardAdjustmentRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardAdjustmentRecord.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ValidatorSlashEvent)
-	if !ok {
-		that2, ok := that.(ValidatorSlashEvent)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_RewardAdjustmentRecord proto.InternalMessageInfo
+
+func (m *RewardAdjustmentRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *RewardAdjustmentRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if this.ValidatorPeriod != that1.ValidatorPeriod {
-		return false
+	return 0
+}
+
+func (m *RewardAdjustmentRecord) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if !this.Fraction.Equal(that1.Fraction) {
-		return false
+	return ""
+}
+
+func (m *RewardAdjustmentRecord) GetJustification() string {
+	if m != nil {
+		return m.Justification
 	}
-	return true
+	return ""
 }
-func (this *ValidatorSlashEvents) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RewardAdjustmentRecord) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
+	return 0
+}
 
-	that1, ok := that.(*ValidatorSlashEvents)
-	if !ok {
-		that2, ok := that.(ValidatorSlashEvents)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *RewardAdjustmentRecord) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *RewardAdjustmentRecord) GetAdjustments() []ValidatorRewardAdjustment {
+	if m != nil {
+		return m.Adjustments
 	}
-	if len(this.ValidatorSlashEvents) != len(that1.ValidatorSlashEvents) {
-		return false
+	return nil
+}
+
+func (m *RewardAdjustmentRecord) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	for i := range this.ValidatorSlashEvents {
-		if !this.ValidatorSlashEvents[i].Equal(&that1.ValidatorSlashEvents[i]) {
-			return false
-		}
-	}
-	return true
+	return 0
 }
-func (this *FeePool) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RewardAdjustmentRecord) GetProposalTitle() string {
+	if m != nil {
+		return m.ProposalTitle
 	}
+	return ""
+}
 
-	that1, ok := that.(*FeePool)
-	if !ok {
-		that2, ok := that.(FeePool)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// DelegationDelegatorReward represents the properties
+// of a delegator's delegation reward.
+type DelegationDelegatorReward struct {
+	ValidatorAddress string                                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Reward           github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
+}
+
+func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorReward{} }
+func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
+func (*DelegationDelegatorReward) ProtoMessage()    {}
+func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{18}
+}
+func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationDelegatorReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationDelegatorReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.CommunityPool) != len(that1.CommunityPool) {
-		return false
-	}
-	for i := range this.CommunityPool {
-		if !this.CommunityPool[i].Equal(&that1.CommunityPool[i]) {
-			return false
+}
+func (m *DelegationDelegatorReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationDelegatorReward.Merge(m, src)
+}
+func (m *DelegationDelegatorReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationDelegatorReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationDelegatorReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
+
+// CommunityPoolSpendProposalWithDeposit defines a CommunityPoolSpendProposal
+// with a deposit
+type CommunityPoolSpendProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient   string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount      string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Deposit     string `protobuf:"bytes,5,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = CommunityPoolSpendProposalWithDeposit{} }
+func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
+func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{19}
+}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.Merge(m, src)
+}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSpendProposalWithDeposit.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*DelegatorStartingInfo)
-	if !ok {
-		that2, ok := that.(DelegatorStartingInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
+
+// RewardsForfeiture represents rewards of a validator which were forfeited to
+// the community pool at a height.
+type RewardsForfeiture struct {
+	// height defines the block height of the forfeiture.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// amount defines the forfeited rewards.
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *RewardsForfeiture) Reset()         { *m = RewardsForfeiture{} }
+func (m *RewardsForfeiture) String() string { return proto.CompactTextString(m) }
+func (*RewardsForfeiture) ProtoMessage()    {}
+func (*RewardsForfeiture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{20}
+}
+func (m *RewardsForfeiture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsForfeiture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsForfeiture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *RewardsForfeiture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsForfeiture.Merge(m, src)
+}
+func (m *RewardsForfeiture) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsForfeiture) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsForfeiture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsForfeiture proto.InternalMessageInfo
+
+func (m *RewardsForfeiture) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if this.PreviousPeriod != that1.PreviousPeriod {
-		return false
+	return 0
+}
+
+func (m *RewardsForfeiture) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
 	}
-	if !this.Stake.Equal(that1.Stake) {
-		return false
+	return nil
+}
+
+// ValidatorForfeitedRewards represents the rewards of a validator which were
+// forfeited to the community pool because the validator had no tokens left,
+// e.g. after being slashed to zero, so that they could not be attributed to
+// its delegators.
+type ValidatorForfeitedRewards struct {
+	// forfeited defines the cumulative forfeited rewards.
+	Forfeited github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=forfeited,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"forfeited"`
+	// recent defines the most recent forfeitures, oldest first. Only the last
+	// MaxRecentRewardsForfeitures forfeitures are kept.
+	Recent []RewardsForfeiture `protobuf:"bytes,2,rep,name=recent,proto3" json:"recent"`
+}
+
+func (m *ValidatorForfeitedRewards) Reset()         { *m = ValidatorForfeitedRewards{} }
+func (m *ValidatorForfeitedRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorForfeitedRewards) ProtoMessage()    {}
+func (*ValidatorForfeitedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{21}
+}
+func (m *ValidatorForfeitedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorForfeitedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorForfeitedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Height != that1.Height {
-		return false
+}
+func (m *ValidatorForfeitedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorForfeitedRewards.Merge(m, src)
+}
+func (m *ValidatorForfeitedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorForfeitedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorForfeitedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorForfeitedRewards proto.InternalMessageInfo
+
+func (m *ValidatorForfeitedRewards) GetForfeited() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Forfeited
 	}
-	return true
+	return nil
 }
-func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidatorForfeitedRewards) GetRecent() []RewardsForfeiture {
+	if m != nil {
+		return m.Recent
 	}
+	return nil
+}
 
-	that1, ok := that.(*DelegationDelegatorReward)
-	if !ok {
-		that2, ok := that.(DelegationDelegatorReward)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RewardQuote represents the value of a reward coin in a quote denom, as
+// priced by the price source configured on the node.
+type RewardQuote struct {
+	// denom defines the denom of the reward coin.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// value defines the value of the reward coin in the quote denom. It is zero
+	// if the price is unavailable.
+	Value cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=value,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"value"`
+	// price_unavailable defines whether the price source has no price of the
+	// denom in the quote denom.
+	PriceUnavailable bool `protobuf:"varint,3,opt,name=price_unavailable,json=priceUnavailable,proto3" json:"price_unavailable,omitempty"`
+}
+
+func (m *RewardQuote) Reset()         { *m = RewardQuote{} }
+func (m *RewardQuote) String() string { return proto.CompactTextString(m) }
+func (*RewardQuote) ProtoMessage()    {}
+func (*RewardQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{22}
+}
+func (m *RewardQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
-		return false
+}
+func (m *RewardQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardQuote.Merge(m, src)
+}
+func (m *RewardQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardQuote proto.InternalMessageInfo
+
+func (m *RewardQuote) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(this.Reward) != len(that1.Reward) {
-		return false
+	return ""
+}
+
+func (m *RewardQuote) GetPriceUnavailable() bool {
+	if m != nil {
+		return m.PriceUnavailable
 	}
-	for i := range this.Reward {
-		if !this.Reward[i].Equal(&that1.Reward[i]) {
-			return false
+	return false
+}
+
+// CommunityPoolRemainderAccrued represents the cumulative truncation
+// remainders of the withdrawn rewards and commission which were added to the
+// community pool.
+type CommunityPoolRemainderAccrued struct {
+	// remainder defines the cumulative remainders.
+	Remainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"remainder"`
+}
+
+func (m *CommunityPoolRemainderAccrued) Reset()         { *m = CommunityPoolRemainderAccrued{} }
+func (m *CommunityPoolRemainderAccrued) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolRemainderAccrued) ProtoMessage()    {}
+func (*CommunityPoolRemainderAccrued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{23}
+}
+func (m *CommunityPoolRemainderAccrued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolRemainderAccrued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolRemainderAccrued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CommunityPoolRemainderAccrued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolRemainderAccrued.Merge(m, src)
+}
+func (m *CommunityPoolRemainderAccrued) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolRemainderAccrued) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolRemainderAccrued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolRemainderAccrued proto.InternalMessageInfo
+
+func (m *CommunityPoolRemainderAccrued) GetRemainder() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Remainder
 	}
+	return nil
+}
 
-	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+// ImplicitWithdrawals represents the rewards withdrawn by the implicit
+// settlements of the delegations of a delegator within a transaction, pending
+// to be reported by a single withdraw_rewards event.
+type ImplicitWithdrawals struct {
+	// sequence defines the order of the first settlement of the delegator in
+	// the transaction.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// validator_count defines the number of settled delegations.
+	ValidatorCount uint32 `protobuf:"varint,2,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+	// amount defines the sum of the withdrawn rewards.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ImplicitWithdrawals) Reset()         { *m = ImplicitWithdrawals{} }
+func (m *ImplicitWithdrawals) String() string { return proto.CompactTextString(m) }
+func (*ImplicitWithdrawals) ProtoMessage()    {}
+func (*ImplicitWithdrawals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{24}
+}
+func (m *ImplicitWithdrawals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImplicitWithdrawals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImplicitWithdrawals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImplicitWithdrawals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImplicitWithdrawals.Merge(m, src)
+}
+func (m *ImplicitWithdrawals) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImplicitWithdrawals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImplicitWithdrawals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImplicitWithdrawals proto.InternalMessageInfo
+
+func (m *ImplicitWithdrawals) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ImplicitWithdrawals) GetValidatorCount() uint32 {
+	if m != nil {
+		return m.ValidatorCount
+	}
+	return 0
+}
+
+func (m *ImplicitWithdrawals) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.distribution.v1beta1.OutstandingRewardsDenomPolicy", OutstandingRewardsDenomPolicy_name, OutstandingRewardsDenomPolicy_value)
+	proto.RegisterEnum("cosmos.distribution.v1beta1.CommissionWithholdingDestination", CommissionWithholdingDestination_name, CommissionWithholdingDestination_value)
+	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
+	proto.RegisterType((*CommunityTaxOverride)(nil), "cosmos.distribution.v1beta1.CommunityTaxOverride")
+	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
+	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
+	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
+	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewards")
+	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
+	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
+	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
+	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
+	proto.RegisterType((*DelegatorAccruedRewards)(nil), "cosmos.distribution.v1beta1.DelegatorAccruedRewards")
+	proto.RegisterType((*CommunityPoolSpendRecord)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendRecord")
+	proto.RegisterType((*ValidatorRewardDistributionStats)(nil), "cosmos.distribution.v1beta1.ValidatorRewardDistributionStats")
+	proto.RegisterType((*ValidatorDustStats)(nil), "cosmos.distribution.v1beta1.ValidatorDustStats")
+	proto.RegisterType((*ValidatorCommissionWithholding)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionWithholding")
+	proto.RegisterType((*ValidatorRewardAdjustment)(nil), "cosmos.distribution.v1beta1.ValidatorRewardAdjustment")
+	proto.RegisterType((*RewardAdjustmentRecord)(nil), "cosmos.distribution.v1beta1.RewardAdjustmentRecord")
+	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
+	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*RewardsForfeiture)(nil), "cosmos.distribution.v1beta1.RewardsForfeiture")
+	proto.RegisterType((*ValidatorForfeitedRewards)(nil), "cosmos.distribution.v1beta1.ValidatorForfeitedRewards")
+	proto.RegisterType((*RewardQuote)(nil), "cosmos.distribution.v1beta1.RewardQuote")
+	proto.RegisterType((*CommunityPoolRemainderAccrued)(nil), "cosmos.distribution.v1beta1.CommunityPoolRemainderAccrued")
+	proto.RegisterType((*ImplicitWithdrawals)(nil), "cosmos.distribution.v1beta1.ImplicitWithdrawals")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/distribution/v1beta1/distribution.proto", fileDescriptor_cd78a31ea281a992)
+}
+
+var fileDescriptor_cd78a31ea281a992 = []byte{
+	// 2211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0x77, 0xf9, 0xed, 0x72, 0xfc, 0x48, 0xd9, 0x49, 0x3a, 0xde, 0xd8, 0x9e, 0x8c, 0x36, 0x60,
+	0x9c, 0xf5, 0x38, 0xce, 0x92, 0x68, 0xe5, 0xdb, 0x78, 0x66, 0x42, 0x06, 0x1c, 0x8f, 0xd3, 0x33,
+	0x49, 0x14, 0x90, 0x68, 0xca, 0xdd, 0xe5, 0x99, 0x8a, 0x7b, 0xba, 0x67, 0xbb, 0xaa, 0x27, 0xf1,
+	0x81, 0x33, 0x01, 0xb1, 0xc0, 0x01, 0xc4, 0x8a, 0x53, 0xb4, 0x5c, 0xa2, 0x3d, 0x45, 0xc2, 0x12,
+	0x37, 0xce, 0x2b, 0xc4, 0x61, 0x15, 0x10, 0x42, 0x1c, 0x02, 0x24, 0x87, 0xa0, 0x95, 0xf8, 0x03,
+	0xb8, 0xa1, 0xea, 0xaa, 0xee, 0x9e, 0x19, 0xb7, 0x1f, 0xd9, 0x68, 0xcc, 0x5e, 0x2c, 0xf7, 0x57,
+	0xf5, 0x3d, 0xea, 0x7b, 0xfc, 0xbe, 0xaf, 0x6a, 0x60, 0xc6, 0x74, 0x59, 0xdd, 0x65, 0xcb, 0x16,
+	0x65, 0xdc, 0xa3, 0x5b, 0x3e, 0xa7, 0xae, 0xb3, 0xdc, 0x5c, 0xd9, 0x22, 0x1c, 0xaf, 0xb4, 0x11,
+	0x33, 0x0d, 0xcf, 0xe5, 0x2e, 0x7a, 0x47, 0xee, 0xcf, 0xb4, 0x2d, 0xa9, 0xfd, 0x33, 0xd3, 0x55,
+	0xb7, 0xea, 0x06, 0xfb, 0x96, 0xc5, 0x7f, 0x92, 0x65, 0x66, 0x4e, 0xa9, 0xd8, 0xc2, 0x8c, 0x44,
+	0xa2, 0x4d, 0x97, 0x2a, 0x91, 0x33, 0xe7, 0xe5, 0xba, 0x21, 0x19, 0x95, 0x7c, 0xb9, 0x74, 0x1a,
+	0xd7, 0xa9, 0xe3, 0x2e, 0x07, 0x7f, 0x25, 0x29, 0xfd, 0x74, 0x0c, 0x0e, 0x6e, 0x62, 0x0f, 0xd7,
+	0x19, 0xfa, 0x1e, 0x1c, 0x33, 0xdd, 0x7a, 0xdd, 0x77, 0x28, 0xdf, 0x35, 0x38, 0x7e, 0xa4, 0x81,
+	0x14, 0x58, 0x18, 0x59, 0xbb, 0xfe, 0xd9, 0x8b, 0xf9, 0x9e, 0xbf, 0xbf, 0x98, 0x57, 0xa6, 0x32,
+	0x6b, 0x27, 0x43, 0xdd, 0xe5, 0x3a, 0xe6, 0xb5, 0xcc, 0x3a, 0xa9, 0x62, 0x73, 0x37, 0x4f, 0xcc,
+	0xe7, 0x7b, 0x4b, 0x50, 0x69, 0xca, 0x13, 0xf3, 0xe9, 0xeb, 0x67, 0x8b, 0x40, 0x3f, 0x15, 0x09,
+	0xab, 0xe0, 0x47, 0xe8, 0x01, 0x9c, 0x16, 0x06, 0x0b, 0xab, 0x1a, 0x2e, 0x23, 0x9e, 0xe1, 0x91,
+	0x87, 0xd8, 0xb3, 0xb4, 0xde, 0x40, 0xc7, 0x07, 0x5f, 0x4e, 0x87, 0x06, 0x74, 0x24, 0xa4, 0x6e,
+	0x2a, 0xa1, 0x7a, 0x20, 0x13, 0xd9, 0xf0, 0xcc, 0x96, 0xeb, 0xf8, 0x6c, 0x9f, 0xb2, 0xbe, 0xb7,
+	0x54, 0x36, 0x15, 0x88, 0xed, 0xd0, 0x76, 0x15, 0x9e, 0x79, 0x48, 0x79, 0xcd, 0xf2, 0xf0, 0x43,
+	0x03, 0x5b, 0x96, 0x67, 0x10, 0x07, 0x6f, 0xd9, 0xc4, 0xd2, 0xfa, 0x53, 0x60, 0x61, 0x58, 0x9f,
+	0x0a, 0x17, 0xb3, 0x96, 0xe5, 0x15, 0xe4, 0x12, 0xfa, 0x11, 0x80, 0xe7, 0xda, 0x7c, 0x6d, 0xb8,
+	0x4d, 0xe2, 0x79, 0xd4, 0x22, 0x4c, 0x1b, 0x48, 0xf5, 0x2d, 0x8c, 0x5e, 0x5d, 0xc9, 0x1c, 0x92,
+	0x19, 0x99, 0x5c, 0x8b, 0x6b, 0x4b, 0x8a, 0x73, 0xed, 0x42, 0x70, 0xae, 0xbd, 0xa5, 0x09, 0xc9,
+	0xb9, 0xc4, 0xac, 0x9d, 0xd4, 0x95, 0xcc, 0xb5, 0x6f, 0xca, 0x70, 0x9c, 0x31, 0x13, 0x78, 0x18,
+	0xba, 0x0d, 0x67, 0xa4, 0x73, 0x0c, 0xb3, 0x46, 0xcc, 0x9d, 0x86, 0x4b, 0x1d, 0x6e, 0x50, 0x87,
+	0x13, 0xaf, 0x89, 0x6d, 0x6d, 0x30, 0x05, 0x16, 0xfa, 0xd7, 0xa6, 0x12, 0x84, 0xea, 0x9a, 0x64,
+	0xcb, 0x45, 0x5c, 0x45, 0xc5, 0x84, 0x2a, 0xf0, 0xc2, 0x3e, 0x91, 0xcc, 0x68, 0x10, 0xcf, 0xd8,
+	0xb2, 0x5d, 0x73, 0x47, 0x1b, 0x3a, 0x58, 0xe8, 0xf9, 0x4e, 0xa1, 0x6c, 0x93, 0x78, 0x6b, 0x82,
+	0x0b, 0x55, 0xe1, 0xd7, 0x62, 0x8f, 0x35, 0x5c, 0xd7, 0x36, 0x58, 0x83, 0x38, 0x96, 0x51, 0xa3,
+	0x8c, 0xbb, 0xde, 0xae, 0xe1, 0x11, 0x4e, 0x1c, 0xe1, 0x24, 0x6d, 0xf8, 0x60, 0xf9, 0xe9, 0x48,
+	0xc4, 0xa6, 0xeb, 0xda, 0x65, 0x21, 0xe0, 0xa6, 0xe4, 0xd7, 0x43, 0x76, 0xb4, 0x03, 0x17, 0xa4,
+	0x4c, 0x6a, 0x62, 0x5b, 0x65, 0x0e, 0x33, 0x4c, 0xb7, 0xde, 0xc0, 0xa6, 0xd8, 0xd0, 0x7a, 0x94,
+	0x91, 0x83, 0x55, 0xbd, 0x1b, 0x0b, 0x91, 0x89, 0xc2, 0x72, 0xb1, 0x88, 0xe8, 0x54, 0x77, 0xe1,
+	0x6c, 0x5d, 0x44, 0xdf, 0xe7, 0x8c, 0x63, 0xc7, 0xa2, 0x4e, 0x55, 0x69, 0x34, 0x2c, 0xe2, 0xb8,
+	0x75, 0xa6, 0xc1, 0x14, 0x58, 0x18, 0x4b, 0xd6, 0x30, 0x53, 0xc7, 0x8f, 0x4a, 0x31, 0xa3, 0xd4,
+	0x92, 0x0f, 0xd8, 0xd0, 0xc7, 0x00, 0xa6, 0xf6, 0x0b, 0x65, 0x52, 0xaa, 0xd1, 0x70, 0x6d, 0x6a,
+	0xee, 0x6a, 0xa3, 0x29, 0xb0, 0x30, 0x7e, 0x75, 0xf5, 0xd0, 0x4c, 0xdb, 0xa7, 0x80, 0x05, 0x1a,
+	0x36, 0x03, 0x09, 0xc9, 0x76, 0xcd, 0xba, 0x87, 0xf1, 0xa0, 0xa7, 0x00, 0x9e, 0xa9, 0x53, 0xc7,
+	0x08, 0xeb, 0x02, 0xdb, 0x06, 0xae, 0xbb, 0xbe, 0xc3, 0xb5, 0x53, 0x41, 0xe6, 0x9f, 0x0f, 0xed,
+	0x11, 0x95, 0xdd, 0x92, 0xf1, 0xd4, 0x59, 0xbb, 0x2f, 0x32, 0xfc, 0xd3, 0x7f, 0xcc, 0x2f, 0x54,
+	0x29, 0xaf, 0xf9, 0x5b, 0x19, 0xd3, 0xad, 0x2b, 0x80, 0x5b, 0x8e, 0x8d, 0x58, 0xe6, 0xbb, 0x0d,
+	0xc2, 0x02, 0x06, 0x96, 0x60, 0xde, 0x6f, 0x5e, 0x3f, 0x5b, 0x3c, 0x65, 0x07, 0x75, 0x6e, 0x08,
+	0xd4, 0x64, 0xb2, 0x3c, 0xa6, 0xea, 0xd4, 0xb9, 0x17, 0x59, 0x94, 0x0d, 0x0c, 0x42, 0xdf, 0x81,
+	0xe7, 0x84, 0xa5, 0x8c, 0x70, 0x6e, 0x93, 0x3a, 0x69, 0xad, 0x8c, 0xb1, 0x83, 0x23, 0x2f, 0x4e,
+	0x57, 0x8e, 0x58, 0xa2, 0xb2, 0xb8, 0x0b, 0x67, 0x3b, 0x12, 0x18, 0xdb, 0xb6, 0xfb, 0x90, 0x44,
+	0xa1, 0x1e, 0x4f, 0xf5, 0x2d, 0x8c, 0x1c, 0x10, 0xea, 0xb6, 0xbc, 0xcd, 0x4a, 0x3e, 0x15, 0xea,
+	0x1f, 0xc0, 0x34, 0xae, 0x56, 0x3d, 0x52, 0xc5, 0x9c, 0x18, 0xb4, 0xde, 0xb0, 0xa9, 0x49, 0x79,
+	0xe4, 0x5d, 0x83, 0x34, 0x89, 0xc3, 0x99, 0x36, 0x21, 0xc0, 0x28, 0x59, 0xf8, 0x7c, 0xc4, 0x5e,
+	0x54, 0xdc, 0xa1, 0x23, 0x0a, 0x01, 0x2f, 0xfa, 0x3e, 0xbc, 0x18, 0x26, 0x65, 0x4b, 0x8a, 0x18,
+	0x8c, 0x63, 0xce, 0x22, 0xb4, 0x9b, 0x3c, 0x58, 0xc1, 0x9c, 0xe4, 0xce, 0xb7, 0x30, 0x97, 0x05,
+	0xaf, 0x42, 0xc3, 0xd5, 0x4b, 0x3f, 0x79, 0xfd, 0x6c, 0x31, 0xd5, 0x12, 0xc1, 0x47, 0xed, 0xfd,
+	0x53, 0xf6, 0xa7, 0xf4, 0x47, 0x00, 0x4e, 0x27, 0x01, 0x1f, 0x9a, 0x86, 0x03, 0x81, 0x0b, 0x65,
+	0xc3, 0xd2, 0xe5, 0x07, 0xfa, 0x36, 0xec, 0xf7, 0x30, 0x27, 0xaa, 0xc3, 0x7c, 0xd9, 0x2e, 0x16,
+	0xc8, 0x58, 0x9d, 0x7a, 0xbe, 0xff, 0x58, 0xe9, 0xbf, 0x02, 0x38, 0x73, 0x17, 0xdb, 0xd4, 0xc2,
+	0xdc, 0xf5, 0x6e, 0x76, 0x56, 0x3b, 0xfa, 0x99, 0xc0, 0x78, 0xbf, 0xee, 0xdb, 0x98, 0xd3, 0x26,
+	0x09, 0xcb, 0xda, 0xc3, 0x9c, 0xba, 0x1a, 0x08, 0x32, 0xfd, 0x42, 0x62, 0xa6, 0xe7, 0x89, 0x19,
+	0x24, 0xfb, 0x07, 0x2a, 0xd9, 0x2f, 0x1f, 0x23, 0xd9, 0x15, 0x0f, 0x0b, 0xa1, 0x3e, 0x52, 0x2b,
+	0x8d, 0xd1, 0x85, 0x52, 0xf4, 0x75, 0x38, 0xe1, 0x91, 0x6d, 0xe2, 0x11, 0xc7, 0x24, 0x86, 0x19,
+	0x54, 0x9c, 0xf0, 0xcd, 0x98, 0x3e, 0x1e, 0x91, 0x73, 0x82, 0x9a, 0xfe, 0x2d, 0x80, 0xe7, 0xa2,
+	0x83, 0xe5, 0x7c, 0xcf, 0x23, 0x0e, 0x0f, 0x4f, 0xd5, 0x80, 0x43, 0x0a, 0x4b, 0xba, 0x7c, 0x88,
+	0x50, 0x0d, 0x3a, 0x0b, 0x07, 0x1b, 0xc4, 0xa3, 0xae, 0x9c, 0x15, 0xfa, 0x75, 0xf5, 0x95, 0xfe,
+	0x18, 0xc0, 0xb9, 0xc8, 0xca, 0xac, 0xa9, 0xce, 0x4c, 0x2c, 0x91, 0x22, 0x94, 0x31, 0x01, 0xe5,
+	0x4d, 0x08, 0xcd, 0xe8, 0xab, 0xcb, 0xf6, 0xb6, 0x68, 0x4a, 0xff, 0x1c, 0xc0, 0x77, 0x22, 0xd3,
+	0xf6, 0x23, 0xe8, 0xc9, 0x3b, 0x51, 0x58, 0x34, 0x15, 0x59, 0x54, 0xb6, 0x31, 0xab, 0x05, 0xb5,
+	0x8d, 0xbe, 0x01, 0x27, 0x9b, 0x21, 0xd9, 0x50, 0x6e, 0x06, 0x81, 0x9b, 0x27, 0x22, 0xfa, 0x66,
+	0x40, 0x46, 0xb7, 0xe0, 0xf0, 0xb6, 0x27, 0xfb, 0x97, 0xaa, 0xa9, 0x95, 0x37, 0xae, 0x29, 0x3d,
+	0x12, 0x91, 0xfe, 0x31, 0x80, 0xd3, 0x09, 0x16, 0x31, 0xf4, 0x21, 0x3c, 0x1b, 0x9b, 0xc4, 0xc4,
+	0x42, 0x88, 0x61, 0xd2, 0x57, 0x57, 0x0e, 0xed, 0x57, 0x09, 0x22, 0xd7, 0x46, 0x84, 0x9d, 0xd2,
+	0x21, 0xd3, 0xcd, 0x04, 0x95, 0xe9, 0xc7, 0x00, 0x0e, 0xdd, 0x20, 0x44, 0x60, 0x2b, 0xfa, 0x21,
+	0x1c, 0x6f, 0x87, 0xe9, 0x2e, 0x87, 0x68, 0xac, 0x0d, 0xda, 0xd3, 0xbf, 0xee, 0x85, 0x33, 0xb9,
+	0x7d, 0x43, 0x8a, 0x1c, 0x39, 0xb1, 0x2d, 0xa0, 0x8e, 0x53, 0x6e, 0x93, 0x10, 0xea, 0x82, 0x0f,
+	0x94, 0x82, 0xa3, 0x16, 0x61, 0xa6, 0x47, 0x1b, 0x71, 0x74, 0xf4, 0x56, 0x12, 0xba, 0x00, 0x47,
+	0x3c, 0x62, 0xd2, 0x06, 0x25, 0x0e, 0x97, 0x63, 0xb0, 0x1e, 0x13, 0xd0, 0x2e, 0x1c, 0x54, 0x2d,
+	0xb8, 0xff, 0xa8, 0x16, 0x7c, 0xe3, 0x4d, 0x5b, 0xf0, 0x01, 0xfd, 0x56, 0x29, 0x5c, 0x5d, 0x78,
+	0xfc, 0x64, 0xbe, 0xe7, 0xdf, 0x4f, 0xe6, 0x7b, 0xfe, 0xb8, 0xb7, 0x34, 0xa3, 0xb4, 0x56, 0xdd,
+	0x66, 0x8b, 0x52, 0x47, 0x0c, 0x66, 0x1a, 0x48, 0xff, 0x05, 0xc0, 0x33, 0x79, 0x22, 0x24, 0x89,
+	0xe8, 0x71, 0xec, 0x71, 0xea, 0x54, 0x8b, 0xce, 0x76, 0x00, 0x6c, 0x0d, 0x8f, 0x34, 0xa9, 0xeb,
+	0xb3, 0xf6, 0x1c, 0x1e, 0x0f, 0xc9, 0x2a, 0x85, 0xd7, 0xe1, 0x00, 0xe3, 0x78, 0xe7, 0x6d, 0x7b,
+	0x82, 0x14, 0x82, 0xf2, 0x70, 0xb0, 0x46, 0x68, 0xb5, 0x26, 0x1d, 0xda, 0xbf, 0xf6, 0xde, 0x17,
+	0x2f, 0xe6, 0x27, 0x4c, 0x8f, 0xe0, 0xa0, 0x3b, 0xca, 0xa5, 0x4f, 0x5e, 0x3f, 0x5b, 0xec, 0xa4,
+	0x29, 0x07, 0xc8, 0x8f, 0xf4, 0x13, 0x00, 0xcf, 0x45, 0xc7, 0xca, 0x9a, 0xa6, 0xe7, 0x13, 0xeb,
+	0xff, 0x86, 0x13, 0xc9, 0x8d, 0xee, 0xbf, 0xbd, 0x50, 0xdb, 0x9f, 0x93, 0x3a, 0x31, 0x5d, 0xcf,
+	0x42, 0xe3, 0xb0, 0x97, 0x86, 0xfe, 0xee, 0xa5, 0x96, 0x80, 0x6b, 0xe5, 0x15, 0xe1, 0xe4, 0xbe,
+	0xf0, 0x9c, 0xe8, 0x3a, 0x1c, 0xc1, 0x3e, 0xaf, 0xb9, 0x1e, 0xe5, 0xbb, 0xea, 0x22, 0xa6, 0x3d,
+	0xdf, 0x5b, 0x9a, 0x56, 0x07, 0x12, 0xb7, 0x23, 0xc2, 0x58, 0x99, 0x7b, 0x02, 0x27, 0xe3, 0xad,
+	0x82, 0x2f, 0xce, 0xdc, 0xfe, 0xa3, 0xf8, 0xe2, 0x9c, 0xae, 0x45, 0x39, 0x3d, 0x70, 0x54, 0x4e,
+	0x5f, 0x7b, 0xd3, 0x9c, 0x6e, 0x4b, 0x61, 0x34, 0x0f, 0x47, 0x1b, 0xaa, 0x3e, 0x0d, 0x6a, 0xc9,
+	0x3b, 0x93, 0x0e, 0x43, 0x52, 0xd1, 0x42, 0x97, 0xe0, 0x78, 0xb4, 0x41, 0x56, 0xef, 0x50, 0x50,
+	0x81, 0x63, 0x21, 0xb5, 0x22, 0x88, 0xc9, 0xbe, 0xff, 0x4f, 0x1f, 0x4c, 0x45, 0x98, 0xa6, 0x27,
+	0xcf, 0x51, 0xe8, 0x23, 0x00, 0x4f, 0x33, 0x62, 0x6f, 0x1b, 0x5b, 0xae, 0x63, 0x19, 0xed, 0x29,
+	0x73, 0x02, 0xb5, 0x3c, 0x21, 0x74, 0xaf, 0xb9, 0x4e, 0x94, 0xb7, 0x3f, 0x05, 0x70, 0x92, 0x3c,
+	0xe2, 0xc4, 0x73, 0xe2, 0x1b, 0x94, 0xd6, 0x7b, 0x62, 0xe6, 0x84, 0xaa, 0x43, 0x73, 0x7e, 0x09,
+	0xe0, 0x74, 0xdc, 0x9d, 0xa3, 0xd1, 0xd8, 0xd1, 0xfa, 0x4e, 0xca, 0xa4, 0xa9, 0x58, 0x7d, 0x38,
+	0x5b, 0x3b, 0xc9, 0xf1, 0xfe, 0x15, 0x80, 0x28, 0x8a, 0x77, 0xde, 0x67, 0x5c, 0x46, 0xf8, 0x01,
+	0xec, 0xb7, 0x7c, 0xc6, 0xbb, 0x0c, 0x03, 0x81, 0x8e, 0x64, 0xbb, 0xfe, 0xd4, 0x3a, 0x6d, 0xe5,
+	0xda, 0x4e, 0x53, 0x73, 0x6d, 0x31, 0xdc, 0xa0, 0x19, 0x38, 0x2c, 0x5c, 0x5b, 0x23, 0xb6, 0xc4,
+	0x83, 0x61, 0x3d, 0xfa, 0x6e, 0xe9, 0x30, 0xbd, 0x27, 0xdd, 0x61, 0x12, 0x8f, 0xf3, 0xb8, 0x17,
+	0x9e, 0xef, 0x28, 0xab, 0xac, 0xf5, 0xc0, 0x67, 0x5c, 0x5c, 0xd9, 0xd0, 0x06, 0x3c, 0x1d, 0x8f,
+	0x20, 0x58, 0x22, 0x8c, 0x7a, 0x0d, 0xbb, 0xf8, 0x7c, 0x6f, 0x69, 0x56, 0xd9, 0x1e, 0x4f, 0x9f,
+	0x6d, 0x20, 0x14, 0x4f, 0x54, 0x8a, 0x8e, 0x9c, 0x8e, 0xd3, 0x77, 0x2b, 0x7e, 0x21, 0x22, 0x9d,
+	0x85, 0x83, 0x16, 0xb1, 0x7c, 0x53, 0x76, 0xa6, 0x61, 0x5d, 0x7d, 0x25, 0xbb, 0xe2, 0x93, 0x3e,
+	0x78, 0xb6, 0xd3, 0x03, 0x27, 0x84, 0xed, 0xef, 0xc2, 0x31, 0xa1, 0x91, 0x6e, 0x53, 0x33, 0x68,
+	0x90, 0x12, 0xdf, 0xf5, 0x76, 0x22, 0xba, 0x08, 0x4f, 0x31, 0xd1, 0xee, 0x55, 0xfb, 0xd4, 0x06,
+	0x02, 0xdd, 0xa3, 0x01, 0xed, 0xa6, 0x34, 0x60, 0x16, 0xc2, 0xe0, 0x2d, 0x48, 0x6e, 0x18, 0x0c,
+	0x36, 0x8c, 0x10, 0xc7, 0x52, 0xcb, 0x26, 0x1c, 0xc5, 0xd1, 0xd9, 0x98, 0x36, 0x14, 0x04, 0xe1,
+	0xfa, 0xf1, 0xe6, 0xc8, 0x4e, 0xd7, 0xb4, 0x4e, 0x93, 0xad, 0x52, 0x3b, 0xdb, 0xc0, 0xf0, 0x31,
+	0xda, 0xc0, 0xc8, 0xb1, 0xdb, 0xc0, 0xbf, 0x00, 0x3c, 0xaf, 0xa6, 0x04, 0xea, 0x3a, 0xd1, 0xbc,
+	0xa0, 0x9e, 0x20, 0xbb, 0x90, 0xaf, 0xd1, 0xf3, 0x6c, 0x57, 0xf3, 0x55, 0x6a, 0x59, 0xed, 0x17,
+	0x43, 0x60, 0xfa, 0xcf, 0x00, 0x5e, 0x3a, 0x78, 0xf4, 0x15, 0x48, 0x93, 0x27, 0x0d, 0x97, 0x51,
+	0xde, 0xa5, 0x29, 0xf8, 0x6c, 0xcb, 0x14, 0x2c, 0x96, 0xc2, 0x6a, 0xd2, 0xe0, 0x90, 0x25, 0x15,
+	0x07, 0xa9, 0x37, 0xa2, 0x87, 0x9f, 0xab, 0xe9, 0xc7, 0x47, 0x0e, 0xae, 0xe9, 0x67, 0x00, 0x9e,
+	0x56, 0x8d, 0xe8, 0x86, 0xeb, 0x6d, 0x13, 0xca, 0x7d, 0x8f, 0xb4, 0x54, 0x12, 0x68, 0xab, 0xa4,
+	0x13, 0x46, 0x8a, 0x03, 0xe6, 0x3d, 0xd0, 0x02, 0x8e, 0xca, 0xe8, 0x78, 0x28, 0xe5, 0x70, 0x64,
+	0x3b, 0xa4, 0x75, 0xb9, 0x1f, 0xc5, 0x8a, 0xd0, 0x6d, 0x91, 0x92, 0x26, 0x89, 0x1c, 0x93, 0x39,
+	0xb4, 0x7a, 0xf7, 0x39, 0xbc, 0xb5, 0x6a, 0x95, 0xa0, 0xe4, 0xb3, 0xff, 0x0e, 0xc0, 0x51, 0xc9,
+	0x7d, 0xdb, 0x77, 0xf9, 0x41, 0x6f, 0x4b, 0xeb, 0x70, 0xa0, 0x89, 0x6d, 0xff, 0xad, 0x2f, 0x12,
+	0x81, 0x10, 0x74, 0x19, 0x9e, 0x6e, 0x78, 0xd4, 0x24, 0x86, 0xef, 0xe0, 0x26, 0xa6, 0x36, 0xde,
+	0xb2, 0x89, 0x42, 0xee, 0xc9, 0x60, 0xe1, 0x4e, 0x4c, 0x4f, 0xb6, 0xfa, 0x53, 0x00, 0x67, 0xdb,
+	0x4a, 0x47, 0x27, 0x75, 0x4c, 0x1d, 0x8b, 0x84, 0x37, 0x0a, 0x11, 0x35, 0x2f, 0xa4, 0x75, 0x3b,
+	0x6a, 0x91, 0xa2, 0x64, 0x63, 0xbf, 0x00, 0x70, 0xaa, 0xf3, 0xa5, 0x11, 0xdb, 0x4c, 0xcc, 0x0f,
+	0x8c, 0x7c, 0xe8, 0x13, 0xc7, 0x24, 0xaa, 0xe7, 0x44, 0xdf, 0xe2, 0x8a, 0x17, 0x23, 0x5c, 0xdb,
+	0xdb, 0x55, 0x33, 0x1e, 0x4a, 0xfc, 0xb6, 0xab, 0x6c, 0xdf, 0x57, 0x61, 0xd0, 0x58, 0xfc, 0x3d,
+	0x80, 0xb3, 0x87, 0xbe, 0xa1, 0xa3, 0x2b, 0xf0, 0xbd, 0xd2, 0x9d, 0x4a, 0xb9, 0x92, 0xdd, 0xc8,
+	0x17, 0x37, 0xbe, 0x65, 0xe8, 0x85, 0x7b, 0x59, 0x3d, 0x5f, 0x36, 0xf2, 0x85, 0x8d, 0xd2, 0x2d,
+	0x63, 0xb3, 0xb4, 0x5e, 0xcc, 0xdd, 0x37, 0xee, 0x6c, 0x94, 0x37, 0x0b, 0xb9, 0xe2, 0x8d, 0x62,
+	0x21, 0x3f, 0xd9, 0x83, 0x96, 0xe1, 0xe5, 0x23, 0x39, 0xf2, 0xc5, 0xbb, 0x05, 0xbd, 0x62, 0x6c,
+	0x14, 0xee, 0x4d, 0x02, 0xf4, 0x3e, 0x5c, 0x3e, 0x92, 0xa1, 0x70, 0xb7, 0x98, 0xab, 0x18, 0xe5,
+	0x5b, 0xd9, 0xf5, 0xf5, 0x42, 0xb9, 0x32, 0xd9, 0xbb, 0xf8, 0x07, 0x00, 0x53, 0x89, 0x83, 0x5e,
+	0x9e, 0x30, 0x4e, 0x1d, 0xd9, 0x9b, 0xaf, 0xc2, 0x4c, 0xae, 0x74, 0xeb, 0x56, 0xb1, 0x5c, 0x2e,
+	0x96, 0x36, 0x8c, 0x7b, 0xc5, 0xca, 0xcd, 0x9b, 0xa5, 0xf5, 0x40, 0x49, 0xbe, 0x50, 0xae, 0x14,
+	0x37, 0xb2, 0x15, 0x41, 0xdf, 0x67, 0xfe, 0x31, 0x78, 0x4a, 0x9b, 0x05, 0x3d, 0x5b, 0x29, 0xe9,
+	0x93, 0x00, 0x5d, 0x83, 0x2b, 0xc7, 0x60, 0x10, 0x5b, 0xee, 0x6c, 0x14, 0x2b, 0xf7, 0x8d, 0xcd,
+	0x52, 0x69, 0x7d, 0xb2, 0x77, 0xad, 0xf4, 0xf4, 0xe5, 0x1c, 0xf8, 0xec, 0xe5, 0x1c, 0xf8, 0xfc,
+	0xe5, 0x1c, 0xf8, 0xe7, 0xcb, 0x39, 0xf0, 0x8b, 0x57, 0x73, 0x3d, 0x9f, 0xbf, 0x9a, 0xeb, 0xf9,
+	0xdb, 0xab, 0xb9, 0x9e, 0xef, 0xae, 0x1c, 0x1a, 0xf5, 0x8e, 0x17, 0xe8, 0x20, 0x09, 0xb6, 0x06,
+	0x83, 0x9f, 0x4c, 0xdf, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x38, 0xe4, 0x81, 0xe5,
+	0x1d, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
 	if !ok {
-		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
+		that2, ok := that.(Params)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1031,797 +1883,4287 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	} else if this == nil {
 		return false
 	}
-	if this.Title != that1.Title {
+	if !this.CommunityTax.Equal(that1.CommunityTax) {
 		return false
 	}
-	if this.Description != that1.Description {
+	if !this.BaseProposerReward.Equal(that1.BaseProposerReward) {
 		return false
 	}
-	if this.Recipient != that1.Recipient {
+	if !this.BonusProposerReward.Equal(that1.BonusProposerReward) {
 		return false
 	}
-	if this.Amount != that1.Amount {
+	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
-	if this.Deposit != that1.Deposit {
+	if len(this.CommunityTaxOverrides) != len(that1.CommunityTaxOverrides) {
+		return false
+	}
+	for i := range this.CommunityTaxOverrides {
+		if !this.CommunityTaxOverrides[i].Equal(&that1.CommunityTaxOverrides[i]) {
+			return false
+		}
+	}
+	if this.RewardCheckpointInterval != that1.RewardCheckpointInterval {
+		return false
+	}
+	if this.RewardCheckpointsPerBlock != that1.RewardCheckpointsPerBlock {
+		return false
+	}
+	if this.CommunityPoolSpendHistoryRetention != that1.CommunityPoolSpendHistoryRetention {
+		return false
+	}
+	if this.HistoricalRewardsCompactionsPerBlock != that1.HistoricalRewardsCompactionsPerBlock {
+		return false
+	}
+	if this.MaxOutstandingRewardDenoms != that1.MaxOutstandingRewardDenoms {
+		return false
+	}
+	if this.OutstandingRewardsDenomPolicy != that1.OutstandingRewardsDenomPolicy {
+		return false
+	}
+	if len(this.MinWithdrawalAmount) != len(that1.MinWithdrawalAmount) {
+		return false
+	}
+	for i := range this.MinWithdrawalAmount {
+		if !this.MinWithdrawalAmount[i].Equal(&that1.MinWithdrawalAmount[i]) {
+			return false
+		}
+	}
+	if this.MinSettlementInterval != that1.MinSettlementInterval {
+		return false
+	}
+	if len(this.CommunityPoolAllowedDenoms) != len(that1.CommunityPoolAllowedDenoms) {
+		return false
+	}
+	for i := range this.CommunityPoolAllowedDenoms {
+		if this.CommunityPoolAllowedDenoms[i] != that1.CommunityPoolAllowedDenoms[i] {
+			return false
+		}
+	}
+	if this.AggregateImplicitWithdrawEvents != that1.AggregateImplicitWithdrawEvents {
+		return false
+	}
+	if this.RewardDistributionStatsEnabled != that1.RewardDistributionStatsEnabled {
 		return false
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CommunityTaxOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawAddrEnabled {
-		i--
-		if m.WithdrawAddrEnabled {
-			dAtA[i] = 1
+	that1, ok := that.(*CommunityTaxOverride)
+	if !ok {
+		that2, ok := that.(CommunityTaxOverride)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	{
-		size := m.BonusProposerReward.Size()
-		i -= size
-		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseProposerReward.Size()
-		i -= size
-		if _, err := m.BaseProposerReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	if this.Denom != that1.Denom {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.CommunityTax.Size()
-		i -= size
-		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	if !this.Rate.Equal(that1.Rate) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReferenceCount != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*ValidatorHistoricalRewards)
+	if !ok {
+		that2, ok := that.(ValidatorHistoricalRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.CumulativeRewardRatio) > 0 {
-		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
+		return false
+	}
+	for i := range this.CumulativeRewardRatio {
+		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ReferenceCount != that1.ReferenceCount {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Period != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*ValidatorCurrentRewards)
+	if !ok {
+		that2, ok := that.(ValidatorCurrentRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorAccumulatedCommission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Period != that1.Period {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorAccumulatedCommission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commission) > 0 {
-		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ValidatorAccumulatedCommission)
+	if !ok {
+		that2, ok := that.(ValidatorAccumulatedCommission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorOutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValidatorOutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(this.Commission) != len(that1.Commission) {
+		return false
+	}
+	for i := range this.Commission {
+		if !this.Commission[i].Equal(&that1.Commission[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ValidatorSlashEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorSlashEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ValidatorSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Fraction.Size()
-		i -= size
-		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*ValidatorOutstandingRewards)
+	if !ok {
+		that2, ok := that.(ValidatorOutstandingRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.ValidatorPeriod != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.ValidatorPeriod))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorSlashEvents) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorSlashEvents) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValidatorSlashEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorSlashEvents) > 0 {
-		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorSlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *FeePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ValidatorSlashEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FeePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CommunityPool) > 0 {
-		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ValidatorSlashEvent)
+	if !ok {
+		that2, ok := that.(ValidatorSlashEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ValidatorPeriod != that1.ValidatorPeriod {
+		return false
+	}
+	if !this.Fraction.Equal(that1.Fraction) {
+		return false
+	}
+	return true
 }
+func (this *ValidatorSlashEvents) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	that1, ok := that.(*ValidatorSlashEvents)
+	if !ok {
+		that2, ok := that.(ValidatorSlashEvents)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.ValidatorSlashEvents) != len(that1.ValidatorSlashEvents) {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	for i := range this.ValidatorSlashEvents {
+		if !this.ValidatorSlashEvents[i].Equal(&that1.ValidatorSlashEvents[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *FeePool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Stake.Size()
-		i -= size
-		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*FeePool)
+	if !ok {
+		that2, ok := that.(FeePool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.PreviousPeriod != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.CommunityPool) != len(that1.CommunityPool) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	for i := range this.CommunityPool {
+		if !this.CommunityPool[i].Equal(&that1.CommunityPool[i]) {
+			return false
 		}
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*DelegatorStartingInfo)
+	if !ok {
+		that2, ok := that.(DelegatorStartingInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	if this.PreviousPeriod != that1.PreviousPeriod {
+		return false
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if !this.Stake.Equal(that1.Stake) {
+		return false
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if this.Height != that1.Height {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *DelegatorAccruedRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*DelegatorAccruedRewards)
+	if !ok {
+		that2, ok := that.(DelegatorAccruedRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
+	}
+	return true
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CommunityPoolSpendRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.WithdrawAddrEnabled {
-		n += 2
+
+	that1, ok := that.(*CommunityPoolSpendRecord)
+	if !ok {
+		that2, ok := that.(CommunityPoolSpendRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if this.ProposalTitle != that1.ProposalTitle {
+		return false
+	}
+	return true
 }
+func (this *ValidatorRewardDistributionStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ValidatorRewardDistributionStats)
+	if !ok {
+		that2, ok := that.(ValidatorRewardDistributionStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.CumulativeRewardRatio) > 0 {
-		for _, e := range m.CumulativeRewardRatio {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.SelfBondRewards) != len(that1.SelfBondRewards) {
+		return false
+	}
+	for i := range this.SelfBondRewards {
+		if !this.SelfBondRewards[i].Equal(&that1.SelfBondRewards[i]) {
+			return false
 		}
 	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	if len(this.ExternalRewards) != len(that1.ExternalRewards) {
+		return false
 	}
-	return n
+	for i := range this.ExternalRewards {
+		if !this.ExternalRewards[i].Equal(&that1.ExternalRewards[i]) {
+			return false
+		}
+	}
+	if len(this.CommissionWithdrawn) != len(that1.CommissionWithdrawn) {
+		return false
+	}
+	for i := range this.CommissionWithdrawn {
+		if !this.CommissionWithdrawn[i].Equal(&that1.CommissionWithdrawn[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *ValidatorDustStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValidatorCurrentRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ValidatorDustStats)
+	if !ok {
+		that2, ok := that.(ValidatorDustStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Dust) != len(that1.Dust) {
+		return false
+	}
+	for i := range this.Dust {
+		if !this.Dust[i].Equal(&that1.Dust[i]) {
+			return false
 		}
 	}
-	if m.Period != 0 {
-		n += 1 + sovDistribution(uint64(m.Period))
+	return true
+}
+func (this *ValidatorCommissionWithholding) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
+
+	that1, ok := that.(*ValidatorCommissionWithholding)
+	if !ok {
+		that2, ok := that.(ValidatorCommissionWithholding)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Withheld != that1.Withheld {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *ValidatorRewardAdjustment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValidatorAccumulatedCommission) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ValidatorRewardAdjustment)
+	if !ok {
+		that2, ok := that.(ValidatorRewardAdjustment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Commission) > 0 {
-		for _, e := range m.Commission {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
 		}
 	}
-	return n
+	if this.Deduct != that1.Deduct {
+		return false
+	}
+	return true
 }
+func (this *RewardAdjustmentRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValidatorOutstandingRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*RewardAdjustmentRecord)
+	if !ok {
+		that2, ok := that.(RewardAdjustmentRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Justification != that1.Justification {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	if len(this.Adjustments) != len(that1.Adjustments) {
+		return false
+	}
+	for i := range this.Adjustments {
+		if !this.Adjustments[i].Equal(&that1.Adjustments[i]) {
+			return false
+		}
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if this.ProposalTitle != that1.ProposalTitle {
+		return false
+	}
+	return true
+}
+func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationDelegatorReward)
+	if !ok {
+		that2, ok := that.(DelegationDelegatorReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Reward) != len(that1.Reward) {
+		return false
+	}
+	for i := range this.Reward {
+		if !this.Reward[i].Equal(&that1.Reward[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+	if !ok {
+		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
+func (this *RewardsForfeiture) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardsForfeiture)
+	if !ok {
+		that2, ok := that.(RewardsForfeiture)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorForfeitedRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorForfeitedRewards)
+	if !ok {
+		that2, ok := that.(ValidatorForfeitedRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Forfeited) != len(that1.Forfeited) {
+		return false
+	}
+	for i := range this.Forfeited {
+		if !this.Forfeited[i].Equal(&that1.Forfeited[i]) {
+			return false
+		}
+	}
+	if len(this.Recent) != len(that1.Recent) {
+		return false
+	}
+	for i := range this.Recent {
+		if !this.Recent[i].Equal(&that1.Recent[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RewardQuote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardQuote)
+	if !ok {
+		that2, ok := that.(RewardQuote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Value.Equal(that1.Value) {
+		return false
+	}
+	if this.PriceUnavailable != that1.PriceUnavailable {
+		return false
+	}
+	return true
+}
+func (this *CommunityPoolRemainderAccrued) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolRemainderAccrued)
+	if !ok {
+		that2, ok := that.(CommunityPoolRemainderAccrued)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Remainder) != len(that1.Remainder) {
+		return false
+	}
+	for i := range this.Remainder {
+		if !this.Remainder[i].Equal(&that1.Remainder[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ImplicitWithdrawals) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImplicitWithdrawals)
+	if !ok {
+		that2, ok := that.(ImplicitWithdrawals)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.ValidatorCount != that1.ValidatorCount {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardDistributionStatsEnabled {
+		i--
+		if m.RewardDistributionStatsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.AggregateImplicitWithdrawEvents {
+		i--
+		if m.AggregateImplicitWithdrawEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.CommunityPoolAllowedDenoms) > 0 {
+		for iNdEx := len(m.CommunityPoolAllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommunityPoolAllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.CommunityPoolAllowedDenoms[iNdEx])
+			i = encodeVarintDistribution(dAtA, i, uint64(len(m.CommunityPoolAllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MinSettlementInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MinSettlementInterval))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MinWithdrawalAmount) > 0 {
+		for iNdEx := len(m.MinWithdrawalAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinWithdrawalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.OutstandingRewardsDenomPolicy != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.OutstandingRewardsDenomPolicy))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxOutstandingRewardDenoms != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxOutstandingRewardDenoms))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HistoricalRewardsCompactionsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.HistoricalRewardsCompactionsPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CommunityPoolSpendHistoryRetention != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.CommunityPoolSpendHistoryRetention))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RewardCheckpointsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RewardCheckpointsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RewardCheckpointInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RewardCheckpointInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CommunityTaxOverrides) > 0 {
+		for iNdEx := len(m.CommunityTaxOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityTaxOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.WithdrawAddrEnabled {
+		i--
+		if m.WithdrawAddrEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BonusProposerReward.Size()
+		i -= size
+		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseProposerReward.Size()
+		i -= size
+		if _, err := m.BaseProposerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityTaxOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityTaxOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityTaxOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferenceCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CumulativeRewardRatio) > 0 {
+		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAccumulatedCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccumulatedCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ValidatorPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ValidatorPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorSlashEvents) > 0 {
+		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSlashEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PreviousPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorAccruedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorAccruedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorAccruedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalTitle) > 0 {
+		i -= len(m.ProposalTitle)
+		copy(dAtA[i:], m.ProposalTitle)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ProposalTitle)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardDistributionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardDistributionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardDistributionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommissionWithdrawn) > 0 {
+		for iNdEx := len(m.CommissionWithdrawn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionWithdrawn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExternalRewards) > 0 {
+		for iNdEx := len(m.ExternalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SelfBondRewards) > 0 {
+		for iNdEx := len(m.SelfBondRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelfBondRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDustStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDustStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDustStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCommissionWithholding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionWithholding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionWithholding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Withheld {
+		i--
+		if m.Withheld {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deduct {
+		i--
+		if m.Deduct {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardAdjustmentRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardAdjustmentRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardAdjustmentRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalTitle) > 0 {
+		i -= len(m.ProposalTitle)
+		copy(dAtA[i:], m.ProposalTitle)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ProposalTitle)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Adjustments) > 0 {
+		for iNdEx := len(m.Adjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Adjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsForfeiture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsForfeiture) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsForfeiture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorForfeitedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorForfeitedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorForfeitedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recent) > 0 {
+		for iNdEx := len(m.Recent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Forfeited) > 0 {
+		for iNdEx := len(m.Forfeited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forfeited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PriceUnavailable {
+		i--
+		if m.PriceUnavailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolRemainderAccrued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolRemainderAccrued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolRemainderAccrued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImplicitWithdrawals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImplicitWithdrawals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImplicitWithdrawals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ValidatorCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	if len(m.CommunityTaxOverrides) > 0 {
+		for _, e := range m.CommunityTaxOverrides {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.RewardCheckpointInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.RewardCheckpointInterval))
+	}
+	if m.RewardCheckpointsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.RewardCheckpointsPerBlock))
+	}
+	if m.CommunityPoolSpendHistoryRetention != 0 {
+		n += 1 + sovDistribution(uint64(m.CommunityPoolSpendHistoryRetention))
+	}
+	if m.HistoricalRewardsCompactionsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.HistoricalRewardsCompactionsPerBlock))
+	}
+	if m.MaxOutstandingRewardDenoms != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxOutstandingRewardDenoms))
+	}
+	if m.OutstandingRewardsDenomPolicy != 0 {
+		n += 1 + sovDistribution(uint64(m.OutstandingRewardsDenomPolicy))
+	}
+	if len(m.MinWithdrawalAmount) > 0 {
+		for _, e := range m.MinWithdrawalAmount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.MinSettlementInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.MinSettlementInterval))
+	}
+	if len(m.CommunityPoolAllowedDenoms) > 0 {
+		for _, s := range m.CommunityPoolAllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.AggregateImplicitWithdrawEvents {
+		n += 2
+	}
+	if m.RewardDistributionStatsEnabled {
+		n += 3
+	}
+	return n
+}
+
+func (m *CommunityTaxOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *ValidatorCurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovDistribution(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *ValidatorAccumulatedCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorOutstandingRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorSlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.ValidatorPeriod))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorSlashEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorSlashEvents) > 0 {
+		for _, e := range m.ValidatorSlashEvents {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorStartingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DelegatorAccruedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovDistribution(uint64(m.ProposalId))
+	}
+	l = len(m.ProposalTitle)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorRewardDistributionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SelfBondRewards) > 0 {
+		for _, e := range m.SelfBondRewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.ExternalRewards) > 0 {
+		for _, e := range m.ExternalRewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.CommissionWithdrawn) > 0 {
+		for _, e := range m.CommissionWithdrawn {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorDustStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorCommissionWithholding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Withheld {
+		n += 2
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRewardAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Deduct {
+		n += 2
+	}
+	return n
+}
+
+func (m *RewardAdjustmentRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.EndHeight))
+	}
+	if len(m.Adjustments) > 0 {
+		for _, e := range m.Adjustments {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovDistribution(uint64(m.ProposalId))
+	}
+	l = len(m.ProposalTitle)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardsForfeiture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorForfeitedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Forfeited) > 0 {
+		for _, e := range m.Forfeited {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Recent) > 0 {
+		for _, e := range m.Recent {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.PriceUnavailable {
+		n += 2
+	}
+	return n
+}
+
+func (m *CommunityPoolRemainderAccrued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImplicitWithdrawals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovDistribution(uint64(m.Sequence))
+	}
+	if m.ValidatorCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ValidatorCount))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTaxOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityTaxOverrides = append(m.CommunityTaxOverrides, CommunityTaxOverride{})
+			if err := m.CommunityTaxOverrides[len(m.CommunityTaxOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCheckpointInterval", wireType)
+			}
+			m.RewardCheckpointInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardCheckpointInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCheckpointsPerBlock", wireType)
+			}
+			m.RewardCheckpointsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardCheckpointsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpendHistoryRetention", wireType)
+			}
+			m.CommunityPoolSpendHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPoolSpendHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewardsCompactionsPerBlock", wireType)
+			}
+			m.HistoricalRewardsCompactionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalRewardsCompactionsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutstandingRewardDenoms", wireType)
+			}
+			m.MaxOutstandingRewardDenoms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutstandingRewardDenoms |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewardsDenomPolicy", wireType)
+			}
+			m.OutstandingRewardsDenomPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutstandingRewardsDenomPolicy |= OutstandingRewardsDenomPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWithdrawalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinWithdrawalAmount = append(m.MinWithdrawalAmount, types.Coin{})
+			if err := m.MinWithdrawalAmount[len(m.MinWithdrawalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlementInterval", wireType)
+			}
+			m.MinSettlementInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSettlementInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolAllowedDenoms = append(m.CommunityPoolAllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateImplicitWithdrawEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AggregateImplicitWithdrawEvents = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionStatsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardDistributionStatsEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityTaxOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityTaxOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityTaxOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			}
+			m.ValidatorPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			}
+			m.PreviousPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ValidatorSlashEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ValidatorPeriod != 0 {
-		n += 1 + sovDistribution(uint64(m.ValidatorPeriod))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Fraction.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	return n
+	return nil
 }
-
-func (m *ValidatorSlashEvents) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ValidatorSlashEvents) > 0 {
-		for _, e := range m.ValidatorSlashEvents {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+func (m *DelegatorAccruedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *FeePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CommunityPool) > 0 {
-		for _, e := range m.CommunityPool {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorAccruedRewards: wiretype end group for non-group")
 		}
-	}
-	return n
-}
-
-func (m *CommunityPoolSpendProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorAccruedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	}
-	return n
-}
-
-func (m *DelegatorStartingInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PreviousPeriod != 0 {
-		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
-	}
-	l = m.Stake.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovDistribution(uint64(m.Height))
-	}
-	return n
-}
-
-func (m *DelegationDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	if len(m.Reward) > 0 {
-		for _, e := range m.Reward {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolSpendRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1840,19 +6182,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1880,13 +6260,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1914,15 +6292,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -1932,31 +6308,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var v int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -1966,12 +6342,43 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithdrawAddrEnabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTitle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTitle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -1993,7 +6400,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewardDistributionStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2016,15 +6423,15 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewardDistributionStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewardDistributionStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2051,16 +6458,16 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
-			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SelfBondRewards = append(m.SelfBondRewards, types.Coin{})
+			if err := m.SelfBondRewards[len(m.SelfBondRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalRewards", wireType)
 			}
-			m.ReferenceCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2070,11 +6477,60 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalRewards = append(m.ExternalRewards, types.Coin{})
+			if err := m.ExternalRewards[len(m.ExternalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionWithdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionWithdrawn = append(m.CommissionWithdrawn, types.Coin{})
+			if err := m.CommissionWithdrawn[len(m.CommissionWithdrawn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2096,7 +6552,7 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+func (m *ValidatorDustStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2119,15 +6575,15 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorDustStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorDustStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2154,30 +6610,11 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dust = append(m.Dust, types.DecCoin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
-			}
-			m.Period = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2199,7 +6636,7 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+func (m *ValidatorCommissionWithholding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,15 +6659,35 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorCommissionWithholding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorCommissionWithholding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withheld", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withheld = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2257,8 +6714,8 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2283,7 +6740,7 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewardAdjustment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,15 +6763,47 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewardAdjustment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewardAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2341,11 +6830,31 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deduct", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deduct = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2367,7 +6876,7 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+func (m *RewardAdjustmentRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2390,17 +6899,17 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardAdjustmentRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardAdjustmentRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.ValidatorPeriod = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2410,14 +6919,33 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2445,63 +6973,81 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Adjustments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2528,11 +7074,62 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Adjustments = append(m.Adjustments, ValidatorRewardAdjustment{})
+			if err := m.Adjustments[len(m.Adjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTitle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTitle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2554,7 +7151,7 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeePool) Unmarshal(dAtA []byte) error {
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2577,15 +7174,47 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2612,8 +7241,8 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2638,7 +7267,7 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2661,10 +7290,10 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2763,11 +7392,43 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2777,25 +7438,23 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2818,7 +7477,7 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+func (m *RewardsForfeiture) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2841,17 +7500,17 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsForfeiture: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsForfeiture: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.PreviousPeriod = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2861,16 +7520,16 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2880,45 +7539,26 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2940,7 +7580,7 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *ValidatorForfeitedRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2963,17 +7603,17 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorForfeitedRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorForfeitedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forfeited", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2983,27 +7623,29 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Forfeited = append(m.Forfeited, types.DecCoin{})
+			if err := m.Forfeited[len(m.Forfeited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3030,8 +7672,8 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recent = append(m.Recent, RewardsForfeiture{})
+			if err := m.Recent[len(m.Recent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3056,7 +7698,7 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *RewardQuote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3079,15 +7721,15 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardQuote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardQuote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3115,11 +7757,11 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3147,13 +7789,15 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceUnavailable", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3163,29 +7807,67 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
+			m.PriceUnavailable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolRemainderAccrued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolRemainderAccrued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolRemainderAccrued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3195,29 +7877,119 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Remainder = append(m.Remainder, types.DecCoin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImplicitWithdrawals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImplicitWithdrawals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImplicitWithdrawals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
+			}
+			m.ValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3227,23 +7999,25 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
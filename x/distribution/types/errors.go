@@ -4,16 +4,25 @@ import "cosmossdk.io/errors"
 
 // x/distribution module sentinel errors
 var (
-	ErrEmptyDelegatorAddr      = errors.Register(ModuleName, 2, "delegator address is empty")
-	ErrEmptyWithdrawAddr       = errors.Register(ModuleName, 3, "withdraw address is empty")
-	ErrEmptyValidatorAddr      = errors.Register(ModuleName, 4, "validator address is empty")
-	ErrEmptyDelegationDistInfo = errors.Register(ModuleName, 5, "no delegation distribution info")
-	ErrNoValidatorDistInfo     = errors.Register(ModuleName, 6, "no validator distribution info")
-	ErrNoValidatorCommission   = errors.Register(ModuleName, 7, "no validator commission to withdraw")
-	ErrSetWithdrawAddrDisabled = errors.Register(ModuleName, 8, "set withdraw address disabled")
-	ErrBadDistribution         = errors.Register(ModuleName, 9, "community pool does not have sufficient coins to distribute")
-	ErrInvalidProposalAmount   = errors.Register(ModuleName, 10, "invalid community pool spend proposal amount")
-	ErrEmptyProposalRecipient  = errors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
-	ErrNoValidatorExists       = errors.Register(ModuleName, 12, "validator does not exist")
-	ErrNoDelegationExists      = errors.Register(ModuleName, 13, "delegation does not exist")
+	ErrEmptyDelegatorAddr       = errors.Register(ModuleName, 2, "delegator address is empty")
+	ErrEmptyWithdrawAddr        = errors.Register(ModuleName, 3, "withdraw address is empty")
+	ErrEmptyValidatorAddr       = errors.Register(ModuleName, 4, "validator address is empty")
+	ErrEmptyDelegationDistInfo  = errors.Register(ModuleName, 5, "no delegation distribution info")
+	ErrNoValidatorDistInfo      = errors.Register(ModuleName, 6, "no validator distribution info")
+	ErrNoValidatorCommission    = errors.Register(ModuleName, 7, "no validator commission to withdraw")
+	ErrSetWithdrawAddrDisabled  = errors.Register(ModuleName, 8, "set withdraw address disabled")
+	ErrBadDistribution          = errors.Register(ModuleName, 9, "community pool does not have sufficient coins to distribute")
+	ErrInvalidProposalAmount    = errors.Register(ModuleName, 10, "invalid community pool spend proposal amount")
+	ErrEmptyProposalRecipient   = errors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
+	ErrNoValidatorExists        = errors.Register(ModuleName, 12, "validator does not exist")
+	ErrNoDelegationExists       = errors.Register(ModuleName, 13, "delegation does not exist")
+	ErrNoHistoricalRewards      = errors.Register(ModuleName, 14, "no validator historical rewards")
+	ErrNoDelegatorStartingInfo  = errors.Register(ModuleName, 15, "no delegator starting info")
+	ErrInvalidRecord            = errors.Register(ModuleName, 16, "invalid distribution record")
+	ErrNoWithheldCommission     = errors.Register(ModuleName, 17, "no withheld validator commission to resolve")
+	ErrBelowMinWithdrawal       = errors.Register(ModuleName, 18, "rewards below the minimum withdrawal amount")
+	ErrNotWithdrawOperator      = errors.Register(ModuleName, 19, "not the withdraw operator of the delegator")
+	ErrNoWithdrawOperator       = errors.Register(ModuleName, 20, "no withdraw operator to revoke")
+	ErrRewardAdjustmentOverdraw = errors.Register(ModuleName, 21, "reward adjustment overdraws the validator rewards")
+	ErrDenomNotAllowed          = errors.Register(ModuleName, 22, "denom not allowed in the community pool")
 )
@@ -8,8 +8,43 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeCheckpointRewards  = "checkpoint_rewards"
 
-	AttributeKeyWithdrawAddress = "withdraw_address"
-	AttributeKeyValidator       = "validator"
-	AttributeKeyDelegator       = "delegator"
+	EventTypeSetCommissionWithholding     = "set_commission_withholding"
+	EventTypeWithholdCommission           = "withhold_commission"
+	EventTypeResolveCommissionWithholding = "resolve_commission_withholding"
+	EventTypeRewardsForfeited             = "rewards_forfeited"
+	EventTypeRewardsDiverted              = "rewards_diverted"
+	EventTypeCommunityPoolRemainder       = "community_pool_remainder"
+	EventTypeRegisterWithdrawOperator     = "register_withdraw_operator"
+	EventTypeWithdrawOnBehalf             = "withdraw_on_behalf"
+	EventTypeRewardAdjustment             = "reward_adjustment"
+	EventTypeSweepCommunityPool           = "sweep_community_pool"
+	EventTypeRepairStartingInfo           = "repair_starting_info"
+
+	AttributeKeyWithdrawAddress       = "withdraw_address"
+	AttributeKeyWithdrawAddressSource = "withdraw_address_source"
+	AttributeKeyValidator             = "validator"
+	AttributeKeyDelegator             = "delegator"
+	AttributeKeyWithheld              = "withheld"
+	AttributeKeyDestination           = "destination"
+	AttributeKeyCondition             = "condition"
+	AttributeKeyOperator              = "operator"
+	AttributeKeyAdjustmentID          = "adjustment_id"
+	AttributeKeyDeduct                = "deduct"
+	AttributeKeyPeriod                = "period"
+	AttributeKeyValidatorCount        = "validator_count"
+	AttributeKeyRemainder             = "remainder"
+
+	AttributeValueWithdrawAddressProvider  = "provider"
+	AttributeValueWithdrawAddressStored    = "stored"
+	AttributeValueWithdrawAddressDelegator = "delegator"
+
+	AttributeValueConditionSlashedToZero = "slashed_to_zero"
+	AttributeValueConditionNoTokens      = "no_tokens"
+
+	AttributeValueConditionNewDenom     = "new_denom"
+	AttributeValueConditionEvictedDenom = "evicted_denom"
+
+	AttributeValueDestinationBurn = "burn"
 )
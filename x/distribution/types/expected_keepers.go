@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
@@ -29,6 +30,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
 }
@@ -63,3 +65,35 @@ type StakingHooks interface {
 }
 
 type ExternalCommunityPoolKeeper protocolpooltypes.ExternalCommunityPoolKeeper
+
+// WithdrawAddressProvider answers the withdraw address of delegators whose
+// accounts are managed by a module, such as vesting or module-managed accounts,
+// which cannot sign a MsgSetWithdrawAddress themselves.
+//
+// A provider must only answer for the accounts owned by the module registering
+// it, as the address it returns takes precedence over the withdraw address set
+// by the delegator.
+type WithdrawAddressProvider interface {
+	// GetWithdrawAddress returns the withdraw address of the delegator, and false
+	// when the delegator account is not managed by the provider.
+	GetWithdrawAddress(ctx context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, bool, error)
+}
+
+// PriceSource prices denoms in other denoms, e.g. from the prices of an oracle
+// module. It is only consulted by queries, never in consensus.
+type PriceSource interface {
+	// Price returns the value of one unit of baseDenom in quoteDenom, or an
+	// error if no price is available.
+	Price(ctx context.Context, baseDenom, quoteDenom string) (math.LegacyDec, error)
+}
+
+// DistributionHooks event hooks for the withdrawals of rewards and commission
+// (noalias). A hook returning an error aborts the withdrawal.
+type DistributionHooks interface {
+	// AfterDelegationRewardWithdrawn is called once the rewards of a delegation
+	// are sent to the withdraw address of the delegator.
+	AfterDelegationRewardWithdrawn(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) error
+	// AfterValidatorCommissionWithdrawn is called once the commission of a
+	// validator is sent to the withdraw address of its operator.
+	AfterValidatorCommissionWithdrawn(ctx context.Context, valAddr sdk.ValAddress, commission sdk.Coins) error
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,34 +10,56 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	stats []ValidatorRewardDistributionStatsRecord, dust []ValidatorDustStatsRecord,
+	accrued []DelegatorAccruedRewardsRecord, spends []CommunityPoolSpendRecord,
+	withholdings []ValidatorCommissionWithholdingRecord, forfeited []ValidatorForfeitedRewardsRecord,
+	remainder sdk.DecCoins, operators []WithdrawOperatorRecord, adjustments []RewardAdjustmentRecord,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                          params,
-		FeePool:                         fp,
-		DelegatorWithdrawInfos:          dwis,
-		PreviousProposer:                pp.String(),
-		OutstandingRewards:              r,
-		ValidatorAccumulatedCommissions: acc,
-		ValidatorHistoricalRewards:      historical,
-		ValidatorCurrentRewards:         cur,
-		DelegatorStartingInfos:          dels,
-		ValidatorSlashEvents:            slashes,
+		Params:                           params,
+		FeePool:                          fp,
+		DelegatorWithdrawInfos:           dwis,
+		PreviousProposer:                 pp.String(),
+		OutstandingRewards:               r,
+		ValidatorAccumulatedCommissions:  acc,
+		ValidatorHistoricalRewards:       historical,
+		ValidatorCurrentRewards:          cur,
+		DelegatorStartingInfos:           dels,
+		ValidatorSlashEvents:             slashes,
+		ValidatorRewardDistributionStats: stats,
+		ValidatorDustStats:               dust,
+		DelegatorAccruedRewards:          accrued,
+		CommunityPoolSpendHistory:        spends,
+		ValidatorCommissionWithholdings:  withholdings,
+		ValidatorForfeitedRewards:        forfeited,
+		CommunityPoolRemainderAccrued:    remainder,
+		WithdrawOperators:                operators,
+		RewardAdjustments:                adjustments,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state of distribution.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		FeePool:                         InitialFeePool(),
-		Params:                          DefaultParams(),
-		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
-		PreviousProposer:                "",
-		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
-		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
-		ValidatorHistoricalRewards:      []ValidatorHistoricalRewardsRecord{},
-		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
-		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
-		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FeePool:                          InitialFeePool(),
+		Params:                           DefaultParams(),
+		DelegatorWithdrawInfos:           []DelegatorWithdrawInfo{},
+		PreviousProposer:                 "",
+		OutstandingRewards:               []ValidatorOutstandingRewardsRecord{},
+		ValidatorAccumulatedCommissions:  []ValidatorAccumulatedCommissionRecord{},
+		ValidatorHistoricalRewards:       []ValidatorHistoricalRewardsRecord{},
+		ValidatorCurrentRewards:          []ValidatorCurrentRewardsRecord{},
+		DelegatorStartingInfos:           []DelegatorStartingInfoRecord{},
+		ValidatorSlashEvents:             []ValidatorSlashEventRecord{},
+		ValidatorRewardDistributionStats: []ValidatorRewardDistributionStatsRecord{},
+		ValidatorDustStats:               []ValidatorDustStatsRecord{},
+		DelegatorAccruedRewards:          []DelegatorAccruedRewardsRecord{},
+		CommunityPoolSpendHistory:        []CommunityPoolSpendRecord{},
+		ValidatorCommissionWithholdings:  []ValidatorCommissionWithholdingRecord{},
+		ValidatorForfeitedRewards:        []ValidatorForfeitedRewardsRecord{},
+		CommunityPoolRemainderAccrued:    sdk.DecCoins{},
+		WithdrawOperators:                []WithdrawOperatorRecord{},
+		RewardAdjustments:                []RewardAdjustmentRecord{},
 	}
 }
 
@@ -44,5 +68,98 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	for _, record := range gs.ValidatorRewardDistributionStats {
+		if err := record.Stats.Validate(); err != nil {
+			return fmt.Errorf("invalid reward distribution stats for validator %s: %w", record.ValidatorAddress, err)
+		}
+	}
+
+	for _, record := range gs.ValidatorDustStats {
+		if err := record.DustStats.Dust.Validate(); err != nil {
+			return fmt.Errorf("invalid dust stats for validator %s: %w", record.ValidatorAddress, err)
+		}
+	}
+
+	for _, record := range gs.DelegatorAccruedRewards {
+		if err := record.AccruedRewards.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid accrued rewards for delegator %s of validator %s: %w", record.DelegatorAddress, record.ValidatorAddress, err)
+		}
+	}
+
+	spendIDs := make(map[uint64]struct{}, len(gs.CommunityPoolSpendHistory))
+	for _, spend := range gs.CommunityPoolSpendHistory {
+		if _, ok := spendIDs[spend.Id]; ok {
+			return fmt.Errorf("duplicate community pool spend id %d", spend.Id)
+		}
+		spendIDs[spend.Id] = struct{}{}
+
+		if spend.Height <= 0 {
+			return fmt.Errorf("invalid height %d of community pool spend %d", spend.Height, spend.Id)
+		}
+
+		if err := spend.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount of community pool spend %d: %w", spend.Id, err)
+		}
+	}
+
+	withholdings := make(map[string]struct{}, len(gs.ValidatorCommissionWithholdings))
+	for _, record := range gs.ValidatorCommissionWithholdings {
+		if _, ok := withholdings[record.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate commission withholding for validator %s", record.ValidatorAddress)
+		}
+		withholdings[record.ValidatorAddress] = struct{}{}
+
+		if err := record.Withholding.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid withheld commission of validator %s: %w", record.ValidatorAddress, err)
+		}
+	}
+
+	forfeited := make(map[string]struct{}, len(gs.ValidatorForfeitedRewards))
+	for _, record := range gs.ValidatorForfeitedRewards {
+		if _, ok := forfeited[record.ValidatorAddress]; ok {
+			return fmt.Errorf("duplicate forfeited rewards for validator %s", record.ValidatorAddress)
+		}
+		forfeited[record.ValidatorAddress] = struct{}{}
+
+		if err := record.ForfeitedRewards.Validate(); err != nil {
+			return fmt.Errorf("invalid forfeited rewards of validator %s: %w", record.ValidatorAddress, err)
+		}
+	}
+
+	if err := gs.CommunityPoolRemainderAccrued.Validate(); err != nil {
+		return fmt.Errorf("invalid community pool remainder accrued: %w", err)
+	}
+
+	operators := make(map[string]struct{}, len(gs.WithdrawOperators))
+	for _, record := range gs.WithdrawOperators {
+		if _, ok := operators[record.DelegatorAddress]; ok {
+			return fmt.Errorf("duplicate withdraw operator for delegator %s", record.DelegatorAddress)
+		}
+		operators[record.DelegatorAddress] = struct{}{}
+
+		if record.OperatorAddress == "" || record.OperatorAddress == record.DelegatorAddress {
+			return fmt.Errorf("invalid withdraw operator %q of delegator %s", record.OperatorAddress, record.DelegatorAddress)
+		}
+	}
+
+	adjustmentIDs := make(map[uint64]struct{}, len(gs.RewardAdjustments))
+	for _, record := range gs.RewardAdjustments {
+		if _, ok := adjustmentIDs[record.Id]; ok {
+			return fmt.Errorf("duplicate reward adjustment id %d", record.Id)
+		}
+		adjustmentIDs[record.Id] = struct{}{}
+
+		if record.Height <= 0 {
+			return fmt.Errorf("invalid height %d of reward adjustment %d", record.Height, record.Id)
+		}
+
+		for _, adjustment := range record.Adjustments {
+			if err := adjustment.Amount.Validate(); err != nil {
+				return fmt.Errorf("invalid amount of reward adjustment %d of validator %s: %w", record.Id, adjustment.ValidatorAddress, err)
+			}
+		}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple distribution hooks, all hook functions are run in array sequence
+var _ DistributionHooks = &MultiDistributionHooks{}
+
+type MultiDistributionHooks []DistributionHooks
+
+func NewMultiDistributionHooks(hooks ...DistributionHooks) MultiDistributionHooks {
+	return hooks
+}
+
+func (h MultiDistributionHooks) AfterDelegationRewardWithdrawn(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterDelegationRewardWithdrawn(ctx, delAddr, valAddr, rewards); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiDistributionHooks) AfterValidatorCommissionWithdrawn(ctx context.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterValidatorCommissionWithdrawn(ctx, valAddr, commission); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -19,6 +19,9 @@ const (
 
 	// RouterKey is the message route for distribution
 	RouterKey = ModuleName
+
+	// TStoreKey is the transient store key for distribution
+	TStoreKey = "transient_" + ModuleName
 )
 
 // Keys for distribution store
@@ -43,6 +46,38 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorRewardDistributionStats
+//
+// - 0x0b<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorDustStats
+//
+// - 0x0c<valAddrLen (1 Byte)><valAddr_Bytes><accAddrLen (1 Byte)><accAddr_Bytes>: DelegatorAccruedRewards
+//
+// - 0x0d: DelegatorStartingInfo key of the last delegation inspected for reward checkpointing
+//
+// - 0x0e<height (8 Bytes)><id (8 Bytes)>: CommunityPoolSpendRecord
+//
+// - 0x0f: CommunityPoolSpendSequence
+//
+// - 0x10<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCommissionWithholding
+//
+// - 0x11<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorForfeitedRewards
+//
+// - 0x12: ValidatorHistoricalRewards key of the last record inspected for historical rewards compaction
+//
+// - 0x13: CommunityPoolRemainderAccrued
+//
+// - 0x14<accAddrLen (1 Byte)><accAddr_Bytes>: sdk.AccAddress of the withdraw operator
+//
+// - 0x15<id (8 Bytes)>: RewardAdjustmentRecord
+//
+// - 0x16: RewardAdjustmentSequence
+//
+// Items are stored in the transient store with the following key: values
+//
+// - 0x00<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorDustStats (pending for the current block)
+//
+// - 0x01: CommunityPoolRemainderAccrued (pending for the current block)
 var (
 	FeePoolKey                        = collections.NewPrefix(0) // key for global distribution state
 	ProposerKey                       = []byte{0x01}             // key for the proposer operator address
@@ -55,7 +90,26 @@ var (
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
-	ParamsKey = collections.NewPrefix(9) // key for distribution module params
+	ParamsKey                              = collections.NewPrefix(9)  // key for distribution module params
+	ValidatorRewardDistributionStatsPrefix = collections.NewPrefix(10) // key for cumulative validator reward distribution stats
+	ValidatorDustStatsPrefix               = collections.NewPrefix(11) // key for cumulative validator dust stats
+	DelegatorAccruedRewardsPrefix          = collections.NewPrefix(12) // key for delegator rewards settled by reward checkpoints
+	RewardCheckpointCursorKey              = collections.NewPrefix(13) // key for the reward checkpointing cursor
+	CommunityPoolSpendHistoryPrefix        = collections.NewPrefix(14) // key for the community pool spend history ordered by height
+	CommunityPoolSpendSequenceKey          = collections.NewPrefix(15) // key for the community pool spend sequence
+	ValidatorCommissionWithholdingPrefix   = collections.NewPrefix(16) // key for the governance controlled validator commission withholdings
+	ValidatorForfeitedRewardsPrefix        = collections.NewPrefix(17) // key for the validator rewards forfeited to the community pool
+	HistoricalRewardsCompactionCursorKey   = collections.NewPrefix(18) // key for the historical rewards compaction cursor
+	CommunityPoolRemainderAccruedKey       = collections.NewPrefix(19) // key for the cumulative remainders added to the community pool
+	WithdrawOperatorsPrefix                = collections.NewPrefix(20) // key for the withdraw operators registered by the delegators
+	RewardAdjustmentsPrefix                = collections.NewPrefix(21) // key for the reward adjustment audit log
+	RewardAdjustmentSequenceKey            = collections.NewPrefix(22) // key for the reward adjustment sequence
+	RewardDenomEvictionsPrefix             = collections.NewPrefix(23) // key for the reward denom evictions pending in the rewards records of the validators
+
+	PendingValidatorDustPrefix       = collections.NewPrefix(0) // transient key for validator dust pending to be flushed at the end of the block
+	PendingCommunityPoolRemainderKey = collections.NewPrefix(1) // transient key for the remainders pending to be flushed at the end of the block
+	PendingImplicitWithdrawalsPrefix = collections.NewPrefix(2) // transient key for the implicit withdrawals pending to be reported at the end of the transaction
+	ImplicitWithdrawalsSequenceKey   = collections.NewPrefix(3) // transient key for the sequence ordering the pending implicit withdrawals
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -170,6 +224,11 @@ func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
 }
 
+// GetDelegatorStartingInfoPrefix creates the prefix key for the starting infos of a validator's delegations.
+func GetDelegatorStartingInfoPrefix(v sdk.ValAddress) []byte {
+	return append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
 // GetValidatorHistoricalRewardsPrefix creates the prefix key for a validator's historical rewards.
 func GetValidatorHistoricalRewardsPrefix(v sdk.ValAddress) []byte {
 	return append(ValidatorHistoricalRewardsPrefix, address.MustLengthPrefix(v.Bytes())...)
@@ -2,6 +2,16 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxRewardAdjustmentEntries is the maximum number of validators adjusted
+	// by a MsgApplyRewardAdjustment.
+	MaxRewardAdjustmentEntries = 100
+	// MaxRewardAdjustmentJustificationLength is the maximum length of the
+	// justification of a MsgApplyRewardAdjustment.
+	MaxRewardAdjustmentJustificationLength = 2048
 )
 
 // Verify interface at compile time
@@ -12,6 +22,16 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgResetValidatorRewardDistributionStats)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
+	_ sdk.Msg = (*MsgSetCommissionWithholding)(nil)
+	_ sdk.Msg = (*MsgResolveCommissionWithholding)(nil)
+	_ sdk.Msg = (*MsgRegisterWithdrawOperator)(nil)
+	_ sdk.Msg = (*MsgApplyRewardAdjustment)(nil)
+	_ sdk.Msg = (*MsgSweepCommunityPoolDenoms)(nil)
+
+	_ sdk.HasValidateBasic = (*MsgApplyRewardAdjustment)(nil)
+	_ sdk.HasValidateBasic = (*MsgSweepCommunityPoolDenoms)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -28,6 +48,35 @@ func NewMsgWithdrawDelegatorReward(delAddr, valAddr string) *MsgWithdrawDelegato
 	}
 }
 
+// NewMsgWithdrawDelegatorRewardOnBehalf returns a new MsgWithdrawDelegatorReward
+// signed by the withdraw operator of a delegator, withdrawing the rewards of the
+// delegator to its withdraw address.
+func NewMsgWithdrawDelegatorRewardOnBehalf(operatorAddr, valAddr, delAddr string) *MsgWithdrawDelegatorReward {
+	return &MsgWithdrawDelegatorReward{
+		DelegatorAddress: operatorAddr,
+		ValidatorAddress: valAddr,
+		OnBehalfOf:       delAddr,
+	}
+}
+
+// NewMsgRegisterWithdrawOperator returns a new MsgRegisterWithdrawOperator
+// registering the withdraw operator of a delegator, or revoking it if the
+// operator address is empty.
+func NewMsgRegisterWithdrawOperator(delAddr, operatorAddr string) *MsgRegisterWithdrawOperator {
+	return &MsgRegisterWithdrawOperator{
+		DelegatorAddress: delAddr,
+		OperatorAddress:  operatorAddr,
+	}
+}
+
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of all the delegations of a delegator.
+func NewMsgWithdrawAllDelegatorRewards(delAddr string) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr,
+	}
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr string) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr,
@@ -52,3 +101,96 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgApplyRewardAdjustment returns a new MsgApplyRewardAdjustment adjusting
+// the rewards of validators misallocated between the given heights.
+func NewMsgApplyRewardAdjustment(
+	authority string, adjustments []ValidatorRewardAdjustment, justification string, startHeight, endHeight int64,
+) *MsgApplyRewardAdjustment {
+	return &MsgApplyRewardAdjustment{
+		Authority:     authority,
+		Adjustments:   adjustments,
+		Justification: justification,
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+	}
+}
+
+// ValidateBasic performs the stateless validation of the adjustments: there
+// are between one and MaxRewardAdjustmentEntries of them, at most one per
+// validator, with valid non-zero amounts, justified and bound to a valid
+// height range. The addresses are validated by the msg server.
+func (msg *MsgApplyRewardAdjustment) ValidateBasic() error {
+	if len(msg.Adjustments) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("no reward adjustment")
+	}
+
+	if len(msg.Adjustments) > MaxRewardAdjustmentEntries {
+		return sdkerrors.ErrInvalidRequest.Wrapf("too many reward adjustments: %d > %d", len(msg.Adjustments), MaxRewardAdjustmentEntries)
+	}
+
+	if msg.Justification == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("reward adjustment justification cannot be empty")
+	}
+
+	if len(msg.Justification) > MaxRewardAdjustmentJustificationLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("reward adjustment justification too long: %d > %d",
+			len(msg.Justification), MaxRewardAdjustmentJustificationLength)
+	}
+
+	if msg.StartHeight <= 0 || msg.EndHeight < msg.StartHeight {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid reward adjustment height range [%d, %d]", msg.StartHeight, msg.EndHeight)
+	}
+
+	validators := make(map[string]struct{}, len(msg.Adjustments))
+	for _, adjustment := range msg.Adjustments {
+		if _, ok := validators[adjustment.ValidatorAddress]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate reward adjustment for validator %s", adjustment.ValidatorAddress)
+		}
+		validators[adjustment.ValidatorAddress] = struct{}{}
+
+		if err := adjustment.Amount.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid reward adjustment of validator %s: %s", adjustment.ValidatorAddress, err)
+		}
+
+		if adjustment.Amount.IsZero() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("zero reward adjustment of validator %s", adjustment.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgSweepCommunityPoolDenoms returns a new MsgSweepCommunityPoolDenoms
+// removing denoms from the community pool. The swept coins are burned if the
+// disposal address is empty.
+func NewMsgSweepCommunityPoolDenoms(authority string, denoms []string, disposalAddr string) *MsgSweepCommunityPoolDenoms {
+	return &MsgSweepCommunityPoolDenoms{
+		Authority:       authority,
+		Denoms:          denoms,
+		DisposalAddress: disposalAddr,
+	}
+}
+
+// ValidateBasic performs the stateless validation of the swept denoms: there
+// is at least one of them, all valid and distinct. The addresses are validated
+// by the msg server.
+func (msg *MsgSweepCommunityPoolDenoms) ValidateBasic() error {
+	if len(msg.Denoms) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("no denom to sweep")
+	}
+
+	denoms := make(map[string]struct{}, len(msg.Denoms))
+	for _, denom := range msg.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid denom to sweep: %s", err)
+		}
+
+		if _, ok := denoms[denom]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom to sweep %s", denom)
+		}
+		denoms[denom] = struct{}{}
+	}
+
+	return nil
+}
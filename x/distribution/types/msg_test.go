@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMsgApplyRewardAdjustment_ValidateBasic(t *testing.T) {
+	amount := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	adjustment := func(val string) types.ValidatorRewardAdjustment {
+		return types.ValidatorRewardAdjustment{ValidatorAddress: val, Amount: amount}
+	}
+
+	tooMany := make([]types.ValidatorRewardAdjustment, types.MaxRewardAdjustmentEntries+1)
+	for i := range tooMany {
+		tooMany[i] = adjustment(strings.Repeat("v", i+1))
+	}
+
+	tests := []struct {
+		name          string
+		adjustments   []types.ValidatorRewardAdjustment
+		justification string
+		start, end    int64
+		err           error
+	}{
+		{"valid", []types.ValidatorRewardAdjustment{adjustment("a"), {ValidatorAddress: "b", Amount: amount, Deduct: true}}, "bug", 1, 1, nil},
+		{"no adjustment", nil, "bug", 1, 2, sdkerrors.ErrInvalidRequest},
+		{"too many adjustments", tooMany, "bug", 1, 2, sdkerrors.ErrInvalidRequest},
+		{"no justification", []types.ValidatorRewardAdjustment{adjustment("a")}, "", 1, 2, sdkerrors.ErrInvalidRequest},
+		{"justification too long", []types.ValidatorRewardAdjustment{adjustment("a")}, strings.Repeat("b", types.MaxRewardAdjustmentJustificationLength+1), 1, 2, sdkerrors.ErrInvalidRequest},
+		{"zero start height", []types.ValidatorRewardAdjustment{adjustment("a")}, "bug", 0, 2, sdkerrors.ErrInvalidRequest},
+		{"end before start", []types.ValidatorRewardAdjustment{adjustment("a")}, "bug", 3, 2, sdkerrors.ErrInvalidRequest},
+		{"duplicate validator", []types.ValidatorRewardAdjustment{adjustment("a"), adjustment("a")}, "bug", 1, 2, sdkerrors.ErrInvalidRequest},
+		{"zero amount", []types.ValidatorRewardAdjustment{{ValidatorAddress: "a"}}, "bug", 1, 2, sdkerrors.ErrInvalidCoins},
+		{"invalid amount", []types.ValidatorRewardAdjustment{{ValidatorAddress: "a", Amount: sdk.DecCoins{{Denom: "1", Amount: sdkmath.LegacyOneDec()}}}}, "bug", 1, 2, sdkerrors.ErrInvalidCoins},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := types.NewMsgApplyRewardAdjustment("authority", tt.adjustments, tt.justification, tt.start, tt.end)
+			err := msg.ValidateBasic()
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestMsgSweepCommunityPoolDenoms_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		denoms []string
+		err    error
+	}{
+		{"valid", []string{"spam", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}, nil},
+		{"no denom", nil, sdkerrors.ErrInvalidRequest},
+		{"invalid denom", []string{"1"}, sdkerrors.ErrInvalidCoins},
+		{"duplicate denom", []string{"spam", "spam"}, sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.NewMsgSweepCommunityPoolDenoms("authority", tt.denoms, "").ValidateBasic()
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultParams returns default distribution parameters
@@ -18,7 +21,44 @@ func DefaultParams() Params {
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	if err := validateCommunityTaxOverrides(p.CommunityTaxOverrides); err != nil {
+		return err
+	}
+
+	if p.RewardCheckpointInterval > 0 && p.RewardCheckpointsPerBlock == 0 {
+		return fmt.Errorf("reward checkpoints per block must be positive when reward checkpointing is enabled")
+	}
+
+	if _, ok := OutstandingRewardsDenomPolicy_name[int32(p.OutstandingRewardsDenomPolicy)]; !ok {
+		return fmt.Errorf("invalid outstanding rewards denom policy: %d", p.OutstandingRewardsDenomPolicy)
+	}
+
+	if err := p.MinWithdrawalAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid min withdrawal amount: %w", err)
+	}
+
+	if err := validateCommunityPoolAllowedDenoms(p.CommunityPoolAllowedDenoms); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CommunityTaxForDenom returns the community tax rate applied to the fees
+// collected in the given denom, falling back to the global community tax when
+// the denom has no override.
+func (p Params) CommunityTaxForDenom(denom string) math.LegacyDec {
+	for _, o := range p.CommunityTaxOverrides {
+		if o.Denom == denom {
+			return o.Rate
+		}
+	}
+
+	return p.CommunityTax
 }
 
 func validateCommunityTax(i any) error {
@@ -40,6 +80,49 @@ func validateCommunityTax(i any) error {
 	return nil
 }
 
+func validateCommunityTaxOverrides(overrides []CommunityTaxOverride) error {
+	seen := make(map[string]struct{}, len(overrides))
+	for _, o := range overrides {
+		if err := sdk.ValidateDenom(o.Denom); err != nil {
+			return fmt.Errorf("invalid community tax override denom: %w", err)
+		}
+
+		if _, ok := seen[o.Denom]; ok {
+			return fmt.Errorf("duplicate community tax override for denom %s", o.Denom)
+		}
+		seen[o.Denom] = struct{}{}
+
+		if err := validateCommunityTax(o.Rate); err != nil {
+			return fmt.Errorf("invalid community tax override for denom %s: %w", o.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// CommunityPoolDenomAllowed returns true if the community pool can be funded
+// with the given denom, which is the case of any denom when the community pool
+// allowed denoms are empty.
+func (p Params) CommunityPoolDenomAllowed(denom string) bool {
+	return len(p.CommunityPoolAllowedDenoms) == 0 || slices.Contains(p.CommunityPoolAllowedDenoms, denom)
+}
+
+func validateCommunityPoolAllowedDenoms(denoms []string) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid community pool allowed denom: %w", err)
+		}
+
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("duplicate community pool allowed denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+
+	return nil
+}
+
 func validateWithdrawAddrEnabled(i any) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -7,6 +7,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -45,6 +46,90 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestParams_ValidateBasicCommunityTaxOverrides(t *testing.T) {
+	toDec := sdkmath.LegacyMustNewDecFromStr
+
+	tests := []struct {
+		name      string
+		overrides []types.CommunityTaxOverride
+		wantErr   bool
+	}{
+		{"no overrides", nil, false},
+		{"success", []types.CommunityTaxOverride{{Denom: "uatom", Rate: toDec("0.1")}, {Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Rate: toDec("1")}}, false},
+		{"zero rate", []types.CommunityTaxOverride{{Denom: "uatom", Rate: toDec("0")}}, false},
+		{"negative rate", []types.CommunityTaxOverride{{Denom: "uatom", Rate: toDec("-0.1")}}, true},
+		{"rate greater than 1", []types.CommunityTaxOverride{{Denom: "uatom", Rate: toDec("1.1")}}, true},
+		{"nil rate", []types.CommunityTaxOverride{{Denom: "uatom"}}, true},
+		{"invalid denom", []types.CommunityTaxOverride{{Denom: "1atom", Rate: toDec("0.1")}}, true},
+		{"empty denom", []types.CommunityTaxOverride{{Denom: "", Rate: toDec("0.1")}}, true},
+		{"duplicate denom", []types.CommunityTaxOverride{{Denom: "uatom", Rate: toDec("0.1")}, {Denom: "uatom", Rate: toDec("0.2")}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			p.CommunityTaxOverrides = tt.overrides
+			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateBasicOutstandingRewardsDenomPolicy(t *testing.T) {
+	p := types.DefaultParams()
+	p.MaxOutstandingRewardDenoms = 10
+	for policy := range types.OutstandingRewardsDenomPolicy_name {
+		p.OutstandingRewardsDenomPolicy = types.OutstandingRewardsDenomPolicy(policy)
+		require.NoError(t, p.ValidateBasic())
+	}
+
+	p.OutstandingRewardsDenomPolicy = 3
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicMinWithdrawalAmount(t *testing.T) {
+	p := types.DefaultParams()
+	p.MinWithdrawalAmount = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, p.ValidateBasic())
+
+	for name, amount := range map[string]sdk.Coins{
+		"unsorted":      {sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("stake", 1000)},
+		"zero amount":   {sdk.NewInt64Coin("stake", 0)},
+		"invalid denom": {sdk.Coin{Denom: "1", Amount: sdkmath.NewInt(10)}},
+	} {
+		p.MinWithdrawalAmount = amount
+		require.Error(t, p.ValidateBasic(), name)
+	}
+}
+
+func TestParams_CommunityPoolAllowedDenoms(t *testing.T) {
+	p := types.DefaultParams()
+	require.True(t, p.CommunityPoolDenomAllowed("spam"))
+
+	p.CommunityPoolAllowedDenoms = []string{"stake", "uatom"}
+	require.NoError(t, p.ValidateBasic())
+	require.True(t, p.CommunityPoolDenomAllowed("uatom"))
+	require.False(t, p.CommunityPoolDenomAllowed("spam"))
+
+	for name, denoms := range map[string][]string{
+		"invalid denom":   {"1"},
+		"duplicate denom": {"stake", "stake"},
+	} {
+		p.CommunityPoolAllowedDenoms = denoms
+		require.Error(t, p.ValidateBasic(), name)
+	}
+}
+
+func TestParams_CommunityTaxForDenom(t *testing.T) {
+	p := types.DefaultParams()
+	p.CommunityTaxOverrides = []types.CommunityTaxOverride{
+		{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(1, 1)},
+	}
+
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(1, 1), p.CommunityTaxForDenom("uatom"))
+	require.Equal(t, p.CommunityTax, p.CommunityTaxForDenom("stake"))
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
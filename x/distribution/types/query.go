@@ -7,6 +7,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxBatchDelegationRewardsPairs is the maximum number of delegations of a
+// batch delegation rewards query.
+const MaxBatchDelegationRewardsPairs = 100
+
 // QueryDelegatorTotalRewardsResponse defines the properties of
 // QueryDelegatorTotalRewards query's response.
 type QueryDelegatorTotalRewardsResponse struct {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,3 +36,43 @@ func NewValidatorSlashEvent(validatorPeriod uint64, fraction sdkmath.LegacyDec)
 		Fraction:        fraction,
 	}
 }
+
+// Validate performs a basic validation of the cumulative reward distribution stats.
+func (s ValidatorRewardDistributionStats) Validate() error {
+	if err := s.SelfBondRewards.Validate(); err != nil {
+		return fmt.Errorf("self bond rewards: %w", err)
+	}
+	if err := s.ExternalRewards.Validate(); err != nil {
+		return fmt.Errorf("external rewards: %w", err)
+	}
+	if err := s.CommissionWithdrawn.Validate(); err != nil {
+		return fmt.Errorf("commission withdrawn: %w", err)
+	}
+	return nil
+}
+
+// MaxRecentRewardsForfeitures is the number of most recent forfeitures kept in
+// the forfeited rewards of a validator.
+const MaxRecentRewardsForfeitures = 10
+
+// Validate performs a basic validation of the forfeited rewards.
+func (f ValidatorForfeitedRewards) Validate() error {
+	if err := f.Forfeited.Validate(); err != nil {
+		return fmt.Errorf("forfeited: %w", err)
+	}
+	if len(f.Recent) > MaxRecentRewardsForfeitures {
+		return fmt.Errorf("%d recent forfeitures exceed the maximum of %d", len(f.Recent), MaxRecentRewardsForfeitures)
+	}
+	for i, forfeiture := range f.Recent {
+		if forfeiture.Height <= 0 {
+			return fmt.Errorf("invalid height %d of recent forfeiture %d", forfeiture.Height, i)
+		}
+		if i > 0 && forfeiture.Height < f.Recent[i-1].Height {
+			return fmt.Errorf("recent forfeiture %d at height %d precedes the previous one", i, forfeiture.Height)
+		}
+		if err := forfeiture.Amount.Validate(); err != nil {
+			return fmt.Errorf("amount of recent forfeiture %d: %w", i, err)
+		}
+	}
+	return nil
+}
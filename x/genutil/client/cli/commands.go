@@ -39,6 +39,9 @@ func CommandsWithCustomMigrationMap(txConfig client.TxConfig, moduleBasics modul
 		MigrateGenesisCmd(migrationMap),
 		CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, defaultNodeHome, gentxModule.GenTxValidator, txConfig.SigningContext().ValidatorAddressCodec()),
 		ValidateGenesisCmd(moduleBasics),
+		PatchGenesisCmd(moduleBasics),
+		RotateConsensusKeysCmd(),
+		GenesisFixtureCmd(moduleBasics),
 		AddGenesisAccountCmd(defaultNodeHome, txConfig.SigningContext().AddressCodec()),
 		AddBulkGenesisAccountCmd(defaultNodeHome, txConfig.SigningContext().AddressCodec()),
 	)
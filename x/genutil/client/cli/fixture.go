@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+)
+
+// GenesisFixtureCmd returns a command that generates a deterministic genesis
+// fixture from the default genesis of the app.
+func GenesisFixtureCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixture [config-file]",
+		Short: "Generate a deterministic genesis fixture from the default genesis of the app",
+		Long: `Generate a complete genesis from the default genesis of the app, populated with randomly generated
+values and overridden as described by the config file. The same config file always generates the same genesis.
+The generated genesis of every module is validated before it is written.
+
+The config file is a JSON object of the form:
+
+{
+  "chain_id": "fixture",
+  "seed": 1,
+  "populations": [{"module": "bank", "path": "/balances", "count": 100, "schema": {...}}],
+  "overrides": [{"module": "bank", "path": "/params/default_send_enabled", "value": false}]
+}`,
+		Example: fmt.Sprintf("%s genesis fixture fixture.json --output-document testdata/genesis.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := os.ReadFile(filepath.Clean(args[0]))
+			if err != nil {
+				return err
+			}
+
+			var cfg genutil.GenesisFixtureConfig
+			if err := json.Unmarshal(bz, &cfg); err != nil {
+				return fmt.Errorf("failed to decode fixture config %s: %w", args[0], err)
+			}
+
+			appGenesis, err := genutil.GenerateGenesisFixture(clientCtx.Codec, clientCtx.TxConfig, mbm, cfg)
+			if err != nil {
+				return err
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument != "" {
+				return appGenesis.SaveAs(outputDocument)
+			}
+
+			out, err := json.MarshalIndent(appGenesis, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the genesis to the given file instead of the standard output")
+
+	return cmd
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const flagDryRun = "dry-run"
+
+// PatchGenesisCmd returns a command that applies RFC 6902 JSON patch documents
+// to the genesis file.
+func PatchGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "patch [patch-file]...",
+		Short: "Apply RFC 6902 JSON patch documents to the genesis file",
+		Long: `Apply one or more RFC 6902 JSON patch documents, in order, to the genesis file at the default location.
+Patches are applied with all-or-nothing semantics: the genesis file is only written if every patch applies
+and the genesis of every touched module passes its validation. The before and after hash of each touched
+module is printed.`,
+		Example: fmt.Sprintf("%s genesis patch bump-params.json add-account.json", version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genFile := serverCtx.Config.GenesisFile()
+			appGenesis, err := types.AppGenesisFromFile(genFile)
+			if err != nil {
+				return enrichUnmarshalError(err)
+			}
+
+			patches := make([]types.JSONPatch, 0, len(args))
+			for _, patchFile := range args {
+				bz, err := os.ReadFile(filepath.Clean(patchFile))
+				if err != nil {
+					return err
+				}
+
+				patch, err := types.DecodeJSONPatch(bz)
+				if err != nil {
+					return fmt.Errorf("%s: %w", patchFile, err)
+				}
+				patches = append(patches, patch)
+			}
+
+			patched, changes, err := genutil.PatchAppGenesis(clientCtx.Codec, clientCtx.TxConfig, mbm, appGenesis, patches...)
+			if err != nil {
+				return err
+			}
+
+			for _, change := range changes {
+				cmd.Printf("%s: %s -> %s\n", change.Module, change.BeforeHash, change.AfterHash)
+			}
+
+			if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+				return nil
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument == "" {
+				outputDocument = genFile
+			}
+
+			return patched.SaveAs(outputDocument)
+		},
+	}
+
+	cmd.Flags().Bool(flagDryRun, false, "Apply and validate the patches without writing the genesis file")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Patched genesis is written to the given file instead of overwriting the genesis file")
+
+	return cmd
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagMinPower      = "min-power"
+	flagZeroUncovered = "zero-uncovered"
+)
+
+// RotateConsensusKeysCmd returns a command that replaces the consensus keys of
+// the validators of an exported genesis, for forked testnets.
+func RotateConsensusKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-consensus-keys [mapping-file]",
+		Short: "Replace the consensus keys of the validators of an exported genesis, for forked testnets",
+		Long: `Replace the consensus keys of the validators of the exported genesis file at the default location, so
+that a testnet forked from it can produce blocks with keys controlled by its operators. The consensus validator set,
+the staking validators and the slashing signing infos are rewritten consistently.
+
+The mapping file is a JSON object mapping validator operator or consensus addresses to the new consensus public keys,
+as printed by the comet show-validator command:
+
+{
+  "cosmosvaloper1...": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "..."}
+}
+
+Every bonded validator with a power above --min-power must be covered by the mapping, unless --zero-uncovered is set,
+in which case the uncovered ones are jailed and removed from the validator set. The resulting voting power
+distribution is printed, so that it can be confirmed that the rotated validators can reach quorum.`,
+		Example: fmt.Sprintf("%s genesis rotate-consensus-keys keys.json --zero-uncovered --output-document testnet.json", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genFile := serverCtx.Config.GenesisFile()
+			appGenesis, err := types.AppGenesisFromFile(genFile)
+			if err != nil {
+				return enrichUnmarshalError(err)
+			}
+
+			bz, err := os.ReadFile(filepath.Clean(args[0]))
+			if err != nil {
+				return err
+			}
+
+			mapping, err := genutil.DecodeConsensusKeyMapping(clientCtx.Codec, bz)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+
+			var opts genutil.ConsensusKeyRotationOptions
+			opts.MinPower, _ = cmd.Flags().GetInt64(flagMinPower)
+			opts.ZeroUncovered, _ = cmd.Flags().GetBool(flagZeroUncovered)
+
+			rotated, report, err := genutil.RotateConsensusKeys(clientCtx.Codec, appGenesis, mapping, opts)
+			if err != nil {
+				return err
+			}
+
+			for _, val := range report.Validators {
+				cmd.Printf("%s %s %d %s\n", val.Operator, val.ConsAddress, val.Power, val.Status)
+			}
+			cmd.Printf("rotated power %d of %d, %d zeroed\n", report.RotatedPower, report.TotalPower, report.ZeroedPower)
+			if !report.CanReachQuorum() {
+				cmd.PrintErrln("WARNING: the rotated validators hold no more than 2/3 of the power and cannot reach quorum on their own")
+			}
+
+			if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+				return nil
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument == "" {
+				outputDocument = genFile
+			}
+
+			return rotated.SaveAs(outputDocument)
+		},
+	}
+
+	cmd.Flags().Int64(flagMinPower, 0, "Bonded validators with a power above this one must be covered by the mapping")
+	cmd.Flags().Bool(flagZeroUncovered, false, "Reduce the power of the uncovered bonded validators to zero instead of failing")
+	cmd.Flags().Bool(flagDryRun, false, "Rotate the keys and print the report without writing the genesis file")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Rotated genesis is written to the given file instead of overwriting the genesis file")
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
@@ -47,6 +49,19 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 				return fmt.Errorf("make sure that you have correctly migrated all CometBFT consensus params. Refer the UPGRADING.md (%s): %w", chainUpgradeGuide, err)
 			}
 
+			// structurally validate the module sections with a schema first, as
+			// it is much cheaper than decoding them and reports all the problems
+			report, err := genutil.ValidateAppStateSchemas(bytes.NewReader(appGenesis.AppState), genutil.GenesisSchemas(mbm))
+			if err != nil {
+				return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+			}
+			if len(report.Skipped) > 0 {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Skipping structural validation of modules without a genesis schema: %s\n", strings.Join(report.Skipped, ", "))
+			}
+			if err := report.Err(); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+			}
+
 			var genState map[string]json.RawMessage
 			if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
 				if strings.Contains(err.Error(), "unexpected end of JSON input") {
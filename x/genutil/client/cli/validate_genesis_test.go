@@ -9,12 +9,15 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/schema"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 // An example exported genesis file from a 0.37 chain. Note that evidence
@@ -60,6 +63,15 @@ func TestValidateGenesis(t *testing.T) {
 			"section is missing in the app_state",
 			module.NewBasicManager(mockModule{}),
 		},
+		{
+			"invalid: structural errors in module sections with a schema",
+			`{"app_state": {"mock": {"foo": 1}, "other": {"bar": "yes"}}, "chain_id": "test", "consensus": {}}`,
+			"/app_state/mock/foo: expected string, got number\n/app_state/other/bar: expected bool, got string",
+			module.NewBasicManager(
+				schemaModule{name: "mock", schema: &types.GenesisSchema{Properties: map[string]*types.GenesisSchema{"foo": {Kind: schema.StringKind}}}},
+				schemaModule{name: "other", schema: &types.GenesisSchema{Properties: map[string]*types.GenesisSchema{"bar": {Kind: schema.BoolKind}}}},
+			),
+		},
 		{
 			"exported 0.37 genesis file",
 			v037Exported,
@@ -109,3 +121,19 @@ func (m mockModule) DefaultGenesis(codec.JSONCodec) json.RawMessage {
 func (m mockModule) ValidateGenesis(codec.JSONCodec, client.TxEncodingConfig, json.RawMessage) error {
 	return fmt.Errorf("mock section is missing: %w", io.EOF)
 }
+
+var _ types.HasGenesisSchema = schemaModule{}
+
+type schemaModule struct {
+	module.AppModuleBasic
+	name   string
+	schema *types.GenesisSchema
+}
+
+func (m schemaModule) Name() string {
+	return m.name
+}
+
+func (m schemaModule) GenesisSchema() *types.GenesisSchema {
+	return m.schema
+}
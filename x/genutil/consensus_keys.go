@@ -0,0 +1,416 @@
+package genutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Statuses of the validators in a ConsensusKeyRotationReport.
+const (
+	ConsensusKeyRotated   = "rotated"
+	ConsensusKeyUncovered = "uncovered"
+	ConsensusKeyZeroed    = "zeroed"
+)
+
+// ConsensusKeyRotationOptions configures RotateConsensusKeys.
+type ConsensusKeyRotationOptions struct {
+	// MinPower is the consensus power above which every bonded validator must
+	// be covered by the mapping.
+	MinPower int64
+	// ZeroUncovered reduces the power of the uncovered bonded validators above
+	// MinPower to zero instead of failing.
+	ZeroUncovered bool
+}
+
+// RotatedValidator describes the outcome of a key rotation for a single
+// bonded validator.
+type RotatedValidator struct {
+	Operator    string `json:"operator"`
+	ConsAddress string `json:"cons_address"`
+	Power       int64  `json:"power"`
+	Status      string `json:"status"`
+}
+
+// ConsensusKeyRotationReport describes the voting power distribution of the
+// bonded validators after a key rotation.
+type ConsensusKeyRotationReport struct {
+	Validators []RotatedValidator `json:"validators"`
+	// TotalPower is the power of the validator set after the rotation, which
+	// excludes the zeroed validators.
+	TotalPower   int64 `json:"total_power"`
+	RotatedPower int64 `json:"rotated_power"`
+	ZeroedPower  int64 `json:"zeroed_power"`
+}
+
+// CanReachQuorum returns whether the validators whose keys were rotated hold
+// more than two thirds of the power of the validator set.
+func (r ConsensusKeyRotationReport) CanReachQuorum() bool {
+	return r.TotalPower > 0 && 3*r.RotatedPower > 2*r.TotalPower
+}
+
+// DecodeConsensusKeyMapping decodes a consensus key mapping file. The file is
+// a JSON object mapping validator operator or consensus addresses to the new
+// consensus public keys, in the format printed by `comet show-validator`.
+func DecodeConsensusKeyMapping(cdc codec.Codec, bz []byte) (map[string]cryptotypes.PubKey, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode consensus key mapping: %w", err)
+	}
+
+	mapping := make(map[string]cryptotypes.PubKey, len(raw))
+	for addr, pkBz := range raw {
+		var pk cryptotypes.PubKey
+		if err := cdc.UnmarshalInterfaceJSON(pkBz, &pk); err != nil {
+			return nil, fmt.Errorf("invalid consensus public key for %s: %w", addr, err)
+		}
+		mapping[addr] = pk
+	}
+
+	return mapping, nil
+}
+
+// RotateConsensusKeys replaces the consensus keys of the validators of an
+// exported genesis, so that a network forked from it can be run with keys
+// controlled by its operators. The mapping is keyed by validator operator or
+// consensus address. The consensus validator set, the staking validators and
+// the slashing signing infos are rewritten consistently.
+//
+// Every bonded validator with a power above opts.MinPower must be covered by
+// the mapping, unless opts.ZeroUncovered is set, in which case the uncovered
+// ones are jailed and unbonded, and removed from the validator set. The given
+// genesis is left untouched.
+func RotateConsensusKeys(
+	cdc codec.Codec,
+	appGenesis *types.AppGenesis,
+	mapping map[string]cryptotypes.PubKey,
+	opts ConsensusKeyRotationOptions,
+) (*types.AppGenesis, ConsensusKeyRotationReport, error) {
+	var report ConsensusKeyRotationReport
+
+	appState, err := appStateFromGenesis(appGenesis)
+	if err != nil {
+		return nil, report, err
+	}
+
+	stakingBz, ok := appState[stakingtypes.ModuleName]
+	if !ok {
+		return nil, report, fmt.Errorf("genesis has no %s state", stakingtypes.ModuleName)
+	}
+
+	var stakingGenesis stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(stakingBz, &stakingGenesis); err != nil {
+		return nil, report, fmt.Errorf("failed to unmarshal %s genesis: %w", stakingtypes.ModuleName, err)
+	}
+
+	newKeys, err := resolveConsensusKeyMapping(stakingGenesis.Validators, mapping)
+	if err != nil {
+		return nil, report, err
+	}
+
+	var allowedKeyTypes []string
+	if appGenesis.Consensus != nil && appGenesis.Consensus.Params != nil {
+		allowedKeyTypes = appGenesis.Consensus.Params.Validator.PubKeyTypes
+	}
+
+	lastPowers := make(map[string]int64, len(stakingGenesis.LastValidatorPowers))
+	for _, lv := range stakingGenesis.LastValidatorPowers {
+		lastPowers[lv.Address] = lv.Power
+	}
+
+	var (
+		// consAddrs maps the original consensus addresses to the new ones
+		consAddrs    = make(map[string]string)
+		newPubKeys   = make(map[string]cryptotypes.PubKey)
+		usedKeys     = make(map[string]string)
+		zeroed       = make(map[string]bool)
+		zeroedTokens = math.ZeroInt()
+	)
+	for _, val := range stakingGenesis.Validators {
+		// the consensus addresses were checked when resolving the mapping
+		consAddr, _ := val.GetConsAddr()
+		usedKeys[string(consAddr)] = val.OperatorAddress
+	}
+
+	for i, val := range stakingGenesis.Validators {
+		bz, _ := val.GetConsAddr()
+		consAddr := sdk.ConsAddress(bz)
+		power, bonded := lastPowers[val.OperatorAddress]
+
+		pk, covered := newKeys[val.OperatorAddress]
+		switch {
+		case covered:
+			cmtPk, err := cryptocodec.ToCmtPubKeyInterface(pk)
+			if err != nil {
+				return nil, report, fmt.Errorf("validator %s: %w", val.OperatorAddress, err)
+			}
+
+			if len(allowedKeyTypes) > 0 && !cmttypes.IsValidPubkeyType(cmttypes.ValidatorParams{PubKeyTypes: allowedKeyTypes}, cmtPk.Type()) {
+				return nil, report, fmt.Errorf("validator %s: consensus key type %s is not allowed by the consensus params", val.OperatorAddress, cmtPk.Type())
+			}
+
+			newConsAddr := sdk.ConsAddress(pk.Address())
+			if other, ok := usedKeys[string(newConsAddr)]; ok && other != val.OperatorAddress {
+				return nil, report, fmt.Errorf("validator %s: consensus key %s is already used by validator %s", val.OperatorAddress, newConsAddr, other)
+			}
+			usedKeys[string(newConsAddr)] = val.OperatorAddress
+
+			pkAny, err := codectypes.NewAnyWithValue(pk)
+			if err != nil {
+				return nil, report, err
+			}
+
+			stakingGenesis.Validators[i].ConsensusPubkey = pkAny
+			consAddrs[consAddr.String()] = newConsAddr.String()
+			newPubKeys[consAddr.String()] = pk
+
+			if bonded {
+				report.Validators = append(report.Validators, RotatedValidator{
+					Operator:    val.OperatorAddress,
+					ConsAddress: newConsAddr.String(),
+					Power:       power,
+					Status:      ConsensusKeyRotated,
+				})
+				report.RotatedPower += power
+				report.TotalPower += power
+			}
+
+		case !bonded:
+			// validators outside of the validator set keep their keys
+
+		case power <= opts.MinPower:
+			report.Validators = append(report.Validators, RotatedValidator{
+				Operator:    val.OperatorAddress,
+				ConsAddress: consAddr.String(),
+				Power:       power,
+				Status:      ConsensusKeyUncovered,
+			})
+			report.TotalPower += power
+
+		case !opts.ZeroUncovered:
+			return nil, report, fmt.Errorf("bonded validator %s with power %d is not covered by the consensus key mapping", val.OperatorAddress, power)
+
+		default:
+			// unbond the validator right away, so that it is not part of the
+			// validator set and its tokens are not bonded anymore
+			stakingGenesis.Validators[i].Jailed = true
+			stakingGenesis.Validators[i].Status = stakingtypes.Unbonded
+			zeroedTokens = zeroedTokens.Add(val.Tokens)
+			zeroed[val.OperatorAddress] = true
+			zeroed[consAddr.String()] = true
+
+			report.Validators = append(report.Validators, RotatedValidator{
+				Operator:    val.OperatorAddress,
+				ConsAddress: consAddr.String(),
+				Power:       power,
+				Status:      ConsensusKeyZeroed,
+			})
+			report.ZeroedPower += power
+		}
+	}
+
+	if len(zeroed) > 0 {
+		lastValidatorPowers := make([]stakingtypes.LastValidatorPower, 0, len(stakingGenesis.LastValidatorPowers))
+		for _, lv := range stakingGenesis.LastValidatorPowers {
+			if zeroed[lv.Address] {
+				continue
+			}
+			lastValidatorPowers = append(lastValidatorPowers, lv)
+		}
+		stakingGenesis.LastValidatorPowers = lastValidatorPowers
+		stakingGenesis.LastTotalPower = stakingGenesis.LastTotalPower.SubRaw(report.ZeroedPower)
+
+		if err := moveBondedTokens(cdc, appState, stakingGenesis.Params.BondDenom, zeroedTokens); err != nil {
+			return nil, report, err
+		}
+	}
+
+	if appState[stakingtypes.ModuleName], err = cdc.MarshalJSON(&stakingGenesis); err != nil {
+		return nil, report, err
+	}
+
+	if err := rotateSigningInfos(cdc, appState, consAddrs); err != nil {
+		return nil, report, err
+	}
+
+	patched := *appGenesis
+	if patched.AppState, err = json.Marshal(appState); err != nil {
+		return nil, report, fmt.Errorf("failed to marshal app_state: %w", err)
+	}
+
+	if appGenesis.Consensus != nil {
+		consensus := *appGenesis.Consensus
+		consensus.Validators = make([]cmttypes.GenesisValidator, 0, len(appGenesis.Consensus.Validators))
+		for _, gv := range appGenesis.Consensus.Validators {
+			consAddr := sdk.ConsAddress(gv.Address).String()
+			if zeroed[consAddr] {
+				continue
+			}
+
+			if pk, ok := newPubKeys[consAddr]; ok {
+				// the conversion cannot fail, it was checked above
+				gv.PubKey, _ = cryptocodec.ToCmtPubKeyInterface(pk)
+				gv.Address = gv.PubKey.Address()
+			}
+			consensus.Validators = append(consensus.Validators, gv)
+		}
+		patched.Consensus = &consensus
+	}
+
+	sort.Slice(report.Validators, func(i, j int) bool {
+		if report.Validators[i].Power != report.Validators[j].Power {
+			return report.Validators[i].Power > report.Validators[j].Power
+		}
+		return report.Validators[i].Operator < report.Validators[j].Operator
+	})
+
+	return &patched, report, nil
+}
+
+// resolveConsensusKeyMapping returns the new consensus keys by validator
+// operator address. Every entry of the mapping must match a validator.
+func resolveConsensusKeyMapping(validators []stakingtypes.Validator, mapping map[string]cryptotypes.PubKey) (map[string]cryptotypes.PubKey, error) {
+	operators := make(map[string]string, 2*len(validators))
+	for _, val := range validators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return nil, fmt.Errorf("validator %s: %w", val.OperatorAddress, err)
+		}
+		operators[val.OperatorAddress] = val.OperatorAddress
+		operators[sdk.ConsAddress(consAddr).String()] = val.OperatorAddress
+	}
+
+	newKeys := make(map[string]cryptotypes.PubKey, len(mapping))
+	for addr, pk := range mapping {
+		operator, ok := operators[addr]
+		if !ok {
+			return nil, fmt.Errorf("consensus key mapping entry %s matches no validator", addr)
+		}
+
+		if _, ok := newKeys[operator]; ok {
+			return nil, fmt.Errorf("validator %s is mapped more than once", operator)
+		}
+		newKeys[operator] = pk
+	}
+
+	return newKeys, nil
+}
+
+// moveBondedTokens moves tokens from the bonded to the not bonded pool in the
+// bank genesis.
+func moveBondedTokens(cdc codec.JSONCodec, appState map[string]json.RawMessage, bondDenom string, tokens math.Int) error {
+	if tokens.IsZero() {
+		return nil
+	}
+
+	var bankGenesis banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis: %w", banktypes.ModuleName, err)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+
+	var moved bool
+	for i, balance := range bankGenesis.Balances {
+		if balance.Address != bondedPool {
+			continue
+		}
+
+		remaining, hasNeg := balance.Coins.SafeSub(coins...)
+		if hasNeg {
+			return fmt.Errorf("bonded pool balance %s does not cover the tokens of the zeroed validators %s", balance.Coins, coins)
+		}
+		bankGenesis.Balances[i].Coins = remaining
+		moved = true
+	}
+	if !moved {
+		return fmt.Errorf("genesis has no bonded pool balance")
+	}
+
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: notBondedPool, Coins: coins})
+	bankGenesis.Balances = mergeBalances(bankGenesis.Balances)
+
+	bz, err := cdc.MarshalJSON(&bankGenesis)
+	if err != nil {
+		return err
+	}
+	appState[banktypes.ModuleName] = bz
+
+	return nil
+}
+
+// mergeBalances merges the balances of the same address and drops the empty
+// ones.
+func mergeBalances(balances []banktypes.Balance) []banktypes.Balance {
+	byAddress := make(map[string]int, len(balances))
+	merged := make([]banktypes.Balance, 0, len(balances))
+	for _, balance := range balances {
+		if i, ok := byAddress[balance.Address]; ok {
+			merged[i].Coins = merged[i].Coins.Add(balance.Coins...)
+			continue
+		}
+		byAddress[balance.Address] = len(merged)
+		merged = append(merged, balance)
+	}
+
+	nonEmpty := merged[:0]
+	for _, balance := range merged {
+		if !balance.Coins.IsZero() {
+			nonEmpty = append(nonEmpty, balance)
+		}
+	}
+
+	return banktypes.SanitizeGenesisBalances(nonEmpty)
+}
+
+// rotateSigningInfos rekeys the slashing signing infos and missed blocks by the
+// new consensus addresses.
+func rotateSigningInfos(cdc codec.JSONCodec, appState map[string]json.RawMessage, consAddrs map[string]string) error {
+	slashingBz, ok := appState[slashingtypes.ModuleName]
+	if !ok || len(consAddrs) == 0 {
+		return nil
+	}
+
+	var slashingGenesis slashingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(slashingBz, &slashingGenesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis: %w", slashingtypes.ModuleName, err)
+	}
+
+	for i, info := range slashingGenesis.SigningInfos {
+		if newAddr, ok := consAddrs[info.Address]; ok {
+			slashingGenesis.SigningInfos[i].Address = newAddr
+			slashingGenesis.SigningInfos[i].ValidatorSigningInfo.Address = newAddr
+		}
+	}
+
+	for i, missed := range slashingGenesis.MissedBlocks {
+		if newAddr, ok := consAddrs[missed.Address]; ok {
+			slashingGenesis.MissedBlocks[i].Address = newAddr
+		}
+	}
+
+	bz, err := cdc.MarshalJSON(&slashingGenesis)
+	if err != nil {
+		return err
+	}
+	appState[slashingtypes.ModuleName] = bz
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// exportedGenesis returns an exported genesis with 3 bonded validators of
+// power 300, 200 and 100.
+func exportedGenesis(t *testing.T, cdc codec.Codec) (*types.AppGenesis, []sdk.ValAddress, []cryptotypes.PubKey) {
+	t.Helper()
+
+	var (
+		stakingGenesis  = stakingtypes.DefaultGenesisState()
+		slashingGenesis = slashingtypes.DefaultGenesisState()
+		consensus       = &types.ConsensusGenesis{Params: cmttypes.DefaultConsensusParams()}
+		valAddrs        []sdk.ValAddress
+		pubKeys         []cryptotypes.PubKey
+		bonded          = math.ZeroInt()
+	)
+	stakingGenesis.Exported = true
+	stakingGenesis.LastTotalPower = math.ZeroInt()
+
+	for i, power := range []int64{300, 200, 100} {
+		pk := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("validator %d", i))).PubKey()
+		valAddr := sdk.ValAddress(pk.Address())
+		consAddr := sdk.ConsAddress(pk.Address())
+		valAddrs = append(valAddrs, valAddr)
+		pubKeys = append(pubKeys, pk)
+
+		val, err := stakingtypes.NewValidator(valAddr.String(), pk, stakingtypes.Description{Moniker: fmt.Sprintf("validator %d", i)})
+		require.NoError(t, err)
+		val.Status = stakingtypes.Bonded
+		val.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+		val.DelegatorShares = math.LegacyNewDecFromInt(val.Tokens)
+		bonded = bonded.Add(val.Tokens)
+
+		stakingGenesis.Validators = append(stakingGenesis.Validators, val)
+		stakingGenesis.LastValidatorPowers = append(stakingGenesis.LastValidatorPowers, stakingtypes.LastValidatorPower{Address: valAddr.String(), Power: power})
+		stakingGenesis.LastTotalPower = stakingGenesis.LastTotalPower.AddRaw(power)
+
+		slashingGenesis.SigningInfos = append(slashingGenesis.SigningInfos, slashingtypes.SigningInfo{
+			Address:              consAddr.String(),
+			ValidatorSigningInfo: slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0).UTC(), false, 0),
+		})
+		slashingGenesis.MissedBlocks = append(slashingGenesis.MissedBlocks, slashingtypes.ValidatorMissedBlocks{Address: consAddr.String()})
+
+		cmtPk, err := cryptocodec.ToCmtPubKeyInterface(pk)
+		require.NoError(t, err)
+		consensus.Validators = append(consensus.Validators, cmttypes.GenesisValidator{Address: cmtPk.Address(), PubKey: cmtPk, Power: power})
+	}
+
+	supply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bonded))
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{{Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(), Coins: supply}}
+	bankGenesis.Supply = supply
+
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		stakingtypes.ModuleName:  cdc.MustMarshalJSON(stakingGenesis),
+		slashingtypes.ModuleName: cdc.MustMarshalJSON(slashingGenesis),
+		banktypes.ModuleName:     cdc.MustMarshalJSON(bankGenesis),
+	})
+	require.NoError(t, err)
+
+	return &types.AppGenesis{ChainID: "forked", AppState: appState, Consensus: consensus}, valAddrs, pubKeys
+}
+
+func TestRotateConsensusKeys(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}, staking.AppModuleBasic{}, slashing.AppModuleBasic{})
+	mbm := module.NewBasicManager(bank.AppModuleBasic{}, staking.AppModuleBasic{}, slashing.AppModuleBasic{})
+
+	appGenesis, valAddrs, pubKeys := exportedGenesis(t, encCfg.Codec)
+	newKeys := []cryptotypes.PubKey{
+		ed25519.GenPrivKeyFromSecret([]byte("new key 0")).PubKey(),
+		ed25519.GenPrivKeyFromSecret([]byte("new key 1")).PubKey(),
+	}
+
+	// the mapping covers the first two validators, by operator and consensus
+	// address respectively
+	pkJSON := func(pk cryptotypes.PubKey) string {
+		bz, err := encCfg.Codec.MarshalInterfaceJSON(pk)
+		require.NoError(t, err)
+		return string(bz)
+	}
+	mapping, err := genutil.DecodeConsensusKeyMapping(encCfg.Codec, []byte(fmt.Sprintf(`{%q: %s, %q: %s}`,
+		valAddrs[0].String(), pkJSON(newKeys[0]),
+		sdk.ConsAddress(pubKeys[1].Address()).String(), pkJSON(newKeys[1]),
+	)))
+	require.NoError(t, err)
+
+	t.Run("strict", func(t *testing.T) {
+		_, _, err := genutil.RotateConsensusKeys(encCfg.Codec, appGenesis, mapping, genutil.ConsensusKeyRotationOptions{})
+		require.ErrorContains(t, err, fmt.Sprintf("bonded validator %s with power 100 is not covered", valAddrs[2]))
+
+		// validators at or below the threshold may be left uncovered
+		rotated, report, err := genutil.RotateConsensusKeys(encCfg.Codec, appGenesis, mapping, genutil.ConsensusKeyRotationOptions{MinPower: 100})
+		require.NoError(t, err)
+		require.Len(t, rotated.Consensus.Validators, 3)
+		require.Equal(t, genutil.ConsensusKeyUncovered, report.Validators[2].Status)
+		require.Equal(t, int64(600), report.TotalPower)
+		require.Equal(t, int64(500), report.RotatedPower)
+		require.True(t, report.CanReachQuorum())
+	})
+
+	t.Run("zero uncovered", func(t *testing.T) {
+		rotated, report, err := genutil.RotateConsensusKeys(encCfg.Codec, appGenesis, mapping, genutil.ConsensusKeyRotationOptions{ZeroUncovered: true})
+		require.NoError(t, err)
+
+		require.Equal(t, []genutil.RotatedValidator{
+			{Operator: valAddrs[0].String(), ConsAddress: sdk.ConsAddress(newKeys[0].Address()).String(), Power: 300, Status: genutil.ConsensusKeyRotated},
+			{Operator: valAddrs[1].String(), ConsAddress: sdk.ConsAddress(newKeys[1].Address()).String(), Power: 200, Status: genutil.ConsensusKeyRotated},
+			{Operator: valAddrs[2].String(), ConsAddress: sdk.ConsAddress(pubKeys[2].Address()).String(), Power: 100, Status: genutil.ConsensusKeyZeroed},
+		}, report.Validators)
+		require.Equal(t, int64(500), report.TotalPower)
+		require.Equal(t, int64(500), report.RotatedPower)
+		require.Equal(t, int64(100), report.ZeroedPower)
+		require.True(t, report.CanReachQuorum())
+
+		// the consensus validator set only holds the new keys
+		require.Len(t, rotated.Consensus.Validators, 2)
+		for i, gv := range rotated.Consensus.Validators {
+			require.Equal(t, newKeys[i].Bytes(), gv.PubKey.Bytes())
+			require.Equal(t, newKeys[i].Address().Bytes(), gv.Address.Bytes())
+		}
+		require.Len(t, appGenesis.Consensus.Validators, 3)
+
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(rotated.AppState, &appState))
+		require.NoError(t, mbm.ValidateGenesis(encCfg.Codec, encCfg.TxConfig, appState))
+
+		var stakingGenesis stakingtypes.GenesisState
+		encCfg.Codec.MustUnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenesis)
+		for i, pk := range newKeys {
+			valPk, err := stakingGenesis.Validators[i].ConsPubKey()
+			require.NoError(t, err)
+			require.True(t, pk.Equals(valPk))
+		}
+		require.True(t, stakingGenesis.Validators[2].Jailed)
+		require.Equal(t, stakingtypes.Unbonded, stakingGenesis.Validators[2].Status)
+		require.Len(t, stakingGenesis.LastValidatorPowers, 2)
+		require.Equal(t, math.NewInt(500), stakingGenesis.LastTotalPower)
+
+		var bankGenesis banktypes.GenesisState
+		encCfg.Codec.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis)
+		balances := make(map[string]sdk.Coins)
+		for _, balance := range bankGenesis.Balances {
+			balances[balance.Address] = balance.Coins
+		}
+		require.Equal(t, map[string]sdk.Coins{
+			authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String():    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction))),
+			authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String(): sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))),
+		}, balances)
+
+		var slashingGenesis slashingtypes.GenesisState
+		encCfg.Codec.MustUnmarshalJSON(appState[slashingtypes.ModuleName], &slashingGenesis)
+		expAddrs := []string{
+			sdk.ConsAddress(newKeys[0].Address()).String(),
+			sdk.ConsAddress(newKeys[1].Address()).String(),
+			sdk.ConsAddress(pubKeys[2].Address()).String(),
+		}
+		for i, info := range slashingGenesis.SigningInfos {
+			require.Equal(t, expAddrs[i], info.Address)
+			require.Equal(t, expAddrs[i], info.ValidatorSigningInfo.Address)
+			require.Equal(t, expAddrs[i], slashingGenesis.MissedBlocks[i].Address)
+		}
+	})
+
+	t.Run("invalid mapping", func(t *testing.T) {
+		_, _, err := genutil.RotateConsensusKeys(encCfg.Codec, appGenesis, map[string]cryptotypes.PubKey{
+			valAddrs[0].String():                           newKeys[0],
+			sdk.ConsAddress(pubKeys[0].Address()).String(): newKeys[1],
+		}, genutil.ConsensusKeyRotationOptions{ZeroUncovered: true})
+		require.ErrorContains(t, err, "is mapped more than once")
+
+		_, _, err = genutil.RotateConsensusKeys(encCfg.Codec, appGenesis, map[string]cryptotypes.PubKey{
+			valAddrs[0].String(): pubKeys[1],
+		}, genutil.ConsensusKeyRotationOptions{ZeroUncovered: true})
+		require.ErrorContains(t, err, "is already used by validator")
+
+		_, _, err = genutil.RotateConsensusKeys(encCfg.Codec, appGenesis, map[string]cryptotypes.PubKey{
+			sdk.ValAddress("unknown").String(): newKeys[0],
+		}, genutil.ConsensusKeyRotationOptions{ZeroUncovered: true})
+		require.ErrorContains(t, err, "matches no validator")
+	})
+}
@@ -0,0 +1,248 @@
+package genutil
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// GenesisFixtureOverride sets a value in the genesis section of a module. Path
+// is a JSON pointer relative to the section, the value is added following the
+// RFC 6902 add semantics: an existing object member is replaced and a value is
+// inserted into an array.
+type GenesisFixtureOverride struct {
+	Module string          `json:"module"`
+	Path   string          `json:"path"`
+	Value  json.RawMessage `json:"value"`
+}
+
+// GenesisFixturePopulation appends Count randomly generated values to the array
+// at Path, a JSON pointer relative to the genesis section of a module. The
+// values are generated from Schema, which defaults to the items of the array in
+// the genesis schema of the module.
+type GenesisFixturePopulation struct {
+	Module string               `json:"module"`
+	Path   string               `json:"path"`
+	Count  int                  `json:"count"`
+	Schema *types.GenesisSchema `json:"schema,omitempty"`
+}
+
+// GenesisFixtureConfig describes a genesis fixture: the default genesis of the
+// app, populated with generated values and then overridden. The same config
+// always generates the same genesis.
+type GenesisFixtureConfig struct {
+	ChainID string `json:"chain_id"`
+	// GenesisTime defaults to the unix epoch, so that fixtures do not depend on
+	// the time they were generated at.
+	GenesisTime time.Time                  `json:"genesis_time"`
+	Seed        int64                      `json:"seed"`
+	Populations []GenesisFixturePopulation `json:"populations,omitempty"`
+	Overrides   []GenesisFixtureOverride   `json:"overrides,omitempty"`
+}
+
+// GenerateGenesisFixture generates a complete genesis from the default genesis
+// of the modules of the basic manager. The populations are applied first, in
+// order, followed by the overrides. The app_state of the generated genesis is
+// validated with the ValidateGenesis of every module.
+func GenerateGenesisFixture(
+	cdc codec.JSONCodec,
+	txEncCfg client.TxEncodingConfig,
+	mbm module.BasicManager,
+	cfg GenesisFixtureConfig,
+) (*types.AppGenesis, error) {
+	appStateBz, err := json.Marshal(mbm.DefaultGenesis(cdc))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal default genesis: %w", err)
+	}
+
+	schemas := GenesisSchemas(mbm)
+	r := rand.New(rand.NewSource(cfg.Seed))
+	for i, p := range cfg.Populations {
+		itemSchema := p.Schema
+		if itemSchema == nil {
+			itemSchema, err = arrayItemsSchema(schemas[p.Module], p.Path)
+			if err != nil {
+				return nil, fmt.Errorf("population %d of module %s: %w", i, p.Module, err)
+			}
+		}
+		if err := itemSchema.Validate(); err != nil {
+			return nil, fmt.Errorf("population %d of module %s: invalid schema: %w", i, p.Module, err)
+		}
+
+		patch := make(types.JSONPatch, 0, p.Count)
+		for range p.Count {
+			value, err := json.Marshal(generateFromSchema(r, itemSchema))
+			if err != nil {
+				return nil, err
+			}
+			patch = append(patch, types.JSONPatchOperation{
+				Op:    types.JSONPatchOpAdd,
+				Path:  "/" + escapeJSONPointer(p.Module) + p.Path + "/-",
+				Value: value,
+			})
+		}
+
+		if appStateBz, err = patch.Apply(appStateBz); err != nil {
+			return nil, fmt.Errorf("population %d of module %s: %w", i, p.Module, err)
+		}
+	}
+
+	for i, o := range cfg.Overrides {
+		patch := types.JSONPatch{{
+			Op:    types.JSONPatchOpAdd,
+			Path:  "/" + escapeJSONPointer(o.Module) + o.Path,
+			Value: o.Value,
+		}}
+		if appStateBz, err = patch.Apply(appStateBz); err != nil {
+			return nil, fmt.Errorf("override %d of module %s: %w", i, o.Module, err)
+		}
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appStateBz, &appState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal app_state: %w", err)
+	}
+
+	if err := mbm.ValidateGenesis(cdc, txEncCfg, appState); err != nil {
+		return nil, fmt.Errorf("generated genesis is invalid: %w", err)
+	}
+
+	// sort the keys of the app_state so that fixtures are stable
+	appStateBz, err = sdk.SortJSON(appStateBz)
+	if err != nil {
+		return nil, err
+	}
+
+	appGenesis := types.NewAppGenesisWithVersion(cfg.ChainID, appStateBz)
+	appGenesis.GenesisTime = cfg.GenesisTime
+	if appGenesis.GenesisTime.IsZero() {
+		appGenesis.GenesisTime = time.Unix(0, 0).UTC()
+	}
+
+	if err := appGenesis.ValidateAndComplete(); err != nil {
+		return nil, fmt.Errorf("generated genesis is invalid: %w", err)
+	}
+
+	return appGenesis, nil
+}
+
+// arrayItemsSchema returns the schema of the items of the array at path in a
+// module genesis schema.
+func arrayItemsSchema(s *types.GenesisSchema, path string) (*types.GenesisSchema, error) {
+	if s == nil {
+		return nil, errors.New("module has no genesis schema, a schema must be given")
+	}
+
+	tokens, err := types.ParseJSONPointer(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		switch {
+		case s.IsObject() && s.Properties[token] != nil:
+			s = s.Properties[token]
+		case s.IsArray():
+			s = s.Items
+		default:
+			return nil, fmt.Errorf("path %s is not part of the genesis schema", path)
+		}
+	}
+
+	if !s.IsArray() {
+		return nil, fmt.Errorf("path %s is not an array", path)
+	}
+
+	return s.Items, nil
+}
+
+// fixtureTimeBase is the earliest time generated for a time value.
+var fixtureTimeBase = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// generateFromSchema generates a random value described by the schema. Object
+// fields are generated in the order of their names and nested arrays get a
+// single element, so the value only depends on the state of r.
+func generateFromSchema(r *rand.Rand, s *types.GenesisSchema) any {
+	switch {
+	case s.IsObject():
+		names := make([]string, 0, len(s.Properties))
+		for name := range s.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		obj := make(map[string]any, len(names))
+		for _, name := range names {
+			obj[name] = generateFromSchema(r, s.Properties[name])
+		}
+		return obj
+
+	case s.IsArray():
+		return []any{generateFromSchema(r, s.Items)}
+	}
+
+	switch s.Kind {
+	case schema.BoolKind:
+		return r.Intn(2) == 1
+	case schema.Float32Kind, schema.Float64Kind:
+		return json.Number(strconv.FormatFloat(r.Float64(), 'f', -1, 64))
+	case schema.Int8Kind, schema.Uint8Kind:
+		return json.Number(strconv.Itoa(r.Intn(100) + 1))
+	case schema.Int16Kind, schema.Uint16Kind, schema.Int32Kind, schema.Uint32Kind:
+		return json.Number(strconv.Itoa(r.Intn(10_000) + 1))
+	case schema.Int64Kind, schema.Uint64Kind, schema.IntegerKind:
+		// 64 bit integers are encoded as strings by proto JSON
+		return strconv.FormatInt(r.Int63n(1_000_000_000)+1, 10)
+	case schema.DecimalKind:
+		return fmt.Sprintf("%d.%06d", r.Intn(1_000), r.Intn(1_000_000))
+	case schema.BytesKind:
+		return base64.StdEncoding.EncodeToString(randomFixtureBytes(r, 32))
+	case schema.AddressKind:
+		addr, err := bech32.ConvertAndEncode(sdk.GetConfig().GetBech32AccountAddrPrefix(), randomFixtureBytes(r, 20))
+		if err != nil {
+			panic(err) // cannot happen with a valid prefix and 20 bytes
+		}
+		return addr
+	case schema.TimeKind:
+		return fixtureTimeBase.Add(time.Duration(r.Int63n(365*24*60*60)) * time.Second).Format(time.RFC3339Nano)
+	case schema.DurationKind:
+		return (time.Duration(r.Int63n(30*24*60*60)+1) * time.Second).String()
+	case schema.EnumKind:
+		if len(s.EnumValues) == 0 {
+			return ""
+		}
+		return s.EnumValues[r.Intn(len(s.EnumValues))]
+	case schema.JSONKind:
+		return map[string]any{}
+	default:
+		// strings start with a letter, so that they are valid denoms as well
+		const letters = "abcdefghijklmnopqrstuvwxyz"
+		b := make([]byte, 8)
+		for i := range b {
+			b[i] = letters[r.Intn(len(letters))]
+		}
+		return string(b)
+	}
+}
+
+func randomFixtureBytes(r *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(r.Intn(256))
+	}
+	return b
+}
@@ -0,0 +1,116 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestGenerateGenesisFixture(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	mbm := module.NewBasicManager(bank.AppModuleBasic{})
+
+	balanceSchema := &types.GenesisSchema{
+		Properties: map[string]*types.GenesisSchema{
+			"address": {Kind: schema.AddressKind},
+			"coins": {Items: &types.GenesisSchema{
+				Properties: map[string]*types.GenesisSchema{
+					"denom":  {Kind: schema.EnumKind, EnumValues: []string{"stake"}},
+					"amount": {Kind: schema.IntegerKind},
+				},
+			}},
+		},
+	}
+	config := func(seed int64, overrides ...genutil.GenesisFixtureOverride) genutil.GenesisFixtureConfig {
+		return genutil.GenesisFixtureConfig{
+			ChainID: "fixture",
+			Seed:    seed,
+			Populations: []genutil.GenesisFixturePopulation{
+				{Module: banktypes.ModuleName, Path: "/balances", Count: 100, Schema: balanceSchema},
+			},
+			Overrides: overrides,
+		}
+	}
+	bankGenesis := func(t *testing.T, appGenesis *types.AppGenesis) banktypes.GenesisState {
+		t.Helper()
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+		var gs banktypes.GenesisState
+		encCfg.Codec.MustUnmarshalJSON(appState[banktypes.ModuleName], &gs)
+		return gs
+	}
+
+	t.Run("deterministic for a seed", func(t *testing.T) {
+		first, err := genutil.GenerateGenesisFixture(encCfg.Codec, encCfg.TxConfig, mbm, config(1))
+		require.NoError(t, err)
+		second, err := genutil.GenerateGenesisFixture(encCfg.Codec, encCfg.TxConfig, mbm, config(1))
+		require.NoError(t, err)
+		other, err := genutil.GenerateGenesisFixture(encCfg.Codec, encCfg.TxConfig, mbm, config(2))
+		require.NoError(t, err)
+
+		firstBz, err := json.Marshal(first)
+		require.NoError(t, err)
+		secondBz, err := json.Marshal(second)
+		require.NoError(t, err)
+		otherBz, err := json.Marshal(other)
+		require.NoError(t, err)
+		require.Equal(t, string(firstBz), string(secondBz))
+		require.NotEqual(t, string(firstBz), string(otherBz))
+
+		gs := bankGenesis(t, first)
+		require.Len(t, gs.Balances, 100)
+		for _, balance := range gs.Balances {
+			require.Len(t, balance.Coins, 1)
+			require.True(t, balance.Coins[0].IsPositive())
+		}
+		require.False(t, first.GenesisTime.IsZero())
+	})
+
+	t.Run("overrides at nested paths", func(t *testing.T) {
+		appGenesis, err := genutil.GenerateGenesisFixture(encCfg.Codec, encCfg.TxConfig, mbm, config(1,
+			genutil.GenesisFixtureOverride{Module: banktypes.ModuleName, Path: "/params/default_send_enabled", Value: json.RawMessage(`false`)},
+			genutil.GenesisFixtureOverride{Module: banktypes.ModuleName, Path: "/balances/0/coins/0/amount", Value: json.RawMessage(`"42"`)},
+		))
+		require.NoError(t, err)
+
+		gs := bankGenesis(t, appGenesis)
+		require.False(t, gs.Params.DefaultSendEnabled)
+		require.Equal(t, "42", gs.Balances[0].Coins[0].Amount.String())
+	})
+
+	t.Run("overrides breaking the validation are rejected", func(t *testing.T) {
+		// the supply does not match the populated balances
+		_, err := genutil.GenerateGenesisFixture(encCfg.Codec, encCfg.TxConfig, mbm, config(1,
+			genutil.GenesisFixtureOverride{Module: banktypes.ModuleName, Path: "/supply", Value: json.RawMessage(`[{"denom":"stake","amount":"1"}]`)},
+		))
+		require.ErrorContains(t, err, "generated genesis is invalid")
+
+		_, err = genutil.GenerateGenesisFixture(encCfg.Codec, encCfg.TxConfig, mbm, config(1,
+			genutil.GenesisFixtureOverride{Module: banktypes.ModuleName, Path: "/balances/0/coins/0/amount", Value: json.RawMessage(`"-1"`)},
+		))
+		require.ErrorContains(t, err, "generated genesis is invalid")
+	})
+
+	t.Run("invalid directives", func(t *testing.T) {
+		_, err := genutil.GenerateGenesisFixture(encCfg.Codec, encCfg.TxConfig, mbm, config(1,
+			genutil.GenesisFixtureOverride{Module: banktypes.ModuleName, Path: "/does/not/exist", Value: json.RawMessage(`1`)},
+		))
+		require.ErrorContains(t, err, "override 0 of module bank")
+
+		// the bank module has no genesis schema to generate balances from
+		cfg := config(1)
+		cfg.Populations[0].Schema = nil
+		_, err = genutil.GenerateGenesisFixture(encCfg.Codec, encCfg.TxConfig, mbm, cfg)
+		require.ErrorContains(t, err, "module has no genesis schema")
+	})
+}
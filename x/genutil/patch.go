@@ -0,0 +1,179 @@
+package genutil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const appStatePathPrefix = "/app_state"
+
+// ModuleGenesisChange describes how the genesis state of a single module was
+// changed by a set of patches. Hashes are the hex encoded sha256 of the sorted
+// JSON state, so they do not depend on the formatting of the genesis file.
+type ModuleGenesisChange struct {
+	Module     string `json:"module"`
+	BeforeHash string `json:"before_hash"`
+	AfterHash  string `json:"after_hash"`
+}
+
+// PatchAppGenesis applies the given RFC 6902 JSON patch documents, in order, to
+// the app genesis. Patches are applied with all-or-nothing semantics: if any
+// patch fails to apply, or the patched genesis fails validation, an error is
+// returned and the given genesis is left untouched.
+//
+// Every module whose app_state section is touched by a patch is validated with
+// its own ValidateGenesis. If a patch touches anything outside of app_state
+// (consensus or top-level metadata) the patched genesis is validated against
+// the AppGenesis rules.
+//
+// The returned changes contain the before and after hash of each touched module.
+func PatchAppGenesis(
+	cdc codec.JSONCodec,
+	txEncCfg client.TxEncodingConfig,
+	mbm module.BasicManager,
+	appGenesis *types.AppGenesis,
+	patches ...types.JSONPatch,
+) (*types.AppGenesis, []ModuleGenesisChange, error) {
+	genesisBz, err := json.Marshal(appGenesis)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal genesis: %w", err)
+	}
+
+	var (
+		touchedModules  = make(map[string]struct{})
+		touchedMetadata bool
+	)
+	for i, patch := range patches {
+		genesisBz, err = patch.Apply(genesisBz)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to apply patch %d: %w", i, err)
+		}
+
+		for _, path := range patch.Paths() {
+			moduleName, ok := moduleFromPatchPath(path)
+			switch {
+			case !ok:
+				touchedMetadata = true
+			case moduleName == "":
+				// the whole app_state is replaced, every module may have changed
+				for name := range mbm {
+					touchedModules[name] = struct{}{}
+				}
+			default:
+				touchedModules[moduleName] = struct{}{}
+			}
+		}
+	}
+
+	var patched types.AppGenesis
+	if err := json.Unmarshal(genesisBz, &patched); err != nil {
+		return nil, nil, fmt.Errorf("patched genesis is not a valid app genesis: %w", err)
+	}
+
+	if touchedMetadata {
+		if err := patched.ValidateAndComplete(); err != nil {
+			return nil, nil, fmt.Errorf("patched genesis is invalid: %w", err)
+		}
+	}
+
+	before, err := appStateFromGenesis(appGenesis)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	after, err := appStateFromGenesis(&patched)
+	if err != nil {
+		return nil, nil, fmt.Errorf("patched app_state is invalid: %w", err)
+	}
+
+	moduleNames := make([]string, 0, len(touchedModules))
+	for name := range touchedModules {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	changes := make([]ModuleGenesisChange, 0, len(moduleNames))
+	for _, name := range moduleNames {
+		if mod, ok := mbm[name].(module.HasGenesisBasics); ok {
+			if err := mod.ValidateGenesis(cdc, txEncCfg, after[name]); err != nil {
+				return nil, nil, fmt.Errorf("patched genesis of module %s is invalid: %w", name, err)
+			}
+		}
+
+		beforeHash, err := hashModuleGenesis(before[name])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		afterHash, err := hashModuleGenesis(after[name])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if beforeHash == afterHash {
+			continue
+		}
+
+		changes = append(changes, ModuleGenesisChange{
+			Module:     name,
+			BeforeHash: beforeHash,
+			AfterHash:  afterHash,
+		})
+	}
+
+	return &patched, changes, nil
+}
+
+// moduleFromPatchPath returns the module name targeted by a patch path. The
+// boolean is false if the path is outside of app_state. An empty module name
+// means the whole app_state is targeted.
+func moduleFromPatchPath(path string) (string, bool) {
+	if path == appStatePathPrefix {
+		return "", true
+	}
+
+	rest, ok := strings.CutPrefix(path, appStatePathPrefix+"/")
+	if !ok {
+		return "", false
+	}
+
+	moduleName, _, _ := strings.Cut(rest, "/")
+	return strings.ReplaceAll(strings.ReplaceAll(moduleName, "~1", "/"), "~0", "~"), true
+}
+
+func appStateFromGenesis(appGenesis *types.AppGenesis) (map[string]json.RawMessage, error) {
+	appState := make(map[string]json.RawMessage)
+	if len(appGenesis.AppState) == 0 {
+		return appState, nil
+	}
+
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal app_state: %w", err)
+	}
+
+	return appState, nil
+}
+
+func hashModuleGenesis(bz json.RawMessage) (string, error) {
+	if len(bz) == 0 {
+		return "", nil
+	}
+
+	sorted, err := sdk.SortJSON(bz)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(sorted)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,82 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func mustDecodePatch(t *testing.T, patch string) types.JSONPatch {
+	t.Helper()
+	p, err := types.DecodeJSONPatch([]byte(patch))
+	require.NoError(t, err)
+	return p
+}
+
+func TestPatchAppGenesis(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	mbm := module.NewBasicManager(bank.AppModuleBasic{})
+
+	appGenesis, err := types.AppGenesisFromFile("types/testdata/app_genesis.json")
+	require.NoError(t, err)
+
+	t.Run("invalid bank genesis is rejected", func(t *testing.T) {
+		// raise a balance without updating the supply
+		patch := mustDecodePatch(t, `[
+			{"op": "replace", "path": "/app_state/bank/balances/1/coins/0/amount", "value": "2000"}
+		]`)
+
+		_, _, err := genutil.PatchAppGenesis(encCfg.Codec, encCfg.TxConfig, mbm, appGenesis, patch)
+		require.ErrorContains(t, err, "patched genesis of module bank is invalid")
+		require.ErrorContains(t, err, "genesis supply is incorrect")
+	})
+
+	t.Run("failing patch rejects all patches", func(t *testing.T) {
+		first := mustDecodePatch(t, `[{"op": "replace", "path": "/chain_id", "value": "patched"}]`)
+		second := mustDecodePatch(t, `[{"op": "remove", "path": "/app_state/bank/does_not_exist"}]`)
+
+		_, _, err := genutil.PatchAppGenesis(encCfg.Codec, encCfg.TxConfig, mbm, appGenesis, first, second)
+		require.ErrorContains(t, err, "failed to apply patch 1")
+		require.Equal(t, "demo", appGenesis.ChainID)
+	})
+
+	t.Run("invalid metadata is rejected", func(t *testing.T) {
+		patch := mustDecodePatch(t, `[{"op": "replace", "path": "/chain_id", "value": ""}]`)
+
+		_, _, err := genutil.PatchAppGenesis(encCfg.Codec, encCfg.TxConfig, mbm, appGenesis, patch)
+		require.ErrorContains(t, err, "non-empty chain_id")
+	})
+
+	t.Run("multiple patches", func(t *testing.T) {
+		balance := mustDecodePatch(t, `[
+			{"op": "test", "path": "/app_state/bank/balances/1/coins/0/amount", "value": "1000"},
+			{"op": "replace", "path": "/app_state/bank/balances/1/coins/0/amount", "value": "2000"}
+		]`)
+		supply := mustDecodePatch(t, `[
+			{"op": "replace", "path": "/app_state/bank/supply/0/amount", "value": "10000010635"}
+		]`)
+		chainID := mustDecodePatch(t, `[{"op": "replace", "path": "/chain_id", "value": "patched"}]`)
+
+		patched, changes, err := genutil.PatchAppGenesis(encCfg.Codec, encCfg.TxConfig, mbm, appGenesis, balance, supply, chainID)
+		require.NoError(t, err)
+		require.Equal(t, "patched", patched.ChainID)
+		require.Equal(t, "demo", appGenesis.ChainID)
+
+		require.Len(t, changes, 1)
+		require.Equal(t, "bank", changes[0].Module)
+		require.NotEmpty(t, changes[0].BeforeHash)
+		require.NotEqual(t, changes[0].BeforeHash, changes[0].AfterHash)
+
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(patched.AppState, &appState))
+		require.Contains(t, string(appState["bank"]), `"10000010635"`)
+		require.NoError(t, mbm.ValidateGenesis(encCfg.Codec, encCfg.TxConfig, appState))
+	})
+}
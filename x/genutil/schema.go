@@ -0,0 +1,254 @@
+package genutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// SchemaViolation is a structural problem found in the genesis section of a
+// module. Path is the JSON pointer of the offending value in the genesis.
+type SchemaViolation struct {
+	Module  string `json:"module"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (v SchemaViolation) String() string {
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// SchemaValidationReport is the result of the structural validation of the
+// app_state of a genesis.
+type SchemaValidationReport struct {
+	// Violations are the problems found, in the order of the genesis file.
+	Violations []SchemaViolation
+	// Skipped are the modules present in the app_state without a schema.
+	Skipped []string
+}
+
+// Err returns an error listing all the violations, or nil if there are none.
+func (r SchemaValidationReport) Err() error {
+	if len(r.Violations) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, len(r.Violations))
+	for i, v := range r.Violations {
+		msgs[i] = v.String()
+	}
+
+	return fmt.Errorf("app_state does not match the module genesis schemas:\n%s", strings.Join(msgs, "\n"))
+}
+
+// GenesisSchemas returns the genesis schemas of the modules of the basic
+// manager implementing types.HasGenesisSchema.
+func GenesisSchemas(mbm module.BasicManager) map[string]*types.GenesisSchema {
+	schemas := make(map[string]*types.GenesisSchema)
+	for name, mod := range mbm {
+		if m, ok := mod.(types.HasGenesisSchema); ok {
+			if s := m.GenesisSchema(); s != nil {
+				schemas[name] = s
+			}
+		}
+	}
+
+	return schemas
+}
+
+// ValidateAppStateSchemas streams the app_state JSON object read from r and
+// structurally validates the section of every module with a schema: required
+// fields must be present, values must be of the expected kind and enum values
+// must be legal. Sections are never decoded in full, so this is much cheaper
+// than the per module ValidateGenesis and all the problems of all the modules
+// are reported at once.
+//
+// Modules without a schema are skipped and listed in the report. An error is
+// only returned if a schema is invalid or app_state is not valid JSON.
+func ValidateAppStateSchemas(r io.Reader, schemas map[string]*types.GenesisSchema) (SchemaValidationReport, error) {
+	var report SchemaValidationReport
+	for name, s := range schemas {
+		if err := s.Validate(); err != nil {
+			return report, fmt.Errorf("invalid genesis schema of module %s: %w", name, err)
+		}
+	}
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return report, fmt.Errorf("failed to read app_state: %w", err)
+	}
+	if tok != json.Delim('{') {
+		return report, errors.New("app_state must be a JSON object")
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return report, fmt.Errorf("failed to read app_state: %w", err)
+		}
+		moduleName := tok.(string)
+
+		v := &schemaValidator{dec: dec, module: moduleName, report: &report}
+		if _, err := v.validate(schemas[moduleName], appStatePathPrefix+"/"+escapeJSONPointer(moduleName)); err != nil {
+			return report, fmt.Errorf("failed to read app_state: %w", err)
+		}
+
+		if schemas[moduleName] == nil {
+			report.Skipped = append(report.Skipped, moduleName)
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return report, fmt.Errorf("failed to read app_state: %w", err)
+	}
+
+	sort.Strings(report.Skipped)
+	return report, nil
+}
+
+type schemaValidator struct {
+	dec    *json.Decoder
+	module string
+	report *SchemaValidationReport
+}
+
+func (v *schemaValidator) violation(path, format string, args ...any) {
+	v.report.Violations = append(v.report.Violations, SchemaViolation{
+		Module:  v.module,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// validate reads the next value from the decoder and validates it against the
+// schema, returning whether the value was null. A nil schema accepts any value.
+func (v *schemaValidator) validate(s *types.GenesisSchema, path string) (bool, error) {
+	tok, err := v.dec.Token()
+	if err != nil {
+		return false, err
+	}
+
+	if tok == nil {
+		return true, nil
+	}
+
+	if s == nil {
+		return false, v.skip(tok)
+	}
+
+	switch {
+	case s.IsObject():
+		if tok != json.Delim('{') {
+			v.violation(path, "expected object, got %s", describeToken(tok))
+			return false, v.skip(tok)
+		}
+		return false, v.validateObject(s, path)
+
+	case s.IsArray():
+		if tok != json.Delim('[') {
+			v.violation(path, "expected array, got %s", describeToken(tok))
+			return false, v.skip(tok)
+		}
+		for i := 0; v.dec.More(); i++ {
+			if _, err := v.validate(s.Items, path+"/"+strconv.Itoa(i)); err != nil {
+				return false, err
+			}
+		}
+		_, err := v.dec.Token()
+		return false, err
+
+	default:
+		if _, ok := tok.(json.Delim); ok && s.Kind != schema.JSONKind {
+			v.violation(path, "expected %s, got %s", s.Kind, describeToken(tok))
+		} else if err := s.ValidateScalar(tok); err != nil {
+			v.violation(path, "%s", err)
+		}
+		return false, v.skip(tok)
+	}
+}
+
+func (v *schemaValidator) validateObject(s *types.GenesisSchema, path string) error {
+	present := make(map[string]bool)
+	for v.dec.More() {
+		tok, err := v.dec.Token()
+		if err != nil {
+			return err
+		}
+		field := tok.(string)
+
+		isNull, err := v.validate(s.Properties[field], path+"/"+escapeJSONPointer(field))
+		if err != nil {
+			return err
+		}
+		// null counts as an absent field
+		present[field] = !isNull
+	}
+
+	if _, err := v.dec.Token(); err != nil {
+		return err
+	}
+
+	for _, field := range s.Required {
+		if !present[field] {
+			v.violation(path, "missing required field %q", field)
+		}
+	}
+
+	return nil
+}
+
+// skip consumes the rest of the value started by tok.
+func (v *schemaValidator) skip(tok json.Token) error {
+	if tok != json.Delim('{') && tok != json.Delim('[') {
+		return nil
+	}
+
+	for depth := 1; depth > 0; {
+		tok, err := v.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+
+	return nil
+}
+
+func describeToken(tok json.Token) string {
+	switch tok.(type) {
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "bool"
+	case json.Delim:
+		if tok == json.Delim('{') {
+			return "object"
+		}
+		return "array"
+	default:
+		return "null"
+	}
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,113 @@
+package genutil_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func loadGenesisSchemas(t *testing.T) map[string]*types.GenesisSchema {
+	t.Helper()
+	bz, err := os.ReadFile("types/testdata/genesis_schemas.json")
+	require.NoError(t, err)
+
+	var schemas map[string]*types.GenesisSchema
+	require.NoError(t, json.Unmarshal(bz, &schemas))
+	return schemas
+}
+
+func TestValidateAppStateSchemas(t *testing.T) {
+	schemas := loadGenesisSchemas(t)
+
+	t.Run("valid app state", func(t *testing.T) {
+		appGenesis, err := types.AppGenesisFromFile("types/testdata/app_genesis.json")
+		require.NoError(t, err)
+
+		report, err := genutil.ValidateAppStateSchemas(bytes.NewReader(appGenesis.AppState), schemas)
+		require.NoError(t, err)
+		require.Empty(t, report.Violations)
+		require.NoError(t, report.Err())
+		require.NotContains(t, report.Skipped, "bank")
+		require.NotContains(t, report.Skipped, "staking")
+		require.Contains(t, report.Skipped, "auth")
+	})
+
+	t.Run("errors of all modules are reported at once", func(t *testing.T) {
+		bz, err := os.ReadFile("types/testdata/app_state_schema_errors.json")
+		require.NoError(t, err)
+
+		report, err := genutil.ValidateAppStateSchemas(bytes.NewReader(bz), schemas)
+		require.NoError(t, err)
+
+		type violation struct{ module, path, msg string }
+		var got []violation
+		for _, v := range report.Violations {
+			got = append(got, violation{v.Module, v.Path, v.Message})
+		}
+
+		expected := []violation{
+			{"bank", "/app_state/bank/params/default_send_enabled", "expected bool, got string"},
+			{"bank", "/app_state/bank/balances/1/coins/0/amount", `invalid integer "1e3"`},
+			{"bank", "/app_state/bank", `missing required field "supply"`},
+			{"staking", "/app_state/staking/params/unbonding_time", `invalid duration "3 weeks"`},
+			{"staking", "/app_state/staking/params", `missing required field "bond_denom"`},
+			{"staking", "/app_state/staking/validators/0/status", `illegal enum value "BOND_STATUS_ACTIVE"`},
+		}
+		require.Len(t, got, len(expected), report.Violations)
+		for i, exp := range expected {
+			require.Equal(t, exp.module, got[i].module)
+			require.Equal(t, exp.path, got[i].path)
+			require.True(t, strings.HasPrefix(got[i].msg, exp.msg), got[i].msg)
+		}
+
+		require.Equal(t, []string{"auth", "upgrade"}, report.Skipped)
+
+		err = report.Err()
+		require.ErrorContains(t, err, "/app_state/bank/params/default_send_enabled")
+		require.ErrorContains(t, err, "/app_state/staking/validators/0/status")
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := genutil.ValidateAppStateSchemas(strings.NewReader(`{"bank": {"params": }`), schemas)
+		require.ErrorContains(t, err, "failed to read app_state")
+
+		_, err = genutil.ValidateAppStateSchemas(strings.NewReader(`[]`), schemas)
+		require.ErrorContains(t, err, "app_state must be a JSON object")
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		_, err := genutil.ValidateAppStateSchemas(strings.NewReader(`{}`), map[string]*types.GenesisSchema{
+			"bank": {Kind: schema.StringKind, Items: &types.GenesisSchema{Kind: schema.StringKind}},
+		})
+		require.ErrorContains(t, err, "invalid genesis schema of module bank")
+	})
+}
+
+type schemaModule struct {
+	module.AppModuleBasic
+	schema *types.GenesisSchema
+}
+
+func (schemaModule) Name() string { return "bank" }
+
+func (m schemaModule) GenesisSchema() *types.GenesisSchema { return m.schema }
+
+func TestGenesisSchemas(t *testing.T) {
+	s := &types.GenesisSchema{Required: []string{"params"}}
+	mbm := module.BasicManager{
+		"bank":    schemaModule{schema: s},
+		"staking": schemaModule{},
+	}
+
+	require.Equal(t, map[string]*types.GenesisSchema{"bank": s}, genutil.GenesisSchemas(mbm))
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// HasGenesisSchema is implemented by the modules exposing the structure of
+// their JSON genesis section, so that it can be validated without decoding it.
+type HasGenesisSchema interface {
+	GenesisSchema() *GenesisSchema
+}
+
+// GenesisSchema describes the structure of a JSON genesis value. A schema with
+// properties or required fields describes an object, a schema with items
+// describes an array and any other schema describes a scalar of the given kind.
+//
+// Object fields which are not part of the properties are accepted, and null is
+// accepted for any value and counts as an absent field.
+type GenesisSchema struct {
+	// Kind is the kind of a scalar value. Integer kinds accept both JSON
+	// numbers and strings, as the proto JSON encoding of 64 bit integers is a
+	// string.
+	Kind schema.Kind `json:"kind,omitempty"`
+	// EnumValues are the legal values of an enum kind value.
+	EnumValues []string `json:"enum_values,omitempty"`
+	// Properties are the schemas of the fields of an object.
+	Properties map[string]*GenesisSchema `json:"properties,omitempty"`
+	// Required are the fields which must be present in an object.
+	Required []string `json:"required,omitempty"`
+	// Items is the schema of the elements of an array.
+	Items *GenesisSchema `json:"items,omitempty"`
+}
+
+// IsObject returns true if the schema describes a JSON object.
+func (s *GenesisSchema) IsObject() bool {
+	return s.Properties != nil || len(s.Required) > 0
+}
+
+// IsArray returns true if the schema describes a JSON array.
+func (s *GenesisSchema) IsArray() bool {
+	return s.Items != nil
+}
+
+// Validate checks that the schema is well formed.
+func (s *GenesisSchema) Validate() error {
+	switch {
+	case s.IsObject() && s.IsArray():
+		return errors.New("schema cannot describe both an object and an array")
+
+	case s.IsObject():
+		if s.Kind != schema.InvalidKind || len(s.EnumValues) > 0 {
+			return errors.New("object schema cannot have a kind")
+		}
+		for name, prop := range s.Properties {
+			if prop == nil {
+				return fmt.Errorf("property %s: missing schema", name)
+			}
+			if err := prop.Validate(); err != nil {
+				return fmt.Errorf("property %s: %w", name, err)
+			}
+		}
+
+	case s.IsArray():
+		if s.Kind != schema.InvalidKind || len(s.EnumValues) > 0 {
+			return errors.New("array schema cannot have a kind")
+		}
+		if err := s.Items.Validate(); err != nil {
+			return fmt.Errorf("items: %w", err)
+		}
+
+	default:
+		if err := s.Kind.Validate(); err != nil {
+			return err
+		}
+		if len(s.EnumValues) > 0 && s.Kind != schema.EnumKind {
+			return fmt.Errorf("enum values are only allowed for the %s kind", schema.EnumKind)
+		}
+	}
+
+	return nil
+}
+
+// ValidateScalar checks that a scalar JSON value, as returned by a json.Decoder
+// with UseNumber, is of the schema kind.
+func (s *GenesisSchema) ValidateScalar(value any) error {
+	switch s.Kind {
+	case schema.JSONKind:
+		return nil
+
+	case schema.BoolKind:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("expected bool, got %s", describeJSONValue(value))
+		}
+		return nil
+
+	case schema.Float32Kind, schema.Float64Kind:
+		num, ok := value.(json.Number)
+		if !ok {
+			return fmt.Errorf("expected number, got %s", describeJSONValue(value))
+		}
+		bitSize := 64
+		if s.Kind == schema.Float32Kind {
+			bitSize = 32
+		}
+		if _, err := strconv.ParseFloat(num.String(), bitSize); err != nil {
+			return fmt.Errorf("invalid %s %s", s.Kind, num)
+		}
+		return nil
+	}
+
+	// all the other kinds are encoded as strings, integers may also be numbers
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case json.Number:
+		if !isIntegerKind(s.Kind) {
+			return fmt.Errorf("expected string, got %s", describeJSONValue(value))
+		}
+		str = v.String()
+	default:
+		return fmt.Errorf("expected string, got %s", describeJSONValue(value))
+	}
+
+	var err error
+	switch s.Kind {
+	case schema.Int8Kind, schema.Int16Kind, schema.Int32Kind, schema.Int64Kind:
+		_, err = strconv.ParseInt(str, 10, integerBitSize(s.Kind))
+	case schema.Uint8Kind, schema.Uint16Kind, schema.Uint32Kind, schema.Uint64Kind:
+		_, err = strconv.ParseUint(str, 10, integerBitSize(s.Kind))
+	case schema.IntegerKind, schema.DecimalKind, schema.StringKind:
+		err = s.Kind.ValidateValue(str)
+	case schema.BytesKind:
+		_, err = base64.StdEncoding.DecodeString(str)
+	case schema.AddressKind:
+		_, _, err = bech32.DecodeAndConvert(str)
+	case schema.TimeKind:
+		_, err = time.Parse(time.RFC3339Nano, str)
+	case schema.DurationKind:
+		_, err = time.ParseDuration(str)
+	case schema.EnumKind:
+		if !slices.Contains(s.EnumValues, str) {
+			return fmt.Errorf("illegal enum value %q, expected one of %v", str, s.EnumValues)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %w", s.Kind, str, err)
+	}
+
+	return nil
+}
+
+func isIntegerKind(kind schema.Kind) bool {
+	return integerBitSize(kind) > 0 || kind == schema.IntegerKind
+}
+
+func integerBitSize(kind schema.Kind) int {
+	switch kind {
+	case schema.Int8Kind, schema.Uint8Kind:
+		return 8
+	case schema.Int16Kind, schema.Uint16Kind:
+		return 16
+	case schema.Int32Kind, schema.Uint32Kind:
+		return 32
+	case schema.Int64Kind, schema.Uint64Kind:
+		return 64
+	default:
+		return 0
+	}
+}
+
+func describeJSONValue(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "bool"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
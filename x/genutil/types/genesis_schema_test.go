@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestGenesisSchemaValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		schema types.GenesisSchema
+		expErr string
+	}{
+		{"valid scalar", types.GenesisSchema{Kind: schema.DecimalKind}, ""},
+		{"valid enum", types.GenesisSchema{Kind: schema.EnumKind, EnumValues: []string{"A", "B"}}, ""},
+		{"valid object", types.GenesisSchema{Properties: map[string]*types.GenesisSchema{"a": {Kind: schema.StringKind}}, Required: []string{"a"}}, ""},
+		{"valid array", types.GenesisSchema{Items: &types.GenesisSchema{Kind: schema.StringKind}}, ""},
+		{"missing kind", types.GenesisSchema{}, "unknown type"},
+		{"object and array", types.GenesisSchema{Required: []string{"a"}, Items: &types.GenesisSchema{Kind: schema.StringKind}}, "both an object and an array"},
+		{"object with kind", types.GenesisSchema{Kind: schema.StringKind, Required: []string{"a"}}, "object schema cannot have a kind"},
+		{"invalid property", types.GenesisSchema{Properties: map[string]*types.GenesisSchema{"a": {}}}, "property a"},
+		{"nil property", types.GenesisSchema{Properties: map[string]*types.GenesisSchema{"a": nil}}, "property a: missing schema"},
+		{"invalid items", types.GenesisSchema{Items: &types.GenesisSchema{}}, "items"},
+		{"enum values without enum kind", types.GenesisSchema{Kind: schema.StringKind, EnumValues: []string{"A"}}, "enum values are only allowed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schema.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestGenesisSchemaValidateScalar(t *testing.T) {
+	testCases := []struct {
+		kind  schema.Kind
+		value any
+		valid bool
+	}{
+		{schema.StringKind, "foo", true},
+		{schema.StringKind, json.Number("1"), false},
+		{schema.BoolKind, true, true},
+		{schema.BoolKind, "true", false},
+		{schema.Int64Kind, "-42", true},
+		{schema.Int64Kind, json.Number("42"), true},
+		{schema.Int64Kind, "4.2", false},
+		{schema.Uint8Kind, "256", false},
+		{schema.Uint64Kind, "-1", false},
+		{schema.IntegerKind, "123456789012345678901234567890", true},
+		{schema.IntegerKind, "1e3", false},
+		{schema.DecimalKind, "0.020000000000000000", true},
+		{schema.DecimalKind, json.Number("0.02"), false},
+		{schema.DecimalKind, "two", false},
+		{schema.Float64Kind, json.Number("0.5"), true},
+		{schema.Float64Kind, "0.5", false},
+		{schema.BytesKind, "tMZonPQYoooG/xbFVhHg95pTLxx7aO43/qgHFxDagWM=", true},
+		{schema.BytesKind, "not base64!", false},
+		{schema.AddressKind, "cosmos1qmkksxlxqdslq6kkca25m4jn344nx29lytq8f9", true},
+		{schema.AddressKind, "cosmos1invalid", false},
+		{schema.TimeKind, "2023-02-20T11:08:30.588307671Z", true},
+		{schema.TimeKind, "yesterday", false},
+		{schema.DurationKind, "172800s", true},
+		{schema.DurationKind, "172800", false},
+		{schema.JSONKind, json.Number("1"), true},
+	}
+
+	for _, tc := range testCases {
+		err := (&types.GenesisSchema{Kind: tc.kind}).ValidateScalar(tc.value)
+		if tc.valid {
+			require.NoError(t, err, "%s %v", tc.kind, tc.value)
+		} else {
+			require.Error(t, err, "%s %v", tc.kind, tc.value)
+		}
+	}
+
+	enum := &types.GenesisSchema{Kind: schema.EnumKind, EnumValues: []string{"A", "B"}}
+	require.NoError(t, enum.ValidateScalar("A"))
+	require.ErrorContains(t, enum.ValidateScalar("C"), `illegal enum value "C"`)
+}
@@ -0,0 +1,338 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSON patch operation names as defined by RFC 6902.
+const (
+	JSONPatchOpAdd     = "add"
+	JSONPatchOpRemove  = "remove"
+	JSONPatchOpReplace = "replace"
+	JSONPatchOpMove    = "move"
+	JSONPatchOpCopy    = "copy"
+	JSONPatchOpTest    = "test"
+)
+
+// JSONPatchOperation is a single operation of an RFC 6902 JSON patch document.
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch is an RFC 6902 JSON patch document: an ordered list of operations.
+type JSONPatch []JSONPatchOperation
+
+// DecodeJSONPatch decodes and sanity checks an RFC 6902 JSON patch document.
+func DecodeJSONPatch(bz []byte) (JSONPatch, error) {
+	var patch JSONPatch
+	if err := json.Unmarshal(bz, &patch); err != nil {
+		return nil, fmt.Errorf("failed to decode json patch: %w", err)
+	}
+
+	for i, op := range patch {
+		if err := op.validate(); err != nil {
+			return nil, fmt.Errorf("invalid json patch operation %d: %w", i, err)
+		}
+	}
+
+	return patch, nil
+}
+
+func (op JSONPatchOperation) validate() error {
+	if _, err := ParseJSONPointer(op.Path); err != nil {
+		return err
+	}
+
+	switch op.Op {
+	case JSONPatchOpAdd, JSONPatchOpReplace, JSONPatchOpTest:
+		if len(op.Value) == 0 {
+			return fmt.Errorf("%s operation requires a value", op.Op)
+		}
+	case JSONPatchOpMove, JSONPatchOpCopy:
+		if _, err := ParseJSONPointer(op.From); err != nil {
+			return fmt.Errorf("invalid from: %w", err)
+		}
+		if op.Op == JSONPatchOpMove && strings.HasPrefix(op.Path+"/", op.From+"/") && op.Path != op.From {
+			return errors.New("cannot move a value into one of its children")
+		}
+	case JSONPatchOpRemove:
+	default:
+		return fmt.Errorf("unknown operation %q", op.Op)
+	}
+
+	return nil
+}
+
+// Paths returns the target paths of all operations of the patch, including
+// the source paths of move operations since those are modified as well.
+func (p JSONPatch) Paths() []string {
+	paths := make([]string, 0, len(p))
+	for _, op := range p {
+		paths = append(paths, op.Path)
+		if op.Op == JSONPatchOpMove {
+			paths = append(paths, op.From)
+		}
+	}
+	return paths
+}
+
+// Apply applies the patch to the given JSON document and returns the patched
+// document. The input document is never modified: if any operation fails the
+// whole patch is rejected.
+func (p JSONPatch) Apply(doc []byte) ([]byte, error) {
+	root, err := decodeJSONValue(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode json document: %w", err)
+	}
+
+	for i, op := range p {
+		root, err = op.apply(root)
+		if err != nil {
+			return nil, fmt.Errorf("json patch operation %d (%s %s) failed: %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+func (op JSONPatchOperation) apply(root any) (any, error) {
+	path, err := ParseJSONPointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case JSONPatchOpAdd:
+		value, err := decodeJSONValue(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		return jsonPointerAdd(root, path, value)
+
+	case JSONPatchOpRemove:
+		root, _, err = jsonPointerRemove(root, path)
+		return root, err
+
+	case JSONPatchOpReplace:
+		value, err := decodeJSONValue(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		if root, _, err = jsonPointerRemove(root, path); err != nil {
+			return nil, err
+		}
+		return jsonPointerAdd(root, path, value)
+
+	case JSONPatchOpMove:
+		from, _ := ParseJSONPointer(op.From)
+		root, value, err := jsonPointerRemove(root, from)
+		if err != nil {
+			return nil, err
+		}
+		return jsonPointerAdd(root, path, value)
+
+	case JSONPatchOpCopy:
+		from, _ := ParseJSONPointer(op.From)
+		value, err := jsonPointerGet(root, from)
+		if err != nil {
+			return nil, err
+		}
+		// deep copy the value so that later operations do not alias it
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if value, err = decodeJSONValue(bz); err != nil {
+			return nil, err
+		}
+		return jsonPointerAdd(root, path, value)
+
+	case JSONPatchOpTest:
+		expected, err := decodeJSONValue(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := jsonPointerGet(root, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			return nil, errors.New("test operation failed: value mismatch")
+		}
+		return root, nil
+
+	default:
+		return nil, fmt.Errorf("unknown operation %q", op.Op)
+	}
+}
+
+// decodeJSONValue decodes a JSON value keeping numbers as json.Number so that
+// large integers survive a round trip.
+func decodeJSONValue(bz []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// ParseJSONPointer parses an RFC 6901 JSON pointer into its unescaped tokens.
+func ParseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("json pointer %q must start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func jsonArrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if allowEnd && token == "-" {
+		return length, nil
+	}
+
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+
+	maxIdx := length - 1
+	if allowEnd {
+		maxIdx = length
+	}
+	if idx > maxIdx {
+		return 0, fmt.Errorf("array index %d out of bounds", idx)
+	}
+	return idx, nil
+}
+
+func jsonPointerGet(node any, path []string) (any, error) {
+	for _, token := range path {
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("key %q not found", token)
+			}
+			node = child
+		case []any:
+			idx, err := jsonArrayIndex(token, len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			node = n[idx]
+		default:
+			return nil, fmt.Errorf("cannot traverse into scalar value at %q", token)
+		}
+	}
+	return node, nil
+}
+
+// jsonPointerAdd adds value at path and returns the (possibly new) root.
+func jsonPointerAdd(root any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	parent, err := jsonPointerGet(root, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]any:
+		p[last] = value
+		return root, nil
+	case []any:
+		idx, err := jsonArrayIndex(last, len(p), true)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, nil)
+		copy(p[idx+1:], p[idx:])
+		p[idx] = value
+		return jsonPointerSet(root, path[:len(path)-1], p)
+	default:
+		return nil, fmt.Errorf("cannot add into scalar value at %q", last)
+	}
+}
+
+// jsonPointerRemove removes the value at path and returns the new root along
+// with the removed value.
+func jsonPointerRemove(root any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, root, nil
+	}
+
+	parent, err := jsonPointerGet(root, path[:len(path)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]any:
+		value, ok := p[last]
+		if !ok {
+			return nil, nil, fmt.Errorf("key %q not found", last)
+		}
+		delete(p, last)
+		return root, value, nil
+	case []any:
+		idx, err := jsonArrayIndex(last, len(p), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		value := p[idx]
+		p = append(p[:idx:idx], p[idx+1:]...)
+		root, err = jsonPointerSet(root, path[:len(path)-1], p)
+		return root, value, err
+	default:
+		return nil, nil, fmt.Errorf("cannot remove from scalar value at %q", last)
+	}
+}
+
+// jsonPointerSet replaces the value at an existing path. It is used to store
+// arrays back into their parent after their length changed.
+func jsonPointerSet(root any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	parent, err := jsonPointerGet(root, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	last := path[len(path)-1]
+	switch p := parent.(type) {
+	case map[string]any:
+		p[last] = value
+	case []any:
+		idx, err := jsonArrayIndex(last, len(p), false)
+		if err != nil {
+			return nil, err
+		}
+		p[idx] = value
+	}
+	return root, nil
+}
@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestJSONPatchApply(t *testing.T) {
+	doc := `{"a":{"b":1,"c":[1,2,3]},"d~e":"x","f/g":"y","big":100000000000000000000}`
+
+	testCases := []struct {
+		name   string
+		patch  string
+		expDoc string
+		expErr string
+	}{
+		{
+			name:   "add object member",
+			patch:  `[{"op":"add","path":"/a/z","value":true}]`,
+			expDoc: `{"a":{"b":1,"c":[1,2,3],"z":true},"big":100000000000000000000,"d~e":"x","f/g":"y"}`,
+		},
+		{
+			name:   "add to array end and middle",
+			patch:  `[{"op":"add","path":"/a/c/-","value":4},{"op":"add","path":"/a/c/0","value":0}]`,
+			expDoc: `{"a":{"b":1,"c":[0,1,2,3,4]},"big":100000000000000000000,"d~e":"x","f/g":"y"}`,
+		},
+		{
+			name:   "remove escaped keys",
+			patch:  `[{"op":"remove","path":"/d~0e"},{"op":"remove","path":"/f~1g"}]`,
+			expDoc: `{"a":{"b":1,"c":[1,2,3]},"big":100000000000000000000}`,
+		},
+		{
+			name:   "replace, move and copy",
+			patch:  `[{"op":"replace","path":"/a/b","value":2},{"op":"move","from":"/a/c","path":"/c"},{"op":"copy","from":"/a","path":"/a2"}]`,
+			expDoc: `{"a":{"b":2},"a2":{"b":2},"big":100000000000000000000,"c":[1,2,3],"d~e":"x","f/g":"y"}`,
+		},
+		{
+			name:   "test succeeds",
+			patch:  `[{"op":"test","path":"/a/c/1","value":2}]`,
+			expDoc: `{"a":{"b":1,"c":[1,2,3]},"big":100000000000000000000,"d~e":"x","f/g":"y"}`,
+		},
+		{
+			name:   "test fails",
+			patch:  `[{"op":"test","path":"/a/b","value":2}]`,
+			expErr: "value mismatch",
+		},
+		{
+			name:   "replace missing key",
+			patch:  `[{"op":"replace","path":"/missing","value":2}]`,
+			expErr: `key "missing" not found`,
+		},
+		{
+			name:   "array index out of bounds",
+			patch:  `[{"op":"remove","path":"/a/c/3"}]`,
+			expErr: "out of bounds",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			patch, err := types.DecodeJSONPatch([]byte(tc.patch))
+			require.NoError(t, err)
+
+			res, err := patch.Apply([]byte(doc))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expDoc, string(res))
+		})
+	}
+}
+
+func TestDecodeJSONPatch(t *testing.T) {
+	_, err := types.DecodeJSONPatch([]byte(`[{"op":"unknown","path":"/a"}]`))
+	require.ErrorContains(t, err, "unknown operation")
+
+	_, err = types.DecodeJSONPatch([]byte(`[{"op":"add","path":"a","value":1}]`))
+	require.ErrorContains(t, err, "must start with '/'")
+
+	_, err = types.DecodeJSONPatch([]byte(`[{"op":"add","path":"/a"}]`))
+	require.ErrorContains(t, err, "requires a value")
+
+	_, err = types.DecodeJSONPatch([]byte(`[{"op":"move","from":"/a","path":"/a/b"}]`))
+	require.ErrorContains(t, err, "into one of its children")
+}
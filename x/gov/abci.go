@@ -174,6 +174,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			// the handlers fails, no state mutation is written and the error
 			// message is logged.
 			cacheCtx, writeCache := ctx.CacheContext()
+			cacheCtx = types.WithExecutingProposal(cacheCtx, types.ExecutingProposal{ID: proposal.Id, Title: proposal.Title})
 			messages, err := proposal.GetMsgs()
 			if err != nil {
 				proposal.Status = v1.StatusFailed
@@ -14,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -480,6 +481,77 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestEndBlockerProposalExecutionContext(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: app.LastBlockHeight() + 1,
+		Hash:   app.LastCommitID().Hash,
+	})
+	require.NoError(t, err)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	proposer := addrs[0]
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	_, err = suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// the community pool spend history attributes spends to the executing proposal
+	distrParams, err := suite.DistributionKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	distrParams.CommunityPoolSpendHistoryRetention = 100
+	require.NoError(t, suite.DistributionKeeper.Params.Set(ctx, distrParams))
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000)))
+	require.NoError(t, suite.DistributionKeeper.FundCommunityPool(ctx, amount, addrs[0]))
+
+	msg := &distrtypes.MsgCommunityPoolSpend{
+		Authority: authtypes.NewModuleAddress(types.ModuleName).String(),
+		Recipient: addrs[0].String(),
+		Amount:    amount,
+	}
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "spend title", "summary", proposer, false)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	params, _ := suite.GovKeeper.Params.Get(ctx)
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	var spends []distrtypes.CommunityPoolSpendRecord
+	err = suite.DistributionKeeper.CommunityPoolSpendHistory.Walk(ctx, nil,
+		func(_ collections.Pair[int64, uint64], spend distrtypes.CommunityPoolSpendRecord) (bool, error) {
+			spends = append(spends, spend)
+			return false, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, spends, 1)
+	require.Equal(t, proposal.Id, spends[0].ProposalId)
+	require.Equal(t, "spend title", spends[0].ProposalTitle)
+	require.Equal(t, amount, spends[0].Amount)
+}
+
 func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	testcases := []struct {
 		name string
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type executingProposalKey struct{}
+
+// ExecutingProposal identifies the proposal whose messages are being executed.
+type ExecutingProposal struct {
+	ID    uint64
+	Title string
+}
+
+// WithExecutingProposal returns a context carrying the proposal whose messages are
+// executed with it, so that message handlers can attribute their effects to it.
+func WithExecutingProposal(ctx sdk.Context, proposal ExecutingProposal) sdk.Context {
+	return ctx.WithValue(executingProposalKey{}, proposal)
+}
+
+// ExecutingProposalFromContext returns the proposal whose messages are executed with
+// the context. It returns false when the messages are not executed by x/gov.
+func ExecutingProposalFromContext(ctx context.Context) (ExecutingProposal, bool) {
+	proposal, ok := ctx.Value(executingProposalKey{}).(ExecutingProposal)
+	return proposal, ok
+}
@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+const (
+	// range of the tiny increments of the block time
+	minTinyBlockTime = time.Millisecond
+	maxTinyBlockTime = time.Second
+
+	// range of the large gaps between blocks
+	minGapBlockTime = time.Hour
+	maxGapBlockTime = 12 * time.Hour
+)
+
+// nextBlockTime returns the time of the block at the given height, following a
+// block at blockTime, as drawn from the block time model. The time always
+// increases, as required by CometBFT.
+func nextBlockTime(r *rand.Rand, model simulation.BlockTimeModel, height int64, blockTime time.Time) time.Time {
+	if model.HaltHeight != 0 && height == model.HaltHeight {
+		return blockTime.Add(model.HaltDuration)
+	}
+
+	normal := func() time.Time {
+		blockTime = blockTime.Add(time.Duration(minTimePerBlock) * time.Second)
+		return blockTime.Add(time.Duration(int64(r.Intn(int(maxTimePerBlock-minTimePerBlock)))) * time.Second)
+	}
+
+	// draw from the normal range only, so that the seeds of the simulations
+	// without a block time model keep reproducing the same runs
+	if model.TinyWeight == 0 && model.GapWeight == 0 {
+		return normal()
+	}
+
+	switch w := r.Intn(model.TinyWeight + model.NormalWeight + model.GapWeight); {
+	case w < model.TinyWeight:
+		return blockTime.Add(randDuration(r, minTinyBlockTime, maxTinyBlockTime))
+	case w < model.TinyWeight+model.NormalWeight:
+		return normal()
+	default:
+		return blockTime.Add(randDuration(r, minGapBlockTime, maxGapBlockTime))
+	}
+}
+
+// randDuration returns a random duration in [minDuration, maxDuration).
+func randDuration(r *rand.Rand, minDuration, maxDuration time.Duration) time.Duration {
+	return minDuration + time.Duration(r.Int63n(int64(maxDuration-minDuration)))
+}
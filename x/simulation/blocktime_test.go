@@ -0,0 +1,123 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// blockTimes returns the increments of the block times of n blocks drawn from
+// the model with the given seed.
+func blockTimes(seed int64, model simtypes.BlockTimeModel, n int) []time.Duration {
+	r := rand.New(rand.NewSource(seed))
+	blockTime := time.Unix(1700000000, 0)
+	increments := make([]time.Duration, n)
+	for i := range increments {
+		next := nextBlockTime(r, model, int64(i+2), blockTime)
+		increments[i] = next.Sub(blockTime)
+		blockTime = next
+	}
+
+	return increments
+}
+
+func TestNextBlockTime(t *testing.T) {
+	minNormal := time.Duration(minTimePerBlock) * time.Second
+	maxNormal := time.Duration(maxTimePerBlock) * time.Second
+
+	// without a model, the draws of the uniform block times are kept
+	r := rand.New(rand.NewSource(3))
+	var expected []time.Duration
+	for range 100 {
+		expected = append(expected, minNormal+time.Duration(int64(r.Intn(int(maxTimePerBlock-minTimePerBlock))))*time.Second)
+	}
+	require.Equal(t, expected, blockTimes(3, simtypes.BlockTimeModel{}, 100))
+
+	model := simtypes.BlockTimeModel{
+		TinyWeight:   3,
+		NormalWeight: 6,
+		GapWeight:    1,
+		HaltHeight:   50,
+		HaltDuration: 72 * time.Hour,
+	}
+	increments := blockTimes(7, model, 1000)
+
+	// the time series is deterministic per seed
+	require.Equal(t, increments, blockTimes(7, model, 1000))
+	require.NotEqual(t, increments, blockTimes(8, model, 1000))
+
+	var tiny, normal, gaps int
+	for i, d := range increments {
+		switch {
+		case i+2 == int(model.HaltHeight):
+			require.Equal(t, model.HaltDuration, d)
+		case d >= minTinyBlockTime && d < maxTinyBlockTime:
+			tiny++
+		case d >= minNormal && d < maxNormal:
+			normal++
+		case d >= minGapBlockTime && d < maxGapBlockTime:
+			gaps++
+		default:
+			t.Fatalf("block time increment %s out of the ranges of the model", d)
+		}
+	}
+	require.InDelta(t, 300, tiny, 60)
+	require.InDelta(t, 600, normal, 60)
+	require.InDelta(t, 100, gaps, 40)
+
+	require.NoError(t, model.Validate())
+	require.Error(t, simtypes.BlockTimeModel{GapWeight: -1}.Validate())
+	require.Error(t, simtypes.BlockTimeModel{HaltHeight: 5}.Validate())
+}
+
+func TestTimeOperationsBurstAfterGap(t *testing.T) {
+	const numOps = 50
+
+	start := time.Unix(1700000000, 0)
+	var ran []int
+	op := func(i int) simtypes.Operation {
+		return func(*rand.Rand, *baseapp.BaseApp, sdk.Context, []simtypes.Account, string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+			ran = append(ran, i)
+			return simtypes.NoOpMsg("test", "op", ""), nil, nil
+		}
+	}
+
+	// schedule operations every minute of the next hours, in shuffled order
+	var timeOps []simtypes.FutureOperation
+	r := rand.New(rand.NewSource(1))
+	for _, i := range r.Perm(numOps) {
+		queueOperations(NewOperationQueue(), &timeOps, []simtypes.FutureOperation{{
+			BlockTime: start.Add(time.Duration(i+1) * time.Minute),
+			Op:        op(i),
+		}})
+	}
+	require.Len(t, timeOps, numOps)
+
+	run := func(blockTime time.Time) int {
+		n, futureOps := runQueuedTimeOperations(t, &timeOps, 1, blockTime, r, nil, sdk.Context{}, nil,
+			NewLogWriter(true), func(string, string, string) {}, true, "test")
+		require.Empty(t, futureOps)
+		return n
+	}
+
+	// a tiny increment runs nothing, a normal one the first operations
+	require.Zero(t, run(start.Add(time.Second)))
+	require.Equal(t, 3, run(start.Add(3*time.Minute+time.Second)))
+
+	// all the operations scheduled during a gap are run in the next block, in
+	// the order of their times
+	require.Equal(t, numOps-3, run(start.Add(12*time.Hour)))
+	require.Empty(t, timeOps)
+	for i := range ran {
+		require.Equal(t, i, ran[i])
+	}
+
+	// nothing is run twice
+	require.Zero(t, run(start.Add(24*time.Hour)))
+}
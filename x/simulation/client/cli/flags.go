@@ -30,6 +30,12 @@ var (
 	FlagSigverifyTxValue bool
 	FlagFauxMerkle       bool
 
+	FlagBlockTimeTinyWeightValue   int
+	FlagBlockTimeNormalWeightValue int
+	FlagBlockTimeGapWeightValue    int
+	FlagHaltHeightValue            int64
+	FlagHaltDurationValue          time.Duration
+
 	// Deprecated: This flag is unused and will be removed in a future release.
 	FlagPeriodValue uint
 	// Deprecated: This flag is unused and will be removed in a future release.
@@ -63,6 +69,13 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagSigverifyTxValue, "SigverifyTx", true, "whether to sigverify check for transaction ")
 	flag.BoolVar(&FlagFauxMerkle, "FauxMerkle", false, "use faux merkle instead of iavl")
 
+	// block time flags
+	flag.IntVar(&FlagBlockTimeTinyWeightValue, "BlockTimeTinyWeight", 0, "relative weight of the block times increasing by 1ms to 1s")
+	flag.IntVar(&FlagBlockTimeNormalWeightValue, "BlockTimeNormalWeight", 0, "relative weight of the block times increasing by the normal range")
+	flag.IntVar(&FlagBlockTimeGapWeightValue, "BlockTimeGapWeight", 0, "relative weight of the block times increasing by 1h to 12h")
+	flag.Int64Var(&FlagHaltHeightValue, "HaltHeight", 0, "height of a block produced HaltDuration after the previous one, as after a chain halt")
+	flag.DurationVar(&FlagHaltDurationValue, "HaltDuration", 72*time.Hour, "time between the block at HaltHeight and the previous one")
+
 	flag.UintVar(&FlagPeriodValue, "Period", 0, "This parameter is unused and will be removed")
 	flag.BoolVar(&FlagEnabledValue, "Enabled", false, "This parameter is unused and will be removed")
 	flag.BoolVar(&FlagOnOperationValue, "SimulateEveryOperation", false, "This parameter is unused and will be removed")
@@ -86,6 +99,13 @@ func NewConfigFromFlags() simulation.Config {
 		Lean:               FlagLeanValue,
 		Commit:             FlagCommitValue,
 		DBBackend:          FlagDBBackendValue,
+		BlockTimeModel: simulation.BlockTimeModel{
+			TinyWeight:   FlagBlockTimeTinyWeightValue,
+			NormalWeight: FlagBlockTimeNormalWeightValue,
+			GapWeight:    FlagBlockTimeGapWeightValue,
+			HaltHeight:   FlagHaltHeightValue,
+			HaltDuration: FlagHaltDurationValue,
+		},
 	}
 }
 
@@ -201,6 +201,11 @@ func RandomRequestFinalizeBlock(
 			vals = pastVoteInfos[height-1]
 		}
 
+		// no validator voted at the height to misbehave at
+		if len(vals) == 0 {
+			continue
+		}
+
 		validator := vals[r.Intn(len(vals))].Validator
 
 		var totalVotingPower int64
@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+)
+
+func TestRandomRequestFinalizeBlockNoPastVotes(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	params := Params{
+		evidenceFraction:          0.9,
+		pastEvidenceFraction:      1,
+		initialLivenessWeightings: []int{1, 0, 0},
+		livenessTransitionMatrix:  defaultLivenessTransitionMatrix,
+	}
+
+	pubKey, err := cryptocodec.ToCmtProtoPublicKey(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	validators := newMockValidators(r, []abci.ValidatorUpdate{{PubKey: pubKey, Power: 10}}, params)
+
+	// no validator voted at the past heights
+	pastTimes := make([]time.Time, 4)
+	pastVoteInfos := make([][]abci.VoteInfo, 4)
+	event := func(route, op, evResult string) {}
+
+	var req *abci.RequestFinalizeBlock
+	require.NotPanics(t, func() {
+		req = RandomRequestFinalizeBlock(r, params, validators, pastTimes, pastVoteInfos, event, 5, time.Now(), nil)
+	})
+	require.Empty(t, req.Misbehavior)
+	require.Len(t, req.DecidedLastCommit.Votes, 1)
+}
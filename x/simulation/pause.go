@@ -0,0 +1,181 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// PauseCondition reports whether the simulation pauses after the block
+// described by the handle has been committed.
+type PauseCondition func(h *PauseHandle) bool
+
+// PausePoint pauses a running simulation when its condition is met and hands
+// control to its callback. The simulation resumes when the callback returns;
+// an error returned by the callback aborts the simulation.
+// A pause point is hit at most once per run.
+type PausePoint struct {
+	Name      string
+	Condition PauseCondition
+	Callback  func(h *PauseHandle) error
+}
+
+// PauseAtHeight returns a condition that is met once the block at the given
+// height has been committed.
+func PauseAtHeight(height int64) PauseCondition {
+	return func(h *PauseHandle) bool {
+		return h.Height() >= height
+	}
+}
+
+// PauseOnFirstDelivery returns a condition that is met after the block in which
+// a msg of the given type URL was delivered successfully for the first time.
+func PauseOnFirstDelivery(msgTypeURL string) PauseCondition {
+	return func(h *PauseHandle) bool {
+		return h.Delivered(msgTypeURL)
+	}
+}
+
+// PauseOnMarker returns a condition that is met once the marker fires on the
+// committed state. The context passed to the marker is read-only.
+func PauseOnMarker(marker func(ctx sdk.Context) bool) PauseCondition {
+	return func(h *PauseHandle) bool {
+		ctx, err := h.QueryContext()
+		if err != nil {
+			return false
+		}
+		return marker(ctx)
+	}
+}
+
+// PauseHandle gives a pause point read-only access to the committed state of
+// the paused simulation and to its bookkeeping. None of its methods consume
+// the randomness of the simulation, so that a paused run stays deterministic.
+type PauseHandle struct {
+	app        *baseapp.BaseApp
+	height     int64
+	accounts   []simulation.Account
+	validators mockValidators
+	delivered  map[string]struct{}
+	rngSource  *byteSource
+	rngDraws   uint64
+}
+
+// Height returns the height of the last committed block.
+func (h *PauseHandle) Height() int64 {
+	return h.height
+}
+
+// Delivered reports whether a msg of the given type URL was delivered
+// successfully in the last committed block.
+func (h *PauseHandle) Delivered(msgTypeURL string) bool {
+	_, ok := h.delivered[msgTypeURL]
+	return ok
+}
+
+// Accounts returns a copy of the simulation accounts.
+func (h *PauseHandle) Accounts() []simulation.Account {
+	return append([]simulation.Account(nil), h.accounts...)
+}
+
+// Validators returns the validator set of the last committed block, sorted by
+// public key.
+func (h *PauseHandle) Validators() []abci.ValidatorUpdate {
+	keys := h.validators.getKeys()
+	vals := make([]abci.ValidatorUpdate, 0, len(keys))
+	for _, key := range keys {
+		vals = append(vals, h.validators[key].val)
+	}
+	return vals
+}
+
+// QueryContext returns a context on the committed state, which can be passed
+// to the keepers of the app. Writes to the context are discarded.
+func (h *PauseHandle) QueryContext() (sdk.Context, error) {
+	return h.app.CreateQueryContext(h.height, false)
+}
+
+// Query runs an ABCI query against the committed state. The height of the
+// request defaults to the height of the last committed block.
+func (h *PauseHandle) Query(req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	if req.Height == 0 {
+		req.Height = h.height
+	}
+	ctx, err := h.QueryContext()
+	if err != nil {
+		return nil, err
+	}
+	return h.app.Query(ctx, req)
+}
+
+// RNG returns a random number generator in the state of the simulation's one
+// at the time of the pause. Drawing from it does not affect the simulation.
+func (h *PauseHandle) RNG() *rand.Rand {
+	return rand.New(h.rngSource.replay(h.rngDraws))
+}
+
+// RNGDraws returns the number of values drawn from the simulation's random
+// number generator at the time of the pause.
+func (h *PauseHandle) RNGDraws() uint64 {
+	return h.rngDraws
+}
+
+// pauseTracker records the msgs delivered in a block and calls the pause
+// points once the block has been committed.
+type pauseTracker struct {
+	points    []PausePoint
+	hit       []bool
+	delivered map[string]struct{}
+	event     func(route, op, evResult string)
+}
+
+func newPauseTracker(points []PausePoint, event func(route, op, evResult string)) *pauseTracker {
+	return &pauseTracker{
+		points:    points,
+		hit:       make([]bool, len(points)),
+		delivered: make(map[string]struct{}),
+		event:     event,
+	}
+}
+
+// tally forwards the event and records the successfully delivered msgs.
+func (t *pauseTracker) tally(route, op, evResult string) {
+	if evResult == "ok" {
+		t.delivered[op] = struct{}{}
+	}
+	t.event(route, op, evResult)
+}
+
+// check calls the pause points hit by the last committed block and resets
+// the delivered msgs.
+func (t *pauseTracker) check(app *baseapp.BaseApp, accs []simulation.Account, validators mockValidators, rngSource *byteSource) error {
+	defer clear(t.delivered)
+	if len(t.points) == 0 {
+		return nil
+	}
+
+	h := &PauseHandle{
+		app:        app,
+		height:     app.LastBlockHeight(),
+		accounts:   accs,
+		validators: validators,
+		delivered:  t.delivered,
+		rngSource:  rngSource,
+		rngDraws:   rngSource.draws,
+	}
+	for i, point := range t.points {
+		if t.hit[i] || !point.Condition(h) {
+			continue
+		}
+		t.hit[i] = true
+		if err := point.Callback(h); err != nil {
+			return fmt.Errorf("pause point %q at height %d: %w", point.Name, h.height, err)
+		}
+	}
+	return nil
+}
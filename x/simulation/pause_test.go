@@ -0,0 +1,174 @@
+package simulation_test
+
+import (
+	"io"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestPausePoints(t *testing.T) {
+	const pauseHeight = 5
+
+	var (
+		pausedAt    []int64
+		supply      sdk.Coins
+		delivered   int64
+		rngValue    int64
+		rngDraws    uint64
+		numAccounts int
+	)
+	pausePoints := func(bankKeeper bankkeeper.Keeper) []simulation.PausePoint {
+		return []simulation.PausePoint{{
+			Name:      "height",
+			Condition: simulation.PauseAtHeight(pauseHeight),
+			Callback: func(h *simulation.PauseHandle) error {
+				pausedAt = append(pausedAt, h.Height())
+				numAccounts = len(h.Accounts())
+				require.NotEmpty(t, h.Validators())
+
+				// the RNG snapshot can be drawn from repeatedly
+				rngDraws = h.RNGDraws()
+				rngValue = h.RNG().Int63()
+				require.Equal(t, rngValue, h.RNG().Int63())
+
+				// query the committed state through the ABCI
+				bz, err := (&banktypes.QueryTotalSupplyRequest{}).Marshal()
+				require.NoError(t, err)
+				res, err := h.Query(&abci.RequestQuery{Path: "/cosmos.bank.v1beta1.Query/TotalSupply", Data: bz})
+				require.NoError(t, err)
+				require.Zero(t, res.Code, res.Log)
+				var supplyRes banktypes.QueryTotalSupplyResponse
+				require.NoError(t, supplyRes.Unmarshal(res.Value))
+				supply = supplyRes.Supply
+
+				// the keepers read the same committed state
+				ctx, err := h.QueryContext()
+				require.NoError(t, err)
+				require.Equal(t, ctx.BlockHeight(), h.Height())
+				total, _, err := bankKeeper.GetPaginatedTotalSupply(ctx, nil)
+				require.NoError(t, err)
+				require.Equal(t, supply, total)
+				return nil
+			},
+		}, {
+			Name:      "first send",
+			Condition: simulation.PauseOnFirstDelivery(sdk.MsgTypeURL(&banktypes.MsgSend{})),
+			Callback: func(h *simulation.PauseHandle) error {
+				delivered = h.Height()
+				return nil
+			},
+		}}
+	}
+
+	unpaused := runPauseTestSimulation(t, nil)
+	paused := runPauseTestSimulation(t, pausePoints)
+
+	// pausing does not change the outcome of the simulation
+	require.NotEmpty(t, unpaused)
+	require.Equal(t, unpaused, paused)
+
+	require.Equal(t, []int64{pauseHeight}, pausedAt)
+	require.NotZero(t, numAccounts)
+	require.NotZero(t, rngDraws)
+	require.False(t, supply.IsZero())
+	require.NotZero(t, delivered)
+}
+
+// runPauseTestSimulation runs a simulation with a fixed seed and returns the
+// app hash at the end of the run.
+func runPauseTestSimulation(t *testing.T, pausePointsFn func(bankKeeper bankkeeper.Keeper) []simulation.PausePoint) []byte {
+	t.Helper()
+	const chainID = "pause-test"
+
+	var (
+		appBuilder *runtime.AppBuilder
+		cdc        codec.Codec
+		txConfig   client.TxConfig
+		bankKeeper bankkeeper.Keeper
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.AuthModule(),
+				configurator.VestingModule(),
+				configurator.BankModule(),
+				configurator.StakingModule(),
+				configurator.GenutilModule(),
+				configurator.ConsensusModule(),
+				configurator.TxModule(),
+			),
+			depinject.Supply(log.NewNopLogger()),
+		),
+		&appBuilder, &cdc, &txConfig, &bankKeeper,
+	)
+	require.NoError(t, err)
+
+	app := appBuilder.Build(dbm.NewMemDB(), nil, baseapp.SetChainID(chainID))
+	require.NoError(t, app.Load(true))
+
+	simManager := module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, nil)
+	ops := simManager.WeightedOperations(module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       cdc,
+		TxConfig:  txConfig,
+		BondDenom: sdk.DefaultBondDenom,
+	})
+
+	var pausePoints []simulation.PausePoint
+	if pausePointsFn != nil {
+		pausePoints = pausePointsFn(bankKeeper)
+	}
+
+	config := simtypes.Config{
+		ChainID:            chainID,
+		Seed:               7,
+		NumBlocks:          10,
+		BlockSize:          20,
+		InitialBlockHeight: 1,
+		GenesisTime:        1700000000,
+		Commit:             true,
+	}
+	_, _, err = simulation.SimulateFromSeedX(
+		t,
+		log.NewNopLogger(),
+		io.Discard,
+		app.BaseApp,
+		simtestutil.AppStateFn(cdc, simManager, app.DefaultGenesis()),
+		simtypes.RandomAccounts,
+		ops,
+		nil,
+		config,
+		cdc,
+		simulation.NewLogWriter(true),
+		pausePoints...,
+	)
+	require.NoError(t, err)
+
+	return app.LastCommitID().Hash
+}
@@ -88,19 +88,28 @@ func SimulateFromSeedX(
 	config simulation.Config,
 	cdc codec.JSONCodec,
 	logWriter LogWriter,
+	pausePoints ...PausePoint,
 ) (exportedParams Params, accs []simulation.Account, err error) {
 	tb.Helper()
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, _, b := getTestingMode(tb)
 
-	r := rand.New(newByteSource(config.FuzzSeed, config.Seed))
+	if len(pausePoints) != 0 && !config.Commit {
+		return Params{}, nil, fmt.Errorf("pause points require the simulation to commit blocks")
+	}
+
+	if err := config.BlockTimeModel.Validate(); err != nil {
+		return Params{}, nil, err
+	}
+
+	rngSource := newByteSource(config.FuzzSeed, config.Seed)
+	r := rand.New(rngSource)
 	params := RandomParams(r)
 
 	startTime := time.Now()
 	logger.Info("Starting SimulateFromSeed with randomness", "time", startTime)
 	logger.Debug("Randomized simulation setup", "params", mustMarshalJSONIndent(params))
 
-	timeDiff := maxTimePerBlock - minTimePerBlock
 	accs = randAccFn(r, params.NumKeys())
 	eventStats := NewEventStats()
 
@@ -156,15 +165,18 @@ func SimulateFromSeedX(
 	// These are operations which have been queued by previous operations
 	operationQueue := NewOperationQueue()
 
+	// tracks the msgs delivered in each block for the pause points
+	pauses := newPauseTracker(pausePoints, eventStats.Tally)
+
 	blockSimulator := createBlockSimulator(
 		tb,
 		testingMode,
 		w,
 		params,
-		eventStats.Tally,
+		pauses.tally,
 		ops,
 		operationQueue,
-		timeOperationQueue,
+		&timeOperationQueue,
 		logWriter,
 		config,
 	)
@@ -213,17 +225,17 @@ func SimulateFromSeedX(
 		// run queued operations; ignores block size if block size is too small
 		numQueuedOpsRan, futureOps := runQueuedOperations(
 			tb, operationQueue, blockTime, int(blockHeight), r, app, ctx, accs, logWriter,
-			eventStats.Tally, config.Lean, config.ChainID,
+			pauses.tally, config.Lean, config.ChainID,
 		)
 
 		numQueuedTimeOpsRan, timeFutureOps := runQueuedTimeOperations(tb,
-			timeOperationQueue, int(blockHeight), blockTime,
-			r, app, ctx, accs, logWriter, eventStats.Tally,
+			&timeOperationQueue, int(blockHeight), blockTime,
+			r, app, ctx, accs, logWriter, pauses.tally,
 			config.Lean, config.ChainID,
 		)
 
 		futureOps = append(futureOps, timeFutureOps...)
-		queueOperations(operationQueue, timeOperationQueue, futureOps)
+		queueOperations(operationQueue, &timeOperationQueue, futureOps)
 
 		// run standard operations
 		operations := blockSimulator(r, app, ctx, accs, cmtproto.Header{
@@ -238,8 +250,7 @@ func SimulateFromSeedX(
 
 		logWriter.AddEntry(EndBlockEntry(blockHeight))
 
-		blockTime = blockTime.Add(time.Duration(minTimePerBlock) * time.Second)
-		blockTime = blockTime.Add(time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
+		blockTime = nextBlockTime(r, config.BlockTimeModel, blockHeight, blockTime)
 		proposerAddress = validators.randomProposer(r)
 
 		if config.Commit {
@@ -249,6 +260,11 @@ func SimulateFromSeedX(
 			}
 		}
 
+		// hand control to the pause points that are hit by the committed block
+		if err := pauses.check(app, accs, validators, rngSource); err != nil {
+			return params, accs, err
+		}
+
 		if proposerAddress == nil {
 			logger.Info("Simulation stopped early as all validators have been unbonded; nobody left to propose a block", "height", blockHeight)
 			break
@@ -297,7 +313,7 @@ type blockSimFn func(
 // parameters being passed every time, to minimize memory overhead.
 func createBlockSimulator(tb testing.TB, printProgress bool, w io.Writer, params Params,
 	event func(route, op, evResult string), ops WeightedOperations,
-	operationQueue OperationQueue, timeOperationQueue []simulation.FutureOperation,
+	operationQueue OperationQueue, timeOperationQueue *[]simulation.FutureOperation,
 	logWriter LogWriter, config simulation.Config,
 ) blockSimFn {
 	tb.Helper()
@@ -409,7 +425,11 @@ func runQueuedOperations(
 	return numOpsRan, allFutureOps
 }
 
-func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperation,
+// runQueuedTimeOperations runs the queued operations scheduled before the
+// current time, in the order of their times, and removes them from the queue.
+// After a large gap between blocks, all the operations scheduled during the
+// gap are run in the same block.
+func runQueuedTimeOperations(tb testing.TB, queueOps *[]simulation.FutureOperation,
 	height int, currentTime time.Time, r *rand.Rand,
 	app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account,
 	logWriter LogWriter, event func(route, op, evResult string),
@@ -420,8 +440,8 @@ func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperatio
 	allFutureOps = make([]simulation.FutureOperation, 0)
 
 	numOpsRan = 0
-	for len(queueOps) > 0 && currentTime.After(queueOps[0].BlockTime) {
-		opMsg, futureOps, err := queueOps[0].Op(r, app, ctx, accounts, chainID)
+	for len(*queueOps) > 0 && currentTime.After((*queueOps)[0].BlockTime) {
+		opMsg, futureOps, err := (*queueOps)[0].Op(r, app, ctx, accounts, chainID)
 
 		opMsg.LogEvent(event)
 
@@ -438,7 +458,7 @@ func runQueuedTimeOperations(tb testing.TB, queueOps []simulation.FutureOperatio
 			allFutureOps = append(allFutureOps, futureOps...)
 		}
 
-		queueOps = queueOps[1:]
+		*queueOps = (*queueOps)[1:]
 		numOpsRan++
 	}
 
@@ -456,18 +476,36 @@ const (
 type byteSource struct {
 	seed     *bytes.Reader
 	fallback *rand.Rand
+
+	// used to reproduce the state of the source
+	fuzzSeed  []byte
+	seedValue int64
+	draws     uint64
 }
 
 // newByteSource creates a new byteSource with a specified byte slice and seed. This gives a fixed sequence of pseudo-random numbers.
 // Initially, it utilizes the byte slice. Once that's exhausted, it continues generating numbers using the provided seed.
 func newByteSource(fuzzSeed []byte, seed int64) *byteSource {
 	return &byteSource{
-		seed:     bytes.NewReader(fuzzSeed),
-		fallback: rand.New(rand.NewSource(seed)),
+		seed:      bytes.NewReader(fuzzSeed),
+		fallback:  rand.New(rand.NewSource(seed)),
+		fuzzSeed:  fuzzSeed,
+		seedValue: seed,
+	}
+}
+
+// replay returns a new byteSource in the state of s after the given number of
+// draws. Drawing from the replayed source does not advance s.
+func (s *byteSource) replay(draws uint64) *byteSource {
+	c := newByteSource(s.fuzzSeed, s.seedValue)
+	for range draws {
+		c.Uint64()
 	}
+	return c
 }
 
 func (s *byteSource) Uint64() uint64 {
+	s.draws++
 	if s.seed.Len() < 8 {
 		return s.fallback.Uint64()
 	}
@@ -18,11 +18,12 @@ func getTestingMode(tb testing.TB) (testingMode bool, t *testing.T, b *testing.B
 	tb.Helper()
 	testingMode = false
 
-	if _t, ok := tb.(*testing.T); ok {
-		t = _t
-		testingMode = true
+	// anything but a benchmark, such as a wrapped *testing.T, runs in testing mode
+	if _b, ok := tb.(*testing.B); ok {
+		b = _b
 	} else {
-		b = tb.(*testing.B)
+		t, _ = tb.(*testing.T)
+		testingMode = true
 	}
 
 	return testingMode, t, b